@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clusterspec"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "apply"
+	short = "Apply the resources of a spec file to a cluster"
+	long  = "Reconcile the machine pools, node pools, identity providers, ingresses, kubelet configs, " +
+		"tuning configs and autoscaler of a cluster with the resources listed in a spec file. The " +
+		"changes are printed before they are applied. Only the kinds of resources listed in the " +
+		"spec file are reconciled, and resources missing from the spec file are only deleted when " +
+		"the '--prune' flag is set. Identity providers can't be updated, so a changed identity " +
+		"provider is first created with the '-replacement' suffix, then the old one is deleted, " +
+		"the new one is created with its name and the copy is deleted. The callback URL of OAuth " +
+		"identity providers contains their name, so to let users log in during the replacement, " +
+		"register the callback URL of the copy, ending in '/oauth2callback/<name>-replacement', in " +
+		"the OAuth application before applying. A cluster can only have one htpasswd identity " +
+		"provider, so changed htpasswd identity providers can't be replaced: delete them first. " +
+		"Secrets, like client secrets, bind passwords and htpasswd users, aren't returned by OCM, so " +
+		"they aren't compared: to rotate the secret of an identity provider, delete it and apply " +
+		"the spec file again."
+	example = `  # Apply the resources of a spec file to a cluster named "mycluster"
+  rosa apply --cluster=mycluster --file=cluster-resources.yaml
+
+  # Print the changes without applying them
+  rosa apply --cluster=mycluster --file=cluster-resources.yaml --dry-run
+
+  # Also delete the resources that are not listed in the spec file
+  rosa apply --cluster=mycluster --file=cluster-resources.yaml --prune`
+)
+
+type ApplyOptions struct {
+	file   string
+	prune  bool
+	dryRun bool
+}
+
+func NewApplyCommand() *cobra.Command {
+	options := &ApplyOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ApplyRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	ocm.AddClusterFlag(cmd)
+	flags.StringVarP(
+		&options.file,
+		"file",
+		"f",
+		"",
+		"Path of the spec file, in YAML or JSON, that lists the resources of the cluster.",
+	)
+	cmd.MarkFlagRequired("file")
+	flags.BoolVar(
+		&options.prune,
+		"prune",
+		false,
+		"Delete the resources of the kinds listed in the spec file that are not in the spec file.",
+	)
+	flags.BoolVar(
+		&options.dryRun,
+		"dry-run",
+		false,
+		"Print the changes without applying them.",
+	)
+	confirm.AddFlag(flags)
+	return cmd
+}
+
+func ApplyRunner(options *ApplyOptions) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		desired, err := clusterspec.Load(options.file)
+		if err != nil {
+			return err
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+		if cluster.State() != cmv1.ClusterStateReady {
+			return fmt.Errorf("Cluster '%s' is not yet ready. Current state is '%s'", clusterKey, cluster.State())
+		}
+
+		live, err := clusterspec.FetchLiveResources(ctx, r.OCMClient, cluster)
+		if err != nil {
+			return err
+		}
+		changes, err := clusterspec.Plan(cluster, desired, live, options.prune)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			r.Reporter.Infof("Cluster '%s' already matches spec file '%s'", clusterKey, options.file)
+			return nil
+		}
+
+		r.Reporter.Infof("The following changes will be applied to cluster '%s':", clusterKey)
+		for _, change := range changes {
+			fmt.Println(change.String())
+		}
+		if options.dryRun {
+			return nil
+		}
+		if !confirm.Confirm("apply %d changes to cluster '%s'", len(changes), clusterKey) {
+			return nil
+		}
+
+		for _, change := range changes {
+			r.Reporter.Debugf("Applying change '%s'", change)
+			err = change.Apply(ctx, r.OCMClient, cluster.ID())
+			if err != nil {
+				return err
+			}
+		}
+		r.Reporter.Infof("Successfully applied %d changes to cluster '%s'", len(changes), clusterKey)
+		return nil
+	}
+}
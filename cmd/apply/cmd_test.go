@@ -0,0 +1,128 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const resourcesSpec = `apiVersion: rosa.openshift.io/v1alpha1
+kind: Cluster
+spec:
+  name: cluster
+resources:
+  machinePools:
+  - name: infra
+    instanceType: r5.xlarge
+    replicas: 3
+`
+
+var _ = Describe("rosa apply", func() {
+	Context("Create Command", func() {
+		It("Returns Command", func() {
+			cmd := NewApplyCommand()
+			Expect(cmd).NotTo(BeNil())
+
+			Expect(cmd.Use).To(Equal(use))
+			Expect(cmd.Example).To(Equal(example))
+			Expect(cmd.Short).To(Equal(short))
+			Expect(cmd.Long).To(Equal(long))
+			Expect(cmd.Args).NotTo(BeNil())
+			Expect(cmd.Run).NotTo(BeNil())
+
+			for _, flag := range []string{"cluster", "file", "prune", "dry-run", "yes"} {
+				Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil())
+			}
+		})
+	})
+
+	Context("Execute command", func() {
+		var t *TestingRuntime
+		var cmd *cobra.Command
+		var options *ApplyOptions
+		var cluster *cmv1.Cluster
+		var machinePoolsPath string
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			cmd = NewApplyCommand()
+
+			options = &ApplyOptions{file: filepath.Join(GinkgoT().TempDir(), "resources.yaml")}
+			Expect(os.WriteFile(options.file, []byte(resourcesSpec), 0600)).To(Succeed())
+
+			cluster = MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			})
+			t.SetCluster(cluster.Name(), cluster)
+
+			prefix := fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s", cluster.ID())
+			machinePoolsPath = prefix + "/machine_pools"
+			t.ApiServer.RouteToHandler("GET", machinePoolsPath,
+				RespondWithJSON(http.StatusOK, FormatMachinePoolList([]*cmv1.MachinePool{})))
+			t.ApiServer.RouteToHandler("GET", prefix+"/ingresses",
+				RespondWithJSON(http.StatusOK, FormatIngressList([]*cmv1.Ingress{})))
+			t.ApiServer.RouteToHandler("GET", prefix+"/autoscaler",
+				RespondWithJSON(http.StatusNotFound, "{}"))
+			t.ApiServer.RouteToHandler("GET", prefix+"/identity_providers",
+				RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{})))
+			t.ApiServer.RouteToHandler("GET", prefix+"/kubelet_configs",
+				RespondWithJSON(http.StatusOK, FormatKubeletConfigList([]*cmv1.KubeletConfig{})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+		})
+
+		AfterEach(func() {
+			Expect(cmd.Flags().Set("yes", "false")).To(Succeed())
+		})
+
+		It("Returns an error if the spec file can't be read", func() {
+			options.file = filepath.Join(GinkgoT().TempDir(), "missing.yaml")
+
+			err := ApplyRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(ContainSubstring("Failed to read spec file")))
+		})
+
+		It("Prints the plan without applying it", func() {
+			options.dryRun = true
+
+			t.StdOutReader.Record()
+			err := ApplyRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(Equal("INFO: The following changes will be applied to cluster 'cluster':\n" +
+				"+ create machine pool 'infra'\n"))
+		})
+
+		It("Applies the plan", func() {
+			Expect(cmd.Flags().Set("yes", "true")).To(Succeed())
+			machinePool, err := cmv1.NewMachinePool().ID("infra").Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.RouteToHandler("POST", machinePoolsPath,
+				RespondWithJSON(http.StatusCreated, FormatResource(machinePool)))
+
+			t.StdOutReader.Record()
+			err = ApplyRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(ContainSubstring("INFO: Successfully applied 1 changes to cluster 'cluster'\n"))
+		})
+
+		It("Returns the error of a change that fails", func() {
+			Expect(cmd.Flags().Set("yes", "true")).To(Succeed())
+			t.ApiServer.RouteToHandler("POST", machinePoolsPath,
+				RespondWithJSON(http.StatusBadRequest, `{"kind": "Error", "reason": "Invalid instance type"}`))
+
+			err := ApplyRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(ContainSubstring("Failed to create machine pool 'infra'")))
+		})
+	})
+})
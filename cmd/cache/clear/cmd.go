@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clear
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "clear [KEY]..."
+	short = "Remove values from the cache"
+	long  = "Remove values from the cache. Without arguments all the values are removed. Each argument " +
+		"is either a key as shown by 'rosa cache list' or the name of a kind of data, like 'versions', " +
+		"which removes all the values of that kind."
+	example = `  # Remove all the cached values
+  rosa cache clear
+
+  # Remove the cached versions and machine types
+  rosa cache clear versions machine_types`
+)
+
+func NewClearCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.ArbitraryArgs,
+		Run:     rosa.DefaultRunner(rosa.DefaultRuntime(), ClearRunner()),
+	}
+}
+
+func ClearRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, argv []string) error {
+		// A cache file that can't be read is replaced, so that clearing it is always possible:
+		service, _ := cache.NewRosaCacheService()
+		if len(argv) == 0 {
+			err := service.Clear()
+			if err != nil {
+				return fmt.Errorf("Failed to clear the cache: %v", err)
+			}
+			r.Reporter.Infof("Cleared the cache")
+			return nil
+		}
+
+		keys := matchingKeys(service.Items(), argv)
+		if len(keys) == 0 {
+			r.Reporter.Infof("There are no cached values matching '%s'", strings.Join(argv, "', '"))
+			return nil
+		}
+		err := service.Delete(keys...)
+		if err != nil {
+			return fmt.Errorf("Failed to remove values from the cache: %v", err)
+		}
+		r.Reporter.Infof("Removed %d values from the cache", len(keys))
+		return nil
+	}
+}
+
+// matchingKeys returns the keys that are equal to one of the arguments or that belong to the kind of
+// data named by one of them.
+func matchingKeys(items map[string]cache.Item, argv []string) []string {
+	var keys []string
+	for key := range items {
+		for _, arg := range argv {
+			if key == arg || strings.HasPrefix(key, arg+"/") || strings.HasPrefix(key, arg+"@") {
+				keys = append(keys, key)
+				break
+			}
+		}
+	}
+	return keys
+}
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/cache/clear"
+	"github.com/openshift/rosa/cmd/cache/list"
+	"github.com/openshift/rosa/cmd/cache/warm"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Inspect and manage the cache of OCM data",
+	Long: "Inspect and manage the local cache of OCM data that is slow to fetch and rarely changes, " +
+		"like versions, regions, machine types, STS policies, credential requests and version gates. " +
+		"Each kind of data is kept from one hour to one day, under keys that include the OCM server " +
+		"and the account, so that the data of different servers and users is kept apart. The cache " +
+		"also holds the OCM responses used by the '--offline' flag, under 'snapshot/' keys.\n\n" +
+		"Use the global '--no-cache' flag to fetch that data again for a single command. The cache " +
+		"isn't used while recording or replaying requests. Many commands can use the cache at the " +
+		"same time: access to the file is serialized with a lock, and a file that can't be read is " +
+		"discarded.",
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	Cmd.AddCommand(list.NewListCommand())
+	Cmd.AddCommand(clear.NewClearCommand())
+	Cmd.AddCommand(warm.NewWarmCommand())
+}
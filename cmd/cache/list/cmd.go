@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "list"
+	short   = "List the cached values"
+	long    = "List the keys of the values stored in the cache and how long until they expire."
+	example = `  # List the cached values
+  rosa cache list`
+)
+
+func NewListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     use,
+		Aliases: []string{"ls"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.DefaultRuntime(), ListRunner()),
+	}
+}
+
+func ListRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		service, err := cache.NewRosaCacheService()
+		if err != nil {
+			return fmt.Errorf("Failed to read the cache: %v", err)
+		}
+		items := service.Items()
+		if len(items) == 0 {
+			r.Reporter.Infof("The cache is empty")
+			return nil
+		}
+
+		keys := make([]string, 0, len(items))
+		for key := range items {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(writer, "KEY\tEXPIRES IN\n")
+		for _, key := range keys {
+			fmt.Fprintf(writer, "%s\t%s\n", key, expiresIn(items[key]))
+		}
+		return writer.Flush()
+	}
+}
+
+func expiresIn(item cache.Item) string {
+	if item.Expiration.IsZero() {
+		return "never"
+	}
+	return time.Until(item.Expiration).Round(time.Second).String()
+}
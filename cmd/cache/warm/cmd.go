@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package warm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/cassette"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "warm"
+	short = "Fill the cache with fresh OCM data"
+	long  = "Fetch the versions, regions, machine types, STS policies, credential requests and version " +
+		"gates from OCM and store them in the cache, replacing the values that are already there. This " +
+		"is useful before running many commands, for example in a script."
+	example = `  # Fill the cache
+  rosa cache warm`
+)
+
+// policyTypes are the types of STS policies that commands request.
+var policyTypes = []string{"", "AccountRole", "OperatorRole", "OCMRole", "OSDSCPPolicy"}
+
+func NewWarmCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), WarmRunner()),
+	}
+}
+
+func WarmRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if cassette.Enabled() {
+			return fmt.Errorf("The cache isn't used while recording or replaying requests")
+		}
+
+		// Values are fetched even if they are already cached, and the cache is updated with them:
+		cache.SetDisabled(true)
+
+		// Each kind of data is fetched even if fetching another one failed, so that the cache is
+		// filled as much as possible:
+		total, failed := 0, 0
+		warm := func(name string, fetch func() error) {
+			r.Reporter.Debugf("Fetching %s", name)
+			total++
+			err := fetch()
+			if err != nil {
+				r.Reporter.Warnf("Failed to fetch %s: %v", name, err)
+				failed++
+			}
+		}
+
+		minors := map[string]bool{}
+		for _, product := range []string{"", ocm.HcpProduct} {
+			warm(fmt.Sprintf("versions of product '%s'", product), func() error {
+				versions, err := r.OCMClient.GetVersionsWithProduct(product, ocm.DefaultChannelGroup, false)
+				for _, version := range versions {
+					minors[ocm.GetVersionMinor(version.RawID())] = true
+				}
+				return err
+			})
+		}
+		warm("regions", func() error {
+			_, err := r.OCMClient.GetDatabaseRegionList()
+			return err
+		})
+		warm("machine types", func() error {
+			_, err := r.OCMClient.GetMachineTypes()
+			return err
+		})
+		for _, policyType := range policyTypes {
+			warm(fmt.Sprintf("STS policies of type '%s'", policyType), func() error {
+				_, err := r.OCMClient.GetPolicies(policyType)
+				return err
+			})
+		}
+		warm("credential requests", func() error {
+			_, err := r.OCMClient.GetAllCredRequests()
+			return err
+		})
+		sorted := make([]string, 0, len(minors))
+		for minor := range minors {
+			sorted = append(sorted, minor)
+		}
+		sort.Strings(sorted)
+		for _, minor := range sorted {
+			warm(fmt.Sprintf("version gates of version '%s'", minor), func() error {
+				_, err := r.OCMClient.ListAllOcpGates(minor)
+				return err
+			})
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("Failed to fetch %d of %d kinds of OCM data, the others were cached", failed, total)
+		}
+		r.Reporter.Infof("Stored OCM data in the cache, including the version gates of %d versions", len(sorted))
+		return nil
+	}
+}
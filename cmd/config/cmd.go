@@ -22,8 +22,11 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/cmd/config/deletecontext"
 	"github.com/openshift/rosa/cmd/config/get"
+	"github.com/openshift/rosa/cmd/config/getcontexts"
 	"github.com/openshift/rosa/cmd/config/set"
+	"github.com/openshift/rosa/cmd/config/usecontext"
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/properties"
 )
@@ -43,6 +46,10 @@ The following variables are supported:
 
 %s
 
+The settings belong to the current context. Use 'rosa config get-contexts' to list the contexts,
+'rosa config use-context' to switch to another one, or the global '--context' flag to use another
+context for a single command.
+
 Note that "rosa config get access_token" gives whatever the file contains - may be missing or expired;
 you probably want "rosa token" command instead which will obtain a fresh token if needed.
 
@@ -66,6 +73,9 @@ func NewConfigCommand() *cobra.Command {
 	}
 	Cmd.AddCommand(get.Cmd)
 	Cmd.AddCommand(set.Cmd)
+	Cmd.AddCommand(usecontext.Cmd)
+	Cmd.AddCommand(getcontexts.Cmd)
+	Cmd.AddCommand(deletecontext.Cmd)
 	return Cmd
 }
 
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/openshift/rosa/cmd/config/get"
+	"github.com/openshift/rosa/cmd/config/getcontexts"
 	"github.com/openshift/rosa/cmd/config/set"
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/test"
@@ -95,6 +96,17 @@ var _ = Describe("Run Command", Ordered, func() {
 			Expect(err).To(BeNil())
 			Expect(strconv.FormatBool(currentConfig.FedRAMP)).To(Equal(fedramp))
 
+			awsProfile := "MyProfile"
+			err = set.SaveConfig("aws_profile", awsProfile)
+			Expect(err).To(BeNil())
+			currentConfig, err = config.Load()
+			Expect(err).To(BeNil())
+			Expect(currentConfig.AWSProfile).To(Equal(awsProfile))
+
+			err = set.SaveConfig("current_context", "staging")
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("use 'rosa config use-context' instead"))
+
 			insecure = "Incorrect"
 			err = set.SaveConfig("insecure", insecure)
 			Expect(err).NotTo(BeNil())
@@ -145,10 +157,33 @@ var _ = Describe("Run Command", Ordered, func() {
 			Expect(err).To(BeNil())
 			Expect(buf.String()).To(ContainSubstring(strconv.FormatBool(currentConfig.FedRAMP)))
 
+			err = get.PrintConfig("aws_profile")
+			Expect(err).To(BeNil())
+			Expect(buf.String()).To(ContainSubstring(currentConfig.AWSProfile))
+
+			err = get.PrintConfig("current_context")
+			Expect(err).To(BeNil())
+			Expect(buf.String()).To(ContainSubstring(config.DefaultContext))
+
 			err = get.PrintConfig("test")
 			Expect(err).NotTo(BeNil())
 			Expect(err.Error()).To(Equal("'test' is not a supported setting"))
 		})
+
+		It("Prints contexts", func() {
+			contextsBuf := new(bytes.Buffer)
+			getcontexts.Writer = contextsBuf
+			config.SelectContext("staging")
+			err = config.Save(&config.Config{URL: "https://api.stage.openshift.com", AWSRegion: "us-west-2"})
+			config.SelectContext("")
+			Expect(err).To(BeNil())
+
+			err = getcontexts.PrintContexts()
+			Expect(err).To(BeNil())
+			Expect(contextsBuf.String()).To(ContainSubstring("CURRENT  NAME"))
+			Expect(contextsBuf.String()).To(MatchRegexp(`\*\s+default`))
+			Expect(contextsBuf.String()).To(MatchRegexp(`staging\s+https://api.stage.openshift.com\s+us-west-2`))
+		})
 	})
 
 	When("Config file doesn't exist", func() {
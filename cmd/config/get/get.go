@@ -24,6 +24,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -51,7 +52,7 @@ func run(_ *cobra.Command, argv []string) {
 	err := PrintConfig(argv[0])
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 }
 
@@ -98,6 +99,12 @@ func PrintConfig(arg string) error {
 		fmt.Fprintf(Writer, "%s\n", cfg.URL)
 	case "fedramp":
 		fmt.Fprintf(Writer, "%v\n", cfg.FedRAMP)
+	case "aws_profile":
+		fmt.Fprintf(Writer, "%s\n", cfg.AWSProfile)
+	case "aws_region":
+		fmt.Fprintf(Writer, "%s\n", cfg.AWSRegion)
+	case "current_context":
+		fmt.Fprintf(Writer, "%s\n", cfg.Context())
 	default:
 		return fmt.Errorf("'%s' is not a supported setting", arg)
 	}
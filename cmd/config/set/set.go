@@ -18,12 +18,12 @@ package set
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -47,7 +47,7 @@ func run(_ *cobra.Command, argv []string) {
 	err := SaveConfig(argv[0], argv[1])
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 }
 
@@ -89,6 +89,12 @@ func SaveConfig(arg, value string) error {
 		if err != nil {
 			return fmt.Errorf("Failed to set fedramp: %v", value)
 		}
+	case "aws_profile":
+		cfg.AWSProfile = value
+	case "aws_region":
+		cfg.AWSRegion = value
+	case "current_context":
+		return fmt.Errorf("Setting current_context is unsupported, use 'rosa config use-context' instead")
 	default:
 		return fmt.Errorf("'%s' is not a supported setting", arg)
 	}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecontext
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	"github.com/openshift/rosa/pkg/config"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var Cmd = NewConfigUseContextCommand()
+
+func NewConfigUseContextCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use-context [flags] NAME",
+		Short: "Switches to another configuration context",
+		Long: "Makes the given context the current one. The settings of the previous context, including " +
+			"its credentials, are kept so that you can switch back to it later without logging in again.\n\n" +
+			"Contexts are created by logging in with the '--context' flag.",
+		Example: `  # Log in to the staging environment in a new context
+  rosa login --context staging --env staging
+
+  # Make the staging context the current one
+  rosa config use-context staging`,
+		Args: cobra.ExactArgs(1),
+		Run:  run,
+	}
+}
+
+func run(_ *cobra.Command, argv []string) {
+	r := rosa.NewRuntime()
+
+	err := config.UseContext(argv[0])
+	if err != nil {
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to switch to context '%s'", argv[0])))
+	}
+	r.Reporter.Infof("Switched to context '%s'", argv[0])
+}
@@ -17,7 +17,6 @@ limitations under the License.
 package accountroles
 
 import (
-	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -26,6 +25,8 @@ import (
 	"github.com/openshift/rosa/cmd/verify/oc"
 	"github.com/openshift/rosa/cmd/verify/quota"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -145,7 +146,7 @@ func run(cmd *cobra.Command, argv []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// If necessary, call `login` as part of `init`. We do this before
@@ -153,22 +154,20 @@ func run(cmd *cobra.Command, argv []string) {
 	// longer checks.
 	err = login.Call(cmd, argv, r.Reporter)
 	if err != nil {
-		r.Reporter.Errorf("Failed to login to OCM: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to login to OCM")))
 	}
 	r.WithOCM()
 	defer r.Cleanup()
 
 	env, err := ocm.GetEnv()
 	if err != nil {
-		r.Reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to determine OCM environment")))
 	}
 
 	managedPolicies := args.managed
 	if args.forcePolicyCreation && managedPolicies {
 		r.Reporter.Warnf("Forcing creation of policies only works for unmanaged policies")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if args.hostedCP && cmd.Flags().Changed("version") {
@@ -191,18 +190,18 @@ func run(cmd *cobra.Command, argv []string) {
 			managedPolicies = false
 		} else {
 			r.Reporter.Errorf("Setting `hosted-cp` as unmanaged policies is not supported")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
 	if isManagedSet && env == ocm.Production {
 		r.Reporter.Errorf("Classic ROSA managed policies are not supported in this environment")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if isHostedCPValueSet && r.Creator.IsGovcloud {
 		r.Reporter.Errorf("Setting `hosted-cp` is not supported for Govcloud AWS accounts")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Validate AWS credentials for current user
@@ -213,12 +212,12 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		r.OCMClient.LogEvent("ROSAInitCredentialsFailed", nil)
 		r.Reporter.Errorf("Error validating AWS credentials: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if !ok {
 		r.OCMClient.LogEvent("ROSAInitCredentialsInvalid", nil)
 		r.Reporter.Errorf("AWS credentials are invalid")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if r.Reporter.IsTerminal() {
 		r.Reporter.Infof("AWS credentials are valid!")
@@ -243,8 +242,7 @@ func run(cmd *cobra.Command, argv []string) {
 	channelGroup := args.channelGroup
 	policyVersion, err := r.OCMClient.GetPolicyVersion(version, channelGroup)
 	if err != nil {
-		r.Reporter.Errorf("Error getting version: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Error getting version")))
 	}
 
 	r.Reporter.Debugf("Creating account roles compatible with OpenShift versions up to %s", policyVersion)
@@ -262,21 +260,20 @@ func run(cmd *cobra.Command, argv []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid role prefix: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid role prefix")))
 		}
 	}
 	if len(prefix) > 32 {
 		r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if !aws.RoleNameRE.MatchString(prefix) {
 		r.Reporter.Errorf("Expected a valid role prefix matching %s", aws.RoleNameRE.String())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if !args.hostedCP && strings.HasSuffix(prefix, "-HCP") {
 		r.Reporter.Errorf("The '-HCP' suffix is reserved for hosted CP managed policies")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	permissionsBoundary := args.permissionsBoundary
@@ -290,16 +287,16 @@ func run(cmd *cobra.Command, argv []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid policy ARN for permissions boundary")))
 		}
 	}
 
 	if permissionsBoundary != "" {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid policy ARN for permissions boundary")))
 		}
 	}
 
@@ -314,34 +311,31 @@ func run(cmd *cobra.Command, argv []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid path: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid path")))
 		}
 	}
 
 	if path != "" && !aws.ARNPath.MatchString(path) {
 		r.Reporter.Errorf("The specified value for path is invalid. " +
 			"It must begin and end with '/' and contain only alphanumeric characters and/or '/' characters.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if interactive.Enabled() {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Role creation mode")
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid role creation mode")))
 		}
 	}
 
 	if args.forcePolicyCreation && mode != interactive.ModeAuto {
 		r.Reporter.Warnf("Forcing creation of policies only works in auto mode")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	policies, err := r.OCMClient.GetPolicies("AccountRole")
 	if err != nil {
-		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid role creation mode")))
 	}
 
 	createClassic := args.classic
@@ -355,8 +349,7 @@ func run(cmd *cobra.Command, argv []string) {
 			Required: false,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value")))
 		}
 		isClassicValueSet = true
 	}
@@ -370,8 +363,7 @@ func run(cmd *cobra.Command, argv []string) {
 			Required: false,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value")))
 		}
 		isHostedCPValueSet = true
 	}
@@ -379,7 +371,7 @@ func run(cmd *cobra.Command, argv []string) {
 	rolesCreator, createRoles := initCreator(r, managedPolicies, createClassic, createHostedCP,
 		isClassicValueSet, isHostedCPValueSet)
 	if !createRoles {
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	input := buildRolesCreationInput(prefix, permissionsBoundary, r.Creator.AccountID, env, policies,
@@ -396,12 +388,12 @@ func run(cmd *cobra.Command, argv []string) {
 					ocm.Version:    policyVersion,
 					ocm.IsThrottle: "true",
 				})
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			r.OCMClient.LogEvent("ROSACreateAccountRolesModeAuto", map[string]string{
 				ocm.Response: ocm.Failure,
 			})
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		r.OCMClient.LogEvent("ROSACreateAccountRolesModeAuto", map[string]string{
 			ocm.Response: ocm.Success,
@@ -415,12 +407,12 @@ func run(cmd *cobra.Command, argv []string) {
 			r.OCMClient.LogEvent("ROSACreateAccountRolesModeManual", map[string]string{
 				ocm.Response: ocm.Failure,
 			})
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		err = rolesCreator.printCommands(r, input)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if r.Reporter.IsTerminal() {
 			r.Reporter.Infof("All policy files saved to the current directory")
@@ -430,6 +422,6 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 }
@@ -17,12 +17,11 @@ limitations under the License.
 package autoscaler
 
 import (
-	"os"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/clusterautoscaler"
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -71,25 +70,25 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if cluster.Hypershift().Enabled() {
 		r.Reporter.Errorf("Hosted Control Plane clusters do not support cluster-autoscaler configuration")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready. Current state is '%s'", clusterKey, cluster.State())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	autoscaler, err := r.OCMClient.GetClusterAutoscaler(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed getting autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if autoscaler != nil {
 		r.Reporter.Errorf("Autoscaler for cluster '%s' already exists. "+
 			"You should edit it via 'rosa edit autoscaler'", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if !clusterautoscaler.IsAutoscalerSetViaCLI(cmd.Flags(), argsPrefix) && !interactive.Enabled() {
@@ -103,21 +102,21 @@ func run(cmd *cobra.Command, _ []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed creating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	autoscalerConfig, err := clusterautoscaler.CreateAutoscalerConfig(autoscalerArgs)
 	if err != nil {
 		r.Reporter.Errorf("Failed creating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	_, err = r.OCMClient.CreateClusterAutoscaler(cluster.ID(), autoscalerConfig)
 	if err != nil {
 		r.Reporter.Errorf("Failed creating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	r.Reporter.Infof("Successfully created autoscaler configuration for cluster '%s'", cluster.ID())
@@ -24,6 +24,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
@@ -48,11 +49,15 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/clusterautoscaler"
+	"github.com/openshift/rosa/pkg/clusterspec"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
 	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
 	"github.com/openshift/rosa/pkg/helper/roles"
 	"github.com/openshift/rosa/pkg/helper/versions"
+	"github.com/openshift/rosa/pkg/iamplan"
 	"github.com/openshift/rosa/pkg/ingress"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -95,6 +100,9 @@ var args struct {
 	// Watch logs during cluster installation
 	watch bool
 
+	// Path to a declarative cluster spec file
+	fromFile string
+
 	// Simulate creating a cluster
 	dryRun bool
 	// Create a fake cluster with no AWS resources
@@ -237,7 +245,10 @@ func makeCmd() *cobra.Command {
   rosa create cluster --cluster-name=mycluster
 
   # Create a cluster in the us-east-2 region
-  rosa create cluster --cluster-name=mycluster --region=us-east-2`,
+  rosa create cluster --cluster-name=mycluster --region=us-east-2
+
+  # Create a cluster from a spec file, overriding the region
+  rosa create cluster --from-file=cluster.yaml --region=us-east-2`,
 		Run:  run,
 		Args: cobra.NoArgs,
 	}
@@ -282,6 +293,14 @@ func initFlags(cmd *cobra.Command) {
 			"Once set, the cluster domain prefix cannot be changed",
 	)
 
+	flags.StringVar(
+		&args.fromFile,
+		"from-file",
+		"",
+		"Path to a YAML or JSON cluster spec file. Flags given on the command line take precedence "+
+			"over the values in the file.",
+	)
+
 	flags.BoolVar(
 		&args.sts,
 		"sts",
@@ -668,7 +687,8 @@ func initFlags(cmd *cobra.Command) {
 		&args.dryRun,
 		"dry-run",
 		false,
-		"Simulate creating the cluster.",
+		"Simulate creating the cluster. For STS clusters, also lists the IAM roles, policies and "+
+			"OIDC resources the cluster requires, along with whether they exist, are missing or are outdated.",
 	)
 
 	flags.BoolVar(
@@ -853,6 +873,15 @@ func networkTypeCompletion(cmd *cobra.Command, args []string, toComplete string)
 }
 
 func run(cmd *cobra.Command, _ []string) {
+	// The spec file has to be applied before the runtime is created, as it can set the region
+	if args.fromFile != "" {
+		err := applySpecFile(cmd, args.fromFile)
+		if err != nil {
+			reporter.CreateReporter().Errorf("%v", err)
+			cmdcontext.Exit(1)
+		}
+	}
+
 	r := rosa.NewRuntime().WithAWS().WithOCM()
 	defer r.Cleanup()
 
@@ -860,14 +889,14 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	for _, val := range userSpecifiedAutoscalerValues {
 		if val.Changed && !args.autoscalingEnabled {
 			r.Reporter.Errorf("Using autoscaling flag '%s', requires flag '--enable-autoscaling'. "+
 				"Please try again with flag", val.Name)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -875,7 +904,7 @@ func run(cmd *cobra.Command, _ []string) {
 	isHostedCP := args.hostedClusterEnabled
 	if isHostedCP && fedramp.Enabled() {
 		r.Reporter.Errorf("Fedramp does not currently support Hosted Control Plane clusters. Please use classic")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	supportedRegions, err := r.OCMClient.GetDatabaseRegionList()
@@ -887,8 +916,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	awsCreator, err := awsClient.GetCreator()
 	if err != nil {
-		r.Reporter.Errorf("Unable to get IAM credentials: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Unable to get IAM credentials")))
 	}
 
 	shardPinningEnabled := false
@@ -937,8 +965,7 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid cluster name: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid cluster name")))
 		}
 	}
 
@@ -949,7 +976,7 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Cluster name must consist"+
 			" of no more than %d lowercase alphanumeric characters or '-', "+
 			"start with a letter, and end with an alphanumeric character.", ocm.MaxClusterNameLength)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Get cluster domain prefix
@@ -966,8 +993,7 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid domain prefix: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid domain prefix")))
 		}
 	}
 
@@ -978,7 +1004,7 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Domain prefix must consist"+
 			" of no more than %d lowercase alphanumeric characters or '-', "+
 			"start with a letter, and end with an alphanumeric character.", ocm.MaxClusterDomainPrefixLength)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if clusterHasLongNameWithoutDomainPrefix(clusterName, domainPrefix) {
@@ -989,7 +1015,7 @@ func run(cmd *cobra.Command, _ []string) {
 			r.Reporter.Warnf("You opted out from creating a cluster with an autogenerated " +
 				"sub-domain for your cluster on openshiftapps.com. To customise the sub-domain" +
 				", use the '--domain-prefix' flag")
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 	}
 
@@ -1001,8 +1027,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Required: false,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid --hosted-cp value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid --hosted-cp value")))
 		}
 	}
 
@@ -1010,7 +1035,7 @@ func run(cmd *cobra.Command, _ []string) {
 		techPreviewMsg, err := r.OCMClient.GetTechnologyPreviewMessage(ocm.HcpProduct, time.Now())
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if techPreviewMsg != "" {
 			r.Reporter.Infof(techPreviewMsg)
@@ -1031,20 +1056,20 @@ func run(cmd *cobra.Command, _ []string) {
 			clusterAdminPassword, err = idputils.GenerateRandomPassword()
 			if err != nil {
 				r.Reporter.Errorf("Failed to generate a random password")
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 		// validates both user inputted custom password and randomly generated password
 		err = passwordValidator.PasswordValidator(clusterAdminPassword)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if clusterAdminUser != "" {
 			err = idp.UsernameValidator(clusterAdminUser)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		} else {
 			clusterAdminUser = admin.ClusterAdminUsername
@@ -1059,8 +1084,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Required: true,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value")))
 		}
 		if isClusterAdmin {
 			//clusterAdminUser = idp.GetIdpUserNameFromPrompt(cmd, r, "cluster-admin-user", clusterAdminUser, true)
@@ -1070,14 +1094,13 @@ func run(cmd *cobra.Command, _ []string) {
 				Required: true,
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid value: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value")))
 			}
 			if !isCustomAdminPassword {
 				clusterAdminPassword, err = idputils.GenerateRandomPassword()
 				if err != nil {
 					r.Reporter.Errorf("Failed to generate a random password")
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 			} else {
 				clusterAdminPassword = idp.GetIdpPasswordFromPrompt(cmd, r,
@@ -1090,7 +1113,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if isHostedCP && cmd.Flags().Changed(arguments.NewDefaultMPLabelsFlag) {
 		r.Reporter.Errorf("Setting the worker machine pool labels is not supported for hosted clusters")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Billing Account
@@ -1099,7 +1122,7 @@ func run(cmd *cobra.Command, _ []string) {
 		isHcpBillingTechPreview, err := r.OCMClient.IsTechnologyPreview(ocm.HcpBillingAccount, time.Now())
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		if !isHcpBillingTechPreview {
@@ -1107,13 +1130,13 @@ func run(cmd *cobra.Command, _ []string) {
 			if billingAccount != "" && !ocm.IsValidAWSAccount(billingAccount) {
 				r.Reporter.Errorf("Billing account is invalid. Run the command again with a valid billing account. %s",
 					listBillingAccountMessage)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 
 			cloudAccounts, err := r.OCMClient.GetBillingAccounts()
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 
 			billingAccounts := ocm.GenerateBillingAccountsList(cloudAccounts)
@@ -1143,7 +1166,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 					if err != nil {
 						r.Reporter.Errorf("Expected a valid billing account: '%s'", err)
-						os.Exit(1)
+						cmdcontext.Exit(1)
 					}
 
 					billingAccount = aws.ParseOption(billingAccount)
@@ -1152,7 +1175,7 @@ func run(cmd *cobra.Command, _ []string) {
 				err := validateBillingAccount(billingAccount)
 				if err != nil {
 					r.Reporter.Errorf("%v", err)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 
 				// Get contract info
@@ -1180,7 +1203,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if !isHostedCP && billingAccount != "" {
 		r.Reporter.Errorf("Billing accounts are only supported for Hosted Control Plane clusters")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	externalAuthProvidersEnabled := args.externalAuthProvidersEnabled
@@ -1189,7 +1212,7 @@ func run(cmd *cobra.Command, _ []string) {
 			r.Reporter.Errorf(
 				"External authentication configuration is only supported for a Hosted Control Plane cluster.",
 			)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -1197,7 +1220,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if etcdEncryptionKmsARN != "" && !isHostedCP {
 		r.Reporter.Errorf("etcd encryption kms arn is only allowed for hosted cp")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// all hosted clusters are sts
@@ -1206,7 +1229,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if isSTS && isIAM {
 		r.Reporter.Errorf("Can't use both STS and mint mode at the same time.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if interactive.Enabled() && (!isSTS && !isIAM) {
@@ -1217,8 +1240,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Required: true,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid --sts value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid --sts value")))
 		}
 		isIAM = !isSTS
 	}
@@ -1235,8 +1257,8 @@ func run(cmd *cobra.Command, _ []string) {
 	if permissionsBoundary != "" {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid policy ARN for permissions boundary")))
 		}
 	}
 
@@ -1244,12 +1266,12 @@ func run(cmd *cobra.Command, _ []string) {
 		if awsCreator.IsSTS {
 			r.Reporter.Errorf("Since your AWS credentials are returning an STS ARN you can only " +
 				"create STS clusters. Otherwise, switch to IAM credentials.")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		err := awsClient.CheckAdminUserExists(aws.AdminUserName)
 		if err != nil {
 			r.Reporter.Errorf("IAM user '%s' does not exist. Run `rosa init` first", aws.AdminUserName)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		r.Reporter.Debugf("IAM user is valid!")
 	}
@@ -1266,7 +1288,7 @@ func run(cmd *cobra.Command, _ []string) {
 	defaultVersion, versionList, err := versions.GetVersionList(r, channelGroup, isSTS, isHostedCP, isHostedCP, true)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if version == "" {
 		version = defaultVersion
@@ -1280,19 +1302,17 @@ func run(cmd *cobra.Command, _ []string) {
 			Required: true,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid OpenShift version: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid OpenShift version")))
 		}
 	}
 	version, err = r.OCMClient.ValidateVersion(version, versionList, channelGroup, isSTS, isHostedCP)
 	if err != nil {
-		r.Reporter.Errorf("Expected a valid OpenShift version: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid OpenShift version")))
 	}
 	if err := r.OCMClient.IsVersionCloseToEol(ocm.CloseToEolDays, version, channelGroup); err != nil {
 		r.Reporter.Warnf("%v", err)
 		if !confirm.Confirm("continue with version '%s'", ocm.GetRawVersionId(version)) {
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 	}
 
@@ -1309,17 +1329,16 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  httpTokens,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid http tokens value : %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid http tokens value ")))
 		}
 	}
 	if err = ocm.ValidateHttpTokensValue(httpTokens); err != nil {
 		r.Reporter.Errorf("Expected a valid http tokens value : %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if err := ocm.ValidateHttpTokensVersion(ocm.GetVersionMinor(version), httpTokens); err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// warn if mode is used for non sts cluster
@@ -1333,7 +1352,7 @@ func run(cmd *cobra.Command, _ []string) {
 		isValidMode := arguments.IsValidMode(interactive.Modes, mode)
 		if !isValidMode {
 			r.Reporter.Errorf("Invalid --mode '%s'. Allowed values are %s", mode, interactive.Modes)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -1341,14 +1360,14 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Cannot watch for STS cluster installation logs in mode 'auto' " +
 			"without also supplying '--yes' option." +
 			"To watch your cluster installation logs, run 'rosa logs install' instead after the cluster has began creating.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if args.watch && isSTS && mode == interactive.ModeManual {
 		r.Reporter.Errorf("Cannot watch for STS cluster installation logs in mode 'manual'." +
 			"It requires manual commands to be performed as part of the process." +
 			"To watch your cluster installation logs, run 'rosa logs install' after the cluster has began creating.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	hasRoles := false
@@ -1364,8 +1383,7 @@ func run(cmd *cobra.Command, _ []string) {
 			roleARNs, err = awsClient.FindRoleARNsClassic(aws.InstallerAccountRole, minor)
 		}
 		if err != nil {
-			r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to find %s role", role.Name)))
 		}
 
 		if len(roleARNs) > 1 {
@@ -1403,8 +1421,7 @@ func run(cmd *cobra.Command, _ []string) {
 					Required: true,
 				})
 				if err != nil {
-					r.Reporter.Errorf("Expected a valid role ARN: %s", err)
-					os.Exit(1)
+					cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid role ARN")))
 				}
 			}
 		} else if len(roleARNs) == 1 {
@@ -1427,8 +1444,8 @@ func run(cmd *cobra.Command, _ []string) {
 			// check if role has hosted cp policy via AWS tag value
 			hostedCPPolicies, err := awsClient.HasHostedCPPolicies(roleARN)
 			if err != nil {
-				r.Reporter.Errorf("Failed to determine if cluster has hosted CP policies: %v", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"Failed to determine if cluster has hosted CP policies")))
 			}
 			hasRoles = true
 			for roleType, role := range aws.AccountRoles {
@@ -1446,15 +1463,14 @@ func run(cmd *cobra.Command, _ []string) {
 					roleARNs, err = awsClient.FindRoleARNsClassic(roleType, minor)
 				}
 				if err != nil {
-					r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-					os.Exit(1)
+					cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to find %s role", role.Name)))
 				}
 				selectedARN := ""
 				expectedResourceIDForAccRole, rolePrefix, err := getExpectedResourceIDForAccRole(
 					hostedCPPolicies, roleARN, roleType)
 				if err != nil {
 					r.Reporter.Errorf("Failed to get the expected resource ID for role type: %s", roleType)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 				r.Reporter.Debugf(
 					"Using '%s' as the role prefix to retrieve the expected resource ID for role type '%s'",
@@ -1466,7 +1482,7 @@ func run(cmd *cobra.Command, _ []string) {
 					resourceId, err := aws.GetResourceIdFromARN(rARN)
 					if err != nil {
 						r.Reporter.Errorf("Failed to get resource ID from arn. %s", err)
-						os.Exit(1)
+						cmdcontext.Exit(1)
 					}
 					lowerCaseResourceIdToCheck := strings.ToLower(resourceId)
 					if lowerCaseResourceIdToCheck == expectedResourceIDForAccRole {
@@ -1514,16 +1530,14 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid ARN: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid ARN")))
 		}
 	}
 
 	if roleARN != "" {
 		err = aws.ARNValidator(roleARN)
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid Role ARN: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid Role ARN")))
 		}
 		isSTS = true
 	}
@@ -1543,8 +1557,7 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid External ID: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid External ID")))
 		}
 	}
 
@@ -1563,19 +1576,17 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid ARN: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid ARN")))
 		}
 	}
 	if supportRoleARN != "" {
 		err = aws.ARNValidator(supportRoleARN)
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid Support Role ARN: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid Support Role ARN")))
 		}
 	} else if roleARN != "" {
 		r.Reporter.Errorf("Support Role ARN is required: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Instance IAM Roles
@@ -1595,19 +1606,18 @@ func run(cmd *cobra.Command, _ []string) {
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid control plane IAM role ARN: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid control plane IAM role ARN")))
 			}
 		}
 		if controlPlaneRoleARN != "" {
 			err = aws.ARNValidator(controlPlaneRoleARN)
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid control plane instance IAM role ARN: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"Expected a valid control plane instance IAM role ARN")))
 			}
 		} else if roleARN != "" {
 			r.Reporter.Errorf("Control plane instance IAM role ARN is required: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -1627,19 +1637,17 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid worker IAM role ARN: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid worker IAM role ARN")))
 		}
 	}
 	if workerRoleARN != "" {
 		err = aws.ARNValidator(workerRoleARN)
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid worker instance IAM role ARN: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid worker instance IAM role ARN")))
 		}
 	} else if roleARN != "" {
 		r.Reporter.Errorf("Worker instance IAM role ARN is required: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// combine role arns to list
@@ -1652,33 +1660,30 @@ func run(cmd *cobra.Command, _ []string) {
 
 	managedPolicies, err := awsClient.HasManagedPolicies(roleARN)
 	if err != nil {
-		r.Reporter.Errorf("Failed to determine if cluster has managed policies: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to determine if cluster has managed policies")))
 	}
 	// check if role has hosted cp policy via AWS tag value
 	hostedCPPolicies, err := awsClient.HasHostedCPPolicies(roleARN)
 	if err != nil {
-		r.Reporter.Errorf("Failed to determine if cluster has hosted CP policies: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to determine if cluster has hosted CP policies")))
 	}
 
 	if managedPolicies {
 		rolePrefix, err := getAccountRolePrefix(hostedCPPolicies, roleARN, aws.InstallerAccountRole)
 		if err != nil {
-			r.Reporter.Errorf("Failed to find prefix from account role: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to find prefix from account role")))
 		}
 
 		err = roles.ValidateAccountRolesManagedPolicies(r, rolePrefix, hostedCPPolicies)
 		if err != nil {
-			r.Reporter.Errorf("Failed while validating account roles: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed while validating account roles")))
 		}
 	} else {
 		err = roles.ValidateUnmanagedAccountRoles(roleARNs, awsClient, version)
 		if err != nil {
-			r.Reporter.Errorf("Failed while validating account roles: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed while validating account roles")))
 		}
 	}
 
@@ -1703,46 +1708,46 @@ func run(cmd *cobra.Command, _ []string) {
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a prefix for the operator IAM roles")))
 			}
 		}
 		if len(operatorRolesPrefix) == 0 {
 			r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if len(operatorRolesPrefix) > 32 {
 			r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if !aws.RoleNameRE.MatchString(operatorRolesPrefix) {
 			r.Reporter.Errorf("Expected valid operator roles prefix matching %s", aws.RoleNameRE.String())
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		credRequests, err := r.OCMClient.GetAllCredRequests()
 		if err != nil {
 			r.Reporter.Errorf("Error getting operator credential request from OCM %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		operatorRoles, err = r.AWSClient.GetOperatorRolesFromAccountByPrefix(operatorRolesPrefix, credRequests)
 		if err != nil {
-			r.Reporter.Errorf("There was a problem retrieving the Operator Roles from AWS: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"There was a problem retrieving the Operator Roles from AWS")))
 		}
 	}
 
 	var oidcConfig *v1.OidcConfig
+	var credRequests map[string]*v1.STSOperator
+	var accRolesPrefix string
 	if isSTS {
-		credRequests, err := r.OCMClient.GetCredRequests(isHostedCP)
+		credRequests, err = r.OCMClient.GetCredRequests(isHostedCP)
 		if err != nil {
 			r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		accRolesPrefix, err := getAccountRolePrefix(hostedCPPolicies, roleARN, aws.InstallerAccountRole)
+		accRolesPrefix, err = getAccountRolePrefix(hostedCPPolicies, roleARN, aws.InstallerAccountRole)
 		if err != nil {
-			r.Reporter.Errorf("Failed to find prefix from account role: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to find prefix from account role")))
 		}
 		if expectedOperatorRolePath != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 			r.Reporter.Infof("ARN path '%s' detected. This ARN path will be used for subsequent"+
@@ -1755,7 +1760,7 @@ func run(cmd *cobra.Command, _ []string) {
 				isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(version), operator.MinVersion())
 				if err != nil {
 					r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 				if !isSupported {
 					continue
@@ -1776,13 +1781,13 @@ func run(cmd *cobra.Command, _ []string) {
 				if !strings.Contains(role, ",") {
 					r.Reporter.Errorf("Expected operator IAM roles to be a comma-separated " +
 						"list of name,namespace,role_arn")
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 				roleData := strings.Split(role, ",")
 				if len(roleData) != 3 {
 					r.Reporter.Errorf("Expected operator IAM roles to be a comma-separated " +
 						"list of name,namespace,role_arn")
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 				computedOperatorIamRoleList = append(computedOperatorIamRoleList, ocm.OperatorIAMRole{
 					Name:      roleData[0],
@@ -1796,20 +1801,20 @@ func run(cmd *cobra.Command, _ []string) {
 		if err != nil {
 			if !oidcConfig.Reusable() {
 				r.Reporter.Errorf("%v", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			} else {
 				err = ocm.ValidateOperatorRolesMatchOidcProvider(r.Reporter, awsClient, computedOperatorIamRoleList,
 					oidcConfig.IssuerUrl(), ocm.GetVersionMinor(version), expectedOperatorRolePath, managedPolicies, true)
 				if err != nil {
 					r.Reporter.Errorf("%v", err)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 			}
 		}
 		err = validateUniqueIamRoleArnsForStsCluster(roleARNs, computedOperatorIamRoleList)
 		if err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -1827,8 +1832,7 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid set of tags: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid set of tags")))
 		}
 		if len(tagsInput) > 0 {
 			_tags = strings.Split(tagsInput, ",")
@@ -1837,7 +1841,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if len(_tags) > 0 {
 		if err := aws.UserTagValidator(_tags); err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		delim := aws.GetTagsDelimiter(_tags)
 		for _, tag := range _tags {
@@ -1855,8 +1859,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  multiAZ,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid multi-AZ value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid multi-AZ value")))
 		}
 	}
 
@@ -1873,8 +1876,7 @@ func run(cmd *cobra.Command, _ []string) {
 	// Get AWS region
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
-		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Error getting region")))
 	}
 	// Filter regions by OCP version for displaying in interactive mode
 	var versionFilter string
@@ -1887,11 +1889,11 @@ func run(cmd *cobra.Command, _ []string) {
 		awsClient, isHostedCP, shardPinningEnabled)
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if region == "" {
 		r.Reporter.Errorf("Expected a valid AWS region")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	} else if found := helper.Contains(regionList, region); isHostedCP && !shardPinningEnabled && !found {
 		r.Reporter.Warnf("Region '%s' not currently available for Hosted Control Plane cluster.", region)
 		interactive.Enable()
@@ -1906,18 +1908,17 @@ func run(cmd *cobra.Command, _ []string) {
 			Required: true,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid AWS region: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid AWS region")))
 		}
 	}
 	if supportsMultiAZ, found := regionAZ[region]; found {
 		if !supportsMultiAZ && multiAZ {
 			r.Reporter.Errorf("Region '%s' does not support multiple availability zones", region)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	} else {
 		r.Reporter.Errorf("Region '%s' is not supported for this AWS account", region)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	awsClient, err = aws.NewClient().
@@ -1926,8 +1927,7 @@ func run(cmd *cobra.Command, _ []string) {
 		UseLocalCredentials(args.useLocalCredentials).
 		Build()
 	if err != nil {
-		r.Reporter.Errorf("Failed to create awsClient: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to create awsClient")))
 	}
 	r.AWSClient = awsClient
 
@@ -1949,14 +1949,13 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  privateLink || (isSTS && args.private),
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid private-link value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid private-link value")))
 		}
 	} else if (privateLink || (isSTS && private)) && !fedramp.Enabled() && !isPrivateHostedCP {
 		// do not prompt users for privatelink if it is private hosted cluster
 		r.Reporter.Warnf("You are choosing to use AWS PrivateLink for your cluster. %s", privateLinkWarning)
 		if !confirm.Confirm("use AWS PrivateLink for cluster '%s'", clusterName) {
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 		privateLink = true
 	}
@@ -1965,7 +1964,7 @@ func run(cmd *cobra.Command, _ []string) {
 		private = true
 	} else if isSTS && private {
 		r.Reporter.Errorf("Private STS clusters are only supported through AWS PrivateLink")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	} else if !isSTS {
 		privateWarning := "You will not be able to access your cluster until " +
 			"you edit network settings in your cloud provider."
@@ -1976,20 +1975,19 @@ func run(cmd *cobra.Command, _ []string) {
 				Default:  private,
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid private value: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid private value")))
 			}
 		} else if private {
 			r.Reporter.Warnf("You are choosing to make your cluster private. %s", privateWarning)
 			if !confirm.Confirm("set cluster '%s' as private", clusterName) {
-				os.Exit(0)
+				cmdcontext.Exit(0)
 			}
 		}
 	}
 
 	if isSTS && private && !privateLink {
 		r.Reporter.Errorf("Private STS clusters are only supported through AWS PrivateLink")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if privateLink || isHostedCP {
@@ -2017,7 +2015,7 @@ func run(cmd *cobra.Command, _ []string) {
 		GetDefaultClusterFlavors(args.flavour)
 	if dMachinecidr == nil || dPodcidr == nil || dServicecidr == nil {
 		r.Reporter.Errorf("Error retrieving default cluster flavors")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Machine CIDR:
@@ -2032,8 +2030,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  machineCIDR,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid CIDR value")))
 		}
 	}
 
@@ -2049,8 +2046,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  serviceCIDR,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid CIDR value")))
 		}
 	}
 	// Pod CIDR:
@@ -2065,8 +2061,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  podCIDR,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid CIDR value")))
 		}
 	}
 
@@ -2088,14 +2083,13 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  useExistingVPC,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value")))
 		}
 	}
 
 	if isHostedCP && !subnetsProvided && !useExistingVPC {
 		r.Reporter.Errorf("All hosted clusters need a pre-configured VPC. Make sure to specify the subnet ids")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// For hosted cluster we will need the number of the private subnets the users has selected
@@ -2107,8 +2101,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if useExistingVPC || subnetsProvided {
 		initialSubnets, err := getInitialValidSubnets(awsClient, subnetIDs, r.Reporter)
 		if err != nil {
-			r.Reporter.Errorf("Failed to get the list of subnets: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get the list of subnets")))
 		}
 		if subnetsProvided {
 			useExistingVPC = true
@@ -2116,18 +2109,18 @@ func run(cmd *cobra.Command, _ []string) {
 		_, machineNetwork, err := net.ParseCIDR(machineCIDR.String())
 		if err != nil {
 			r.Reporter.Errorf("Unable to parse machine CIDR")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		_, serviceNetwork, err := net.ParseCIDR(serviceCIDR.String())
 		if err != nil {
 			r.Reporter.Errorf("Unable to parse service CIDR")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		var filterError error
 		subnets, filterError = filterCidrRangeSubnets(initialSubnets, machineNetwork, serviceNetwork, r)
 		if filterError != nil {
 			r.Reporter.Errorf("%s", filterError)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if privateLink {
 			subnets = filterPrivateSubnets(subnets, r)
@@ -2139,10 +2132,10 @@ func run(cmd *cobra.Command, _ []string) {
 					"All Hosted Control Plane clusters need a pre-configured VPC. Please check: %s",
 					createVpcForHcpDoc,
 				)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			if ok := confirm.Prompt(false, "Continue with default? A new RH Managed VPC will be created for your cluster"); !ok {
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			useExistingVPC = false
 			subnetsProvided = false
@@ -2163,7 +2156,7 @@ func run(cmd *cobra.Command, _ []string) {
 				if !verifiedSubnet {
 					r.Reporter.Errorf("Could not find the following subnet provided in region '%s': %s",
 						r.AWSClient.GetRegion(), subnetArg)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 			}
 		}
@@ -2210,8 +2203,7 @@ func run(cmd *cobra.Command, _ []string) {
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected valid subnet IDs: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected valid subnet IDs")))
 			}
 			for i, subnet := range subnetIDs {
 				subnetIDs[i] = aws.ParseOption(subnet)
@@ -2230,7 +2222,7 @@ func run(cmd *cobra.Command, _ []string) {
 			}
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 
@@ -2256,7 +2248,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if len(subnetIDs) == 0 && isSharedVPC {
 		r.Reporter.Errorf("Installing a cluster into a shared VPC is only supported for BYO VPC clusters")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if isSubnetBelongToSharedVpc(r, awsCreator.AccountID, subnetIDs, mapSubnetIDToSubnet) {
@@ -2269,19 +2261,19 @@ func run(cmd *cobra.Command, _ []string) {
 			privateHostedZoneID, err = getPrivateHostedZoneID(cmd, privateHostedZoneID)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 
 			sharedVPCRoleARN, err = getSharedVpcRoleArn(cmd, sharedVPCRoleARN)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 
 			baseDomain, err = getBaseDomain(r, cmd, baseDomain)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 	}
@@ -2301,21 +2293,21 @@ func run(cmd *cobra.Command, _ []string) {
 				Required: false,
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid value for select-availability-zones: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"Expected a valid value for select-availability-zones")))
 			}
 
 			if selectAvailabilityZones {
 				optionsAvailabilityZones, err := awsClient.DescribeAvailabilityZones()
 				if err != nil {
-					r.Reporter.Errorf("Failed to get the list of the availability zone: %s", err)
-					os.Exit(1)
+					cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+						"Failed to get the list of the availability zone")))
 				}
 
 				availabilityZones, err = selectAvailabilityZonesInteractively(cmd, optionsAvailabilityZones, multiAZ)
 				if err != nil {
 					r.Reporter.Errorf("%s", err)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 			}
 		}
@@ -2324,7 +2316,7 @@ func run(cmd *cobra.Command, _ []string) {
 			err = validateAvailabilityZones(multiAZ, availabilityZones, awsClient)
 			if err != nil {
 				r.Reporter.Errorf(fmt.Sprintf("%s", err))
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 	}
@@ -2343,8 +2335,8 @@ func run(cmd *cobra.Command, _ []string) {
 			Required: false,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value for enable-customer-managed-key: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid value for enable-customer-managed-key")))
 		}
 	}
 
@@ -2359,15 +2351,13 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value for kms-key-arn: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value for kms-key-arn")))
 		}
 	}
 
 	err = kmsArnRegexpValidator.ValidateKMSKeyARN(&kmsKeyARN)
 	if err != nil {
-		r.Reporter.Errorf("Expected a valid value for kms-key-arn: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value for kms-key-arn")))
 	}
 
 	// Compute node instance type:
@@ -2376,7 +2366,7 @@ func run(cmd *cobra.Command, _ []string) {
 		awsClient, externalID)
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if computeMachineType == "" {
 		computeMachineType = defaultComputeMachineType
@@ -2389,14 +2379,12 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  computeMachineType,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid machine type: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid machine type")))
 		}
 	}
 	err = computeMachineTypeList.ValidateMachineType(computeMachineType, multiAZ)
 	if err != nil {
-		r.Reporter.Errorf("Expected a valid machine type: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid machine type")))
 	}
 
 	isAutoscalingSet := cmd.Flags().Changed("enable-autoscaling")
@@ -2412,8 +2400,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Required: false,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value for enable-autoscaling: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value for enable-autoscaling")))
 		}
 	}
 
@@ -2436,7 +2423,7 @@ func run(cmd *cobra.Command, _ []string) {
 		// if the user set compute-nodes and enabled autoscaling
 		if isReplicasSet {
 			r.Reporter.Errorf("Compute-nodes can't be set when autoscaling is enabled")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if interactive.Enabled() || !isMinReplicasSet {
 			minReplicas, err = interactive.GetInt(interactive.Input{
@@ -2449,14 +2436,13 @@ func run(cmd *cobra.Command, _ []string) {
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid number of min replicas: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid number of min replicas")))
 			}
 		}
 		err = minReplicaValidator(multiAZ, isHostedCP, privateSubnetsCount)(minReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		if interactive.Enabled() || !isMaxReplicasSet {
@@ -2470,27 +2456,26 @@ func run(cmd *cobra.Command, _ []string) {
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid number of max replicas: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid number of max replicas")))
 			}
 		}
 		err = maxReplicaValidator(multiAZ, minReplicas, isHostedCP, privateSubnetsCount)(maxReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		if isHostedCP {
 			if clusterautoscaler.IsAutoscalerSetViaCLI(cmd.Flags(), clusterAutoscalerFlagsPrefix) {
 				r.Reporter.Errorf("Hosted Control Plane clusters do not support cluster-autoscaler configuration")
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		} else {
 			clusterAutoscaler, err = clusterautoscaler.GetAutoscalerOptions(
 				cmd.Flags(), clusterAutoscalerFlagsPrefix, true, autoscalerArgs)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 	}
@@ -2505,7 +2490,7 @@ func run(cmd *cobra.Command, _ []string) {
 		// if the user set min/max replicas and hasn't enabled autoscaling
 		if isMinReplicasSet || isMaxReplicasSet {
 			r.Reporter.Errorf("Autoscaling must be enabled in order to set min and max replicas")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		if interactive.Enabled() {
@@ -2518,14 +2503,13 @@ func run(cmd *cobra.Command, _ []string) {
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid number of compute nodes: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid number of compute nodes")))
 			}
 		}
 		err = minReplicaValidator(multiAZ, isHostedCP, privateSubnetsCount)(computeNodes)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -2541,21 +2525,21 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid comma-separated list of attributes")))
 		}
 	}
 	labelMap, err := mpHelpers.ParseLabels(labels)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	isVersionCompatibleComputeSgIds, err := versions.IsGreaterThanOrEqual(
 		version, ocm.MinVersionForAdditionalComputeSecurityGroupIdsDay1)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem checking version compatibility")))
 	}
 	additionalComputeSecurityGroupIds := args.additionalComputeSecurityGroupIds
 	getSecurityGroups(r, cmd, isVersionCompatibleComputeSgIds,
@@ -2576,13 +2560,13 @@ func run(cmd *cobra.Command, _ []string) {
 	expiration, err := validateExpiration()
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Network Type:
 	if err := validateNetworkType(args.networkType); err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if cmd.Flags().Changed("network-type") && interactive.Enabled() {
 		args.networkType, err = interactive.GetOption(interactive.Input{
@@ -2592,8 +2576,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  args.networkType,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid network type: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid network type")))
 		}
 	}
 
@@ -2612,31 +2595,30 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid host prefix value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid host prefix value")))
 		}
 	}
 	err = hostPrefixValidator(hostPrefix)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	machinePoolRootDisk, err := getMachinePoolRootDisk(r, cmd, version,
 		isHostedCP, defaultMachinePoolRootDiskSize)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// No CNI
 	if cmd.Flags().Changed("no-cni") && !isHostedCP {
 		r.Reporter.Errorf("Disabling CNI is supported only for Hosted Control Planes")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if cmd.Flags().Changed("no-cni") && cmd.Flags().Changed("network-type") {
 		r.Reporter.Errorf("--no-cni and --network-type are mutually exclusive parameters")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	noCni := args.noCni
 	if cmd.Flags().Changed("no-cni") && interactive.Enabled() {
@@ -2646,14 +2628,13 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  noCni,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value for no CNI: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value for no CNI")))
 		}
 	}
 
 	if cmd.Flags().Changed("fips") && isHostedCP {
 		r.Reporter.Errorf("FIPS support not available for Hosted Control Plane clusters")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	fips := args.fips || fedramp.Enabled()
 	if interactive.Enabled() && !fedramp.Enabled() && !isHostedCP {
@@ -2663,8 +2644,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  fips,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid FIPS value: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid FIPS value")))
 		}
 	}
 
@@ -2674,7 +2654,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if etcdEncryptionKmsARN != "" {
 		if cmd.Flags().Changed("etcd-encryption") && !etcdEncryption {
 			r.Reporter.Errorf("etcd encryption cannot be disabled when encryption kms arn is provided")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		} else {
 			etcdEncryption = true
 		}
@@ -2687,14 +2667,13 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  etcdEncryption,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid etcd-encryption value: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid etcd-encryption value")))
 		}
 	}
 	if fips {
 		if cmd.Flags().Changed("etcd-encryption") && !etcdEncryption {
 			r.Reporter.Errorf("etcd encryption cannot be disabled on clusters with FIPS mode")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		} else {
 			etcdEncryption = true
 		}
@@ -2711,8 +2690,8 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value for etcd-encryption-kms-arn: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid value for etcd-encryption-kms-arn")))
 		}
 	}
 
@@ -2722,7 +2701,7 @@ func run(cmd *cobra.Command, _ []string) {
 			"Expected a valid value for etcd-encryption-kms-arn matching %s",
 			kmsArnRegexpValidator.KmsArnRE,
 		)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	disableWorkloadMonitoring := args.disableWorkloadMonitoring
@@ -2733,8 +2712,8 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  disableWorkloadMonitoring,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid disable-workload-monitoring value: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid disable-workload-monitoring value")))
 		}
 	}
 
@@ -2747,8 +2726,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Default: enableProxy,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid proxy-enabled value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid proxy-enabled value")))
 		}
 	}
 
@@ -2762,14 +2740,13 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid http proxy: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid http proxy")))
 		}
 	}
 	err = ocm.ValidateHTTPProxy(httpProxy)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if enableProxy && interactive.Enabled() {
@@ -2782,14 +2759,13 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid https proxy: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid https proxy")))
 		}
 	}
 	err = interactive.IsURL(httpsProxy)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if enableProxy && interactive.Enabled() {
@@ -2803,8 +2779,8 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid set of no proxy domains/CIDR's: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid set of no proxy domains/CIDR's")))
 		}
 		noProxySlice = helper.HandleEmptyStringOnSlice(strings.Split(noProxyInput, ","))
 	}
@@ -2813,20 +2789,20 @@ func run(cmd *cobra.Command, _ []string) {
 		duplicate, found := aws.HasDuplicates(noProxySlice)
 		if found {
 			r.Reporter.Errorf("Invalid no-proxy list, duplicate key '%s' found", duplicate)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		for _, domain := range noProxySlice {
 			err := aws.UserNoProxyValidator(domain)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 	}
 
 	if httpProxy == "" && httpsProxy == "" && len(noProxySlice) > 0 {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if useExistingVPC && interactive.Enabled() {
@@ -2839,14 +2815,14 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid additional trust bundle file name: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid additional trust bundle file name")))
 		}
 	}
 	err = ocm.ValidateAdditionalTrustBundle(additionalTrustBundleFile)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Get certificate contents
@@ -2854,8 +2830,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if additionalTrustBundleFile != "" {
 		cert, err := os.ReadFile(additionalTrustBundleFile)
 		if err != nil {
-			r.Reporter.Errorf("Failed to read additional trust bundle file: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to read additional trust bundle file")))
 		}
 		additionalTrustBundle = new(string)
 		*additionalTrustBundle = string(cert)
@@ -2863,13 +2838,13 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if enableProxy && httpProxy == "" && httpsProxy == "" && additionalTrustBundleFile == "" {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy, additional-trust-bundle")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Additional Allowed Principals
 	if cmd.Flags().Changed("additional-allowed-principals") && !isHostedCP {
 		r.Reporter.Errorf("Additional Allowed Principals is supported only for Hosted Control Planes")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	additionalAllowedPrincipals := args.additionalAllowedPrincipals
 	if isHostedCP && interactive.Enabled() {
@@ -2879,15 +2854,15 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  strings.Join(additionalAllowedPrincipals, ","),
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value for Additional Allowed Principal ARNs: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid value for Additional Allowed Principal ARNs")))
 		}
 		additionalAllowedPrincipals = helper.HandleEmptyStringOnSlice(strings.Split(aapInputs, ","))
 	}
 	if len(additionalAllowedPrincipals) > 0 {
 		if err := roles.ValidateAdditionalAllowedPrincipals(additionalAllowedPrincipals); err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -2896,7 +2871,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if auditLogRoleARN != "" && !isHostedCP {
 		r.Reporter.Errorf("Audit log forwarding to AWS CloudWatch is only supported for Hosted Control Plane clusters")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if interactive.Enabled() && isHostedCP {
@@ -2906,8 +2881,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Required: true,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value")))
 		}
 		if requestAuditLogForwarding {
 
@@ -2924,8 +2898,7 @@ func run(cmd *cobra.Command, _ []string) {
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid value for audit-log-arn: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value for audit-log-arn")))
 			}
 		} else {
 			auditLogRoleARN = ""
@@ -2934,31 +2907,31 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if auditLogRoleARN != "" && !aws.RoleArnRE.MatchString(auditLogRoleARN) {
 		r.Reporter.Errorf("Expected a valid value for audit log arn matching %s", aws.RoleArnRE)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	isVersionCompatibleManagedIngressV2, err := versions.IsGreaterThanOrEqual(
 		version, ocm.MinVersionForManagedIngressV2)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem checking version compatibility")))
 	}
 	if ingress.IsDefaultIngressSetViaCLI(cmd.Flags()) {
 		if isHostedCP {
 			r.Reporter.Errorf("Updating default ingress settings is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if !isVersionCompatibleManagedIngressV2 {
 			formattedVersion, err := versions.FormatMajorMinorPatch(ocm.MinVersionForManagedIngressV2)
 			if err != nil {
 				r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			r.Reporter.Errorf(
 				"Updating default ingress settings is not supported for versions prior to '%s'",
 				formattedVersion,
 			)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 	routeSelector := ""
@@ -2975,7 +2948,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if cmd.Flags().Changed(ingress.DefaultIngressRouteSelectorFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating route selectors is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			routeSelector = args.defaultIngressRouteSelectors
 		} else if interactive.Enabled() && !isHostedCP && shouldAskCustomIngress {
@@ -2991,21 +2964,21 @@ func run(cmd *cobra.Command, _ []string) {
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"Expected a valid comma-separated list of attributes")))
 			}
 			routeSelector = routeSelectorArg
 		}
 		routeSelectors, err = ingress.GetRouteSelector(routeSelector)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		if cmd.Flags().Changed(ingress.DefaultIngressExcludedNamespacesFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating excluded namespace is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			excludedNamespaces = args.defaultIngressExcludedNamespaces
 		} else if interactive.Enabled() && !isHostedCP && shouldAskCustomIngress {
@@ -3015,8 +2988,8 @@ func run(cmd *cobra.Command, _ []string) {
 				Default:  args.defaultIngressExcludedNamespaces,
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"Expected a valid comma-separated list of attributes")))
 			}
 			excludedNamespaces = excludedNamespacesArg
 		}
@@ -3025,7 +2998,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if cmd.Flags().Changed(ingress.DefaultIngressWildcardPolicyFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating Wildcard Policy is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			wildcardPolicy = args.defaultIngressWildcardPolicy
 		} else {
@@ -3042,8 +3015,7 @@ func run(cmd *cobra.Command, _ []string) {
 					Required: true,
 				})
 				if err != nil {
-					r.Reporter.Errorf("Expected a valid Wildcard Policy: %s", err)
-					os.Exit(1)
+					cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid Wildcard Policy")))
 				}
 				wildcardPolicy = wildcardPolicyArg
 			}
@@ -3054,7 +3026,7 @@ func run(cmd *cobra.Command, _ []string) {
 				r.Reporter.Errorf(
 					"Updating Namespace Ownership Policy is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			namespaceOwnershipPolicy = args.defaultIngressNamespaceOwnershipPolicy
 		} else {
@@ -3071,8 +3043,7 @@ func run(cmd *cobra.Command, _ []string) {
 					Required: true,
 				})
 				if err != nil {
-					r.Reporter.Errorf("Expected a valid Namespace Ownership Policy: %s", err)
-					os.Exit(1)
+					cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid Namespace Ownership Policy")))
 				}
 				namespaceOwnershipPolicy = namespaceOwnershipPolicyArg
 			}
@@ -3175,8 +3146,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if clusterAutoscaler != nil {
 		autoscalerConfig, err := clusterautoscaler.CreateAutoscalerConfig(clusterAutoscaler)
 		if err != nil {
-			r.Reporter.Errorf("Failed creating autoscaler configuration: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed creating autoscaler configuration")))
 		}
 
 		clusterConfig.AutoscalerConfig = autoscalerConfig
@@ -3189,7 +3159,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.useLocalCredentials {
 		if isSTS {
 			r.Reporter.Errorf("Local credentials are not supported for STS clusters")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		props = append(props, properties.UseLocalCredentials)
 	}
@@ -3208,7 +3178,7 @@ func run(cmd *cobra.Command, _ []string) {
 	clusterConfig, err = clusterConfigFor(r.Reporter, clusterConfig, awsCreator, awsClient)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if !output.HasFlag() || r.Reporter.IsTerminal() {
@@ -3223,8 +3193,35 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if !clusterConfig.IsSTS {
 		if err := r.OCMClient.EnsureNoPendingClusters(awsCreator); err != nil {
-			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, err))
+		}
+	}
+
+	// The IAM resources are listed before OCM checks the cluster, as missing or outdated ones are
+	// often the reason why it would fail
+	if args.dryRun && isSTS {
+		input := iamplan.Input{
+			Version:          version,
+			ManagedPolicies:  managedPolicies,
+			HostedCPPolicies: hostedCPPolicies,
+			SharedVPC:        isSharedVPC,
+			Creator:          awsCreator,
+			AccountRoleARNs: map[string]string{
+				aws.InstallerAccountRole:    roleARN,
+				aws.SupportAccountRole:      supportRoleARN,
+				aws.ControlPlaneAccountRole: controlPlaneRoleARN,
+				aws.WorkerAccountRole:       workerRoleARN,
+			},
+			AccountRolePrefix:   accRolesPrefix,
+			OperatorRolesPrefix: operatorRolesPrefix,
+			OperatorRolePath:    expectedOperatorRolePath,
+			CredRequests:        credRequests,
+			OidcConfig:          oidcConfig,
+		}
+		err = printIAMPlan(r, awsClient, input)
+		if err != nil {
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Failed to compute the IAM resources of cluster '%s'", clusterName)))
 		}
 	}
 
@@ -3235,14 +3232,16 @@ func run(cmd *cobra.Command, _ []string) {
 		} else {
 			r.Reporter.Errorf("Failed to create cluster: %s", err)
 		}
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if args.dryRun {
-		r.Reporter.Infof(
-			"Creating cluster '%s' should succeed. Run without the '--dry-run' flag to create the cluster.",
-			clusterName)
-		os.Exit(0)
+		if !output.HasFlag() {
+			r.Reporter.Infof(
+				"Creating cluster '%s' should succeed. Run without the '--dry-run' flag to create the cluster.",
+				clusterName)
+		}
+		cmdcontext.Exit(0)
 	}
 
 	if !output.HasFlag() || r.Reporter.IsTerminal() {
@@ -3283,7 +3282,7 @@ func run(cmd *cobra.Command, _ []string) {
 					r.Reporter.Debugf("Failed to verify if OIDC provider exists: %s", err)
 				} else {
 					r.Reporter.Errorf("Failed to verify if OIDC provider exists: %s", err)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 			}
 			if !oidcProviderExists {
@@ -3436,8 +3435,7 @@ func handleOidcConfigOptions(r *rosa.Runtime, cmd *cobra.Command, isSTS bool, is
 				Required: true,
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid value: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value")))
 			}
 			isOidcConfig = _isOidcConfig
 		}
@@ -3457,12 +3455,12 @@ func handleOidcConfigOptions(r *rosa.Runtime, cmd *cobra.Command, isSTS bool, is
 		}
 		r.Reporter.Errorf("Hosted Control Plane requires an OIDC Configuration ID\n" +
 			"Please run `rosa create oidc-config -h` and create one.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	oidcConfig, err := r.OCMClient.GetOidcConfig(oidcConfigId)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", oidcConfigId, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem retrieving OIDC Config '%s'", oidcConfigId)))
 	}
 	return oidcConfig
 }
@@ -3472,8 +3470,7 @@ func filterPrivateSubnets(initialSubnets []ec2types.Subnet, r *rosa.Runtime) []e
 	filteredSubnets := []ec2types.Subnet{}
 	publicSubnetMap, err := r.AWSClient.FetchPublicSubnetMap(initialSubnets)
 	if err != nil {
-		r.Reporter.Errorf("Unable to check if subnet have an IGW: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Unable to check if subnet have an IGW")))
 	}
 	for _, subnet := range initialSubnets {
 		skip := false
@@ -3596,6 +3593,40 @@ func hostPrefixValidator(val interface{}) error {
 	return nil
 }
 
+// printIAMPlan lists the IAM resources required by the cluster along with their current state, so
+// that they can be reviewed before running the actual installation.
+func printIAMPlan(r *rosa.Runtime, awsClient aws.Client, input iamplan.Input) error {
+	if input.ManagedPolicies {
+		policies, err := r.OCMClient.GetPolicies("")
+		if err != nil {
+			return fmt.Errorf("Failed to fetch policies: %v", err)
+		}
+		input.Policies = policies
+	}
+	plan, err := iamplan.Build(awsClient, input)
+	if err != nil {
+		return err
+	}
+	if output.HasFlag() {
+		return output.Print(plan)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "KIND\tNAME\tSTATE\tVERSION\tDETAIL\n")
+	for _, resource := range plan.Resources {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n",
+			resource.Kind, resource.Name, resource.State, resource.Version, resource.Detail)
+	}
+	err = writer.Flush()
+	if err != nil {
+		return err
+	}
+	r.Reporter.Infof("%d IAM resources are required: %d exist, %d are missing and %d are outdated",
+		len(plan.Resources), plan.Count(iamplan.StateExists), plan.Count(iamplan.StateMissing),
+		plan.Count(iamplan.StateOutdated))
+	return nil
+}
+
 func getAccountRolePrefix(hostedCPPolicies bool, roleARN string, roleType string) (string, error) {
 
 	accountRoles := aws.AccountRoles
@@ -3696,6 +3727,20 @@ func validateAvailabilityZones(multiAZ bool, availabilityZones []string, awsClie
 	return nil
 }
 
+// applySpecFile loads and validates a cluster spec file, then uses it to set every flag that
+// wasn't given on the command line.
+func applySpecFile(cmd *cobra.Command, path string) error {
+	doc, err := clusterspec.Load(path)
+	if err != nil {
+		return err
+	}
+	err = doc.Spec.Validate()
+	if err != nil {
+		return fmt.Errorf("Invalid spec file '%s': %v", path, err)
+	}
+	return doc.Spec.ApplyToFlags(cmd.Flags())
+}
+
 // parseRFC3339 parses an RFC3339 date in either RFC3339Nano or RFC3339 format.
 func parseRFC3339(s string) (time.Time, error) {
 	if t, timeErr := time.Parse(time.RFC3339Nano, s); timeErr == nil {
@@ -4065,13 +4110,13 @@ func getSecurityGroups(r *rosa.Runtime, cmd *cobra.Command, isVersionCompatibleC
 		if !useExistingVpc {
 			r.Reporter.Errorf("Setting the `%s` flag is only allowed for BYO VPC clusters",
 				securitygroups.SgKindFlagMap[kind])
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		// HCP is still unsupported
 		if isHostedCp {
 			r.Reporter.Errorf("Parameter '%s' is not supported for Hosted Control Plane clusters",
 				securitygroups.SgKindFlagMap[kind])
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if !isVersionCompatibleComputeSgIds {
 			formattedVersion, err := versions.FormatMajorMinorPatch(
@@ -4079,11 +4124,11 @@ func getSecurityGroups(r *rosa.Runtime, cmd *cobra.Command, isVersionCompatibleC
 			)
 			if err != nil {
 				r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			r.Reporter.Errorf("Parameter '%s' is not supported prior to version '%s'",
 				securitygroups.SgKindFlagMap[kind], formattedVersion)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	} else if interactive.Enabled() && isVersionCompatibleComputeSgIds && useExistingVpc && !isHostedCp {
 		vpcId := ""
@@ -4094,7 +4139,7 @@ func getSecurityGroups(r *rosa.Runtime, cmd *cobra.Command, isVersionCompatibleC
 		}
 		if vpcId == "" {
 			r.Reporter.Warnf("Unexpected situation a VPC ID should have been selected based on chosen subnets")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		*additionalSgIds = interactiveSgs.
 			GetSecurityGroupIds(r, cmd, vpcId, kind, "")
@@ -4116,7 +4161,7 @@ func getMachinePoolRootDisk(r *rosa.Runtime, cmd *cobra.Command, version string,
 		formattedVersion, err := versions.FormatMajorMinorPatch(ocm.MinVersionForMachinePoolRootDisk)
 		if err != nil {
 			r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		return nil, fmt.Errorf(
 			"Updating Worker disk size is not supported for versions prior to '%s'",
@@ -18,10 +18,11 @@ package dnsdomains
 
 import (
 	// nolint:gosec
-	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -42,8 +43,7 @@ func run(_ *cobra.Command, _ []string) {
 
 	dnsdomain, err := r.OCMClient.CreateDNSDomain()
 	if err != nil {
-		r.Reporter.Errorf("Failed to create dns domain: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to create dns domain")))
 	}
 
 	r.Reporter.Infof("DNS domain ‘%s’ has been created.", dnsdomain.ID())
@@ -18,7 +18,6 @@ package idp
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -26,6 +25,8 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -332,12 +333,12 @@ func run(cmd *cobra.Command, _ []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf("Adding IDP is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Grab all the IDP information interactively if necessary
@@ -363,13 +364,12 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  idpType,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid IdP type: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid IdP type")))
 		}
 	}
 	if idpType == "" {
 		r.Reporter.Errorf("Expected a valid IDP type. Options are: %s", strings.Join(validIdps, ","))
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if idpType != "" {
@@ -381,7 +381,7 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 		if !isValidIdp {
 			r.Reporter.Errorf("Expected a valid IDP type. Options are %s", validIdps)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -401,7 +401,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err = ValidateIdpName(idpName)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	var idpBuilder cmv1.IdentityProviderBuilder
@@ -414,15 +414,15 @@ func run(cmd *cobra.Command, _ []string) {
 		idpBuilder, err = buildGoogleIdp(cmd, cluster, idpName)
 	case "htpasswd":
 		createHTPasswdIDP(cmd, cluster, clusterKey, idpName, r)
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	case "ldap":
 		idpBuilder, err = buildLdapIdp(cmd, cluster, idpName)
 	case "openid":
 		idpBuilder, err = buildOpenidIdp(cmd, cluster, idpName)
 	}
 	if err != nil {
-		r.Reporter.Errorf("Failed to create IDP for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to create IDP for cluster '%s'", clusterKey)))
 	}
 
 	doCreateIDP(idpName, idpBuilder, cluster, clusterKey, r)
@@ -439,8 +439,8 @@ func getIDPName(cmd *cobra.Command, idpName string, r *rosa.Runtime) string {
 		},
 	})
 	if err != nil {
-		r.Reporter.Errorf("Expected a valid name for the identity provider: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Expected a valid name for the identity provider")))
 	}
 	return strings.Trim(idpName, " \t")
 }
@@ -472,14 +472,13 @@ func doCreateIDP(
 
 	idp, err := idpBuilder.Build()
 	if err != nil {
-		r.Reporter.Errorf("Failed to create IDP for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to create IDP for cluster '%s'", clusterKey)))
 	}
 
 	createdIdp, err := r.OCMClient.CreateIdentityProvider(cluster.ID(), idp)
 	if err != nil {
-		r.Reporter.Errorf("Failed to add IDP to cluster '%s': %s", clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to add IDP to cluster '%s'", clusterKey)))
 	}
 
 	r.Reporter.Infof(
@@ -562,8 +561,8 @@ func getIdps(r *rosa.Runtime, cluster *cmv1.Cluster) []IdentityProvider {
 
 	ocmIdps, err := r.OCMClient.GetIdentityProviders(cluster.ID())
 	if err != nil {
-		r.Reporter.Errorf("Failed to get identity providers for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to get identity providers for cluster '%s'", cluster.ID())))
 	}
 	idps := []IdentityProvider{}
 	for _, idp := range ocmIdps {
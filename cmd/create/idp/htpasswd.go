@@ -27,6 +27,8 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -79,7 +81,7 @@ func createHTPasswdIDP(cmd *cobra.Command,
 			if err != nil {
 				r.Reporter.Errorf(
 					"Failed to add a user to the HTPasswd IDP of cluster '%s': %v", clusterKey, err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			r.Reporter.Infof("User '%s' added", username)
 		}
@@ -111,7 +113,7 @@ func validateUserArgs(r *rosa.Runtime) {
 		r.Reporter.Errorf("Only one of  'users', 'from-file' or 'username/password' may be specified. \n" +
 			"Choose the option 'users' to add one or more users to the IDP.\n" +
 			"Choose the option 'from-file' to load users from a htpassword file")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 }
 
@@ -131,8 +133,7 @@ func getUserList(cmd *cobra.Command, r *rosa.Runtime) (userList map[string]strin
 			Required: false,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid --from-file value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid --from-file value")))
 		}
 	}
 
@@ -142,7 +143,7 @@ func getUserList(cmd *cobra.Command, r *rosa.Runtime) (userList map[string]strin
 		if err != nil {
 			r.Reporter.Errorf(
 				"Failed to load Htpasswd file '%s': %v", htpasswdFile, err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		//password in htpasswd are already and do not need to be hashed again in CS
 		hashed = true
@@ -159,13 +160,13 @@ func getUserList(cmd *cobra.Command, r *rosa.Runtime) (userList map[string]strin
 			if !found {
 				r.Reporter.Errorf(
 					"Users should be provided in the format of a comma separate list of user:password")
-				os.Exit(1)
+				cmdcontext.Exit(1)
 
 			}
 			err := validateHtUsernameAndPassword(u, p)
 			if err != nil {
 				r.Reporter.Errorf(err.Error())
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			userList[u] = p
 		}
@@ -179,7 +180,7 @@ func getUserList(cmd *cobra.Command, r *rosa.Runtime) (userList map[string]strin
 		err := validateHtUsernameAndPassword(args.htpasswdUsername, args.htpasswdPassword)
 		if err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		userList[args.htpasswdUsername] = args.htpasswdPassword
 		return
@@ -253,7 +254,7 @@ func exitHTPasswdCreate(format, clusterKey string, err error, r *rosa.Runtime) {
 	r.Reporter.Errorf("Failed to create IDP for cluster '%s': %v",
 		clusterKey,
 		fmt.Errorf(format, err))
-	os.Exit(1)
+	cmdcontext.Exit(1)
 }
 
 func UsernameValidator(val interface{}) error {
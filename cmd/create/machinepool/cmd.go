@@ -17,12 +17,12 @@ limitations under the License.
 package machinepool
 
 import (
-	"os"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/securitygroups"
@@ -259,7 +259,7 @@ func run(cmd *cobra.Command, _ []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	val, ok := cluster.Properties()[properties.UseLocalCredentials]
@@ -269,7 +269,7 @@ func run(cmd *cobra.Command, _ []string) {
 		_, err := mpHelpers.ParseLabels(args.Labels)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -281,8 +281,7 @@ func run(cmd *cobra.Command, _ []string) {
 		UseLocalCredentials(useLocalCredentials).
 		Build()
 	if err != nil {
-		r.Reporter.Errorf("Failed to create awsClient: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to create awsClient")))
 	}
 
 	service := machinepool.NewMachinePoolService()
@@ -293,6 +292,6 @@ func run(cmd *cobra.Command, _ []string) {
 	}
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 }
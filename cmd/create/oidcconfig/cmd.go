@@ -19,7 +19,6 @@ package oidcconfig
 import (
 	"bytes"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -34,7 +33,9 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	. "github.com/openshift/rosa/pkg/constants"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -141,14 +142,13 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Get AWS region
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
-		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Error getting region")))
 	}
 	args.region = region
 
@@ -160,17 +160,17 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if args.rawFiles && mode != "" {
 		r.Reporter.Warnf("--%s param is not supported alongside --mode param.", rawFilesFlag)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if args.rawFiles && args.installerRoleArn != "" {
 		r.Reporter.Warnf("--%s param is not supported alongside --%s param", rawFilesFlag, InstallerRoleArnFlag)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if args.rawFiles && args.managed {
 		r.Reporter.Warnf("--%s param is not supported alongside --%s param", rawFilesFlag, managedFlag)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if !args.rawFiles && interactive.Enabled() && !cmd.Flags().Changed("mode") {
@@ -182,24 +182,23 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 		mode, err = interactive.GetOptionMode(cmd, mode, question)
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid %s: %s", question, err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid %s", question)))
 		}
 	}
 
 	if output.HasFlag() && mode != "" && mode != interactive.ModeAuto {
 		r.Reporter.Warnf("--output param is not supported outside auto mode.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if args.managed && args.userPrefix != "" {
 		r.Reporter.Warnf("--%s param is not supported for managed OIDC config", userPrefixFlag)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if args.managed && args.installerRoleArn != "" {
 		r.Reporter.Warnf("--%s param is not supported for managed OIDC config", InstallerRoleArnFlag)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if !args.managed {
@@ -226,8 +225,8 @@ func run(cmd *cobra.Command, _ []string) {
 					Validators: []interactive.Validator{interactive.MaxLength(maxLengthUserPrefix)},
 				})
 				if err != nil {
-					r.Reporter.Errorf("Expected a valid prefix for the configuration: %s", err)
-					os.Exit(1)
+					cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+						"Expected a valid prefix for the configuration")))
 				}
 				args.userPrefix = prefix
 			}
@@ -238,8 +237,7 @@ func run(cmd *cobra.Command, _ []string) {
 				}
 				err := aws.ARNValidator(args.installerRoleArn)
 				if err != nil {
-					r.Reporter.Errorf("Expected a valid ARN: %s", err)
-					os.Exit(1)
+					cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid ARN")))
 				}
 				roleExists, _, err := r.AWSClient.CheckRoleExists(roleName)
 				if err != nil {
@@ -248,17 +246,16 @@ func run(cmd *cobra.Command, _ []string) {
 						args.installerRoleArn,
 						err,
 					)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 				if !roleExists {
 					r.Reporter.Errorf("Role '%s' does not exist", args.installerRoleArn)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 				isValid, err := r.AWSClient.ValidateAccountRoleVersionCompatibility(
 					roleName, aws.InstallerAccountRole, MinorVersionForGetSecret)
 				if err != nil {
-					r.Reporter.Errorf("There was a problem listing role tags: %v", err)
-					os.Exit(1)
+					cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "There was a problem listing role tags")))
 				}
 				if !isValid {
 					r.Reporter.Errorf(
@@ -266,7 +263,7 @@ func run(cmd *cobra.Command, _ []string) {
 						args.installerRoleArn,
 						MinorVersionForGetSecret,
 					)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 			}
 		}
@@ -276,7 +273,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if len([]rune(args.userPrefix)) > maxLengthUserPrefix {
 			r.Reporter.Errorf("Expected a valid prefix for the configuration: "+
 				"length of prefix is limited to %d characters", maxLengthUserPrefix)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -285,14 +282,14 @@ func run(cmd *cobra.Command, _ []string) {
 		oidcConfigInput, err = oidcconfigs.BuildOidcConfigInput(args.userPrefix, args.region)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
 	oidcConfigStrategy, err := getOidcConfigStrategy(mode, &oidcConfigInput)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	oidcConfigStrategy.execute(r)
 	if !args.rawFiles {
@@ -318,20 +315,20 @@ func (s *CreateUnmanagedOidcConfigRawStrategy) execute(r *rosa.Runtime) {
 	privateKeyFilename := s.oidcConfig.PrivateKeyFilename
 	err := helper.SaveDocument(string(privateKey), privateKeyFilename)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving private key to a file: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem saving private key to a file")))
 	}
 	discoveryDocumentFilename := fmt.Sprintf("discovery-document-%s.json", bucketName)
 	err = helper.SaveDocument(discoveryDocument, discoveryDocumentFilename)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving discovery document to a file: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem saving discovery document to a file")))
 	}
 	jwksFilename := fmt.Sprintf("jwks-%s.json", bucketName)
 	err = helper.SaveDocument(string(jwks[:]), jwksFilename)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving JSON Web Key Set to a file: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem saving JSON Web Key Set to a file")))
 	}
 	if !output.HasFlag() && r.Reporter.IsTerminal() {
 		r.Reporter.Infof(
@@ -367,15 +364,15 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 	}
 	err := r.AWSClient.CreateS3Bucket(bucketName, args.region)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem creating S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem creating S3 bucket '%s'", bucketName)))
 	}
 	err = r.AWSClient.PutPublicReadObjectInS3Bucket(
 		bucketName, strings.NewReader(discoveryDocument), discoveryDocumentKey)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem populating discovery "+
 			"document to S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	err = r.AWSClient.PutPublicReadObjectInS3Bucket(bucketName, bytes.NewReader(jwks), jwksKey)
 	if err != nil {
@@ -384,12 +381,12 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 		}
 		r.Reporter.Errorf("There was a problem populating JWKS "+
 			"to S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	secretARN, err := r.AWSClient.CreateSecretInSecretsManager(privateKeySecretName, string(privateKey[:]))
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving private key to secrets manager: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem saving private key to secrets manager")))
 	}
 	oidcConfig, err := v1.NewOidcConfig().
 		Managed(false).
@@ -408,15 +405,15 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 			"Please refer to documentation and try again through:\n"+
 			"\trosa register oidc-config --issuer-url %s --secret-arn %s --role-arn %s",
 			err, bucketUrl, secretARN, installerRoleArn)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if output.HasFlag() {
 		err = output.Print(oidcConfig)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 	if r.Reporter.IsTerminal() {
 		if spin != nil {
@@ -441,8 +438,8 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) {
 	privateKeySecretName := s.oidcConfig.PrivateKeySecretName
 	err := helper.SaveDocument(string(privateKey), privateKeyFilename)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving private key to a file: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem saving private key to a file")))
 	}
 	createBucketConfig := ""
 	if args.region != aws.DefaultRegion {
@@ -474,8 +471,8 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) {
 	readOnlyPolicyFilename := fmt.Sprintf("readOnlyPolicy-%s.json", bucketName)
 	err = helper.SaveDocument(fmt.Sprintf(aws.ReadOnlyAnonUserPolicyTemplate, bucketName), readOnlyPolicyFilename)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving bucket policy document to a file: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem saving bucket policy document to a file")))
 	}
 	putBucketBucketPolicyCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutBucketPolicy).
@@ -488,8 +485,8 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) {
 	discoveryDocumentFilename := fmt.Sprintf("discovery-document-%s.json", bucketName)
 	err = helper.SaveDocument(discoveryDocument, discoveryDocumentFilename)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving discovery document to a file: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem saving discovery document to a file")))
 	}
 	putDiscoveryDocumentCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutObject).
@@ -503,8 +500,8 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) {
 	jwksFilename := fmt.Sprintf("jwks-%s.json", bucketName)
 	err = helper.SaveDocument(string(jwks[:]), jwksFilename)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem saving JSON Web Key Set to a file: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem saving JSON Web Key Set to a file")))
 	}
 	putJwksCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutObject).
@@ -551,8 +548,8 @@ func (s *CreateManagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 	}
 	oidcConfig, err := v1.NewOidcConfig().Managed(true).Build()
 	if err != nil {
-		r.Reporter.Errorf("There was a problem building the managed OIDC Configuration: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem building the managed OIDC Configuration")))
 	}
 	oidcConfig, err = r.OCMClient.CreateOidcConfig(oidcConfig)
 	if err != nil {
@@ -560,16 +557,16 @@ func (s *CreateManagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 			spin.Stop()
 		}
 		r.Reporter.Errorf("There was a problem registering your managed OIDC Configuration: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	s.oidcConfigInput.IssuerUrl = oidcConfig.IssuerUrl()
 	if output.HasFlag() {
 		err = output.Print(oidcConfig)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 	if r.Reporter.IsTerminal() {
 		if spin != nil {
@@ -18,7 +18,6 @@ package oidcprovider
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -27,6 +26,8 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	interactiveOidc "github.com/openshift/rosa/pkg/interactive/oidc"
@@ -97,13 +98,13 @@ func run(cmd *cobra.Command, argv []string) {
 	if cmd.Flag("cluster").Changed && cmd.Flag(OidcConfigIdFlag).Changed {
 		r.Reporter.Errorf("A cluster key for STS cluster and an OIDC Config ID " +
 			"cannot be specified alongside each other.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Determine if interactive mode is needed
@@ -119,15 +120,14 @@ func run(cmd *cobra.Command, argv []string) {
 		cluster = r.FetchCluster()
 		if !ocm.IsSts(cluster) {
 			r.Reporter.Errorf("Cluster '%s' is not an STS cluster.", clusterKey)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
 	if !cmd.Flags().Changed("mode") && interactive.Enabled() && !isProgmaticallyCalled {
 		mode, err = interactive.GetOptionMode(cmd, mode, "OIDC provider creation mode")
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid OIDC provider creation mode: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid OIDC provider creation mode")))
 		}
 	}
 
@@ -143,8 +143,8 @@ func run(cmd *cobra.Command, argv []string) {
 			}
 			oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 			if err != nil {
-				r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", args.oidcConfigId, err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"There was a problem retrieving OIDC Config '%s'", args.oidcConfigId)))
 			}
 			oidcEndpointURL = oidcConfig.IssuerUrl()
 		}
@@ -162,7 +162,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Debugf("Failed to verify if OIDC provider exists: %s", err)
 		} else {
 			r.Reporter.Errorf("Failed to verify if OIDC provider exists: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 	if oidcProviderExists {
@@ -170,7 +170,7 @@ func run(cmd *cobra.Command, argv []string) {
 			cluster.AWS().STS().OidcConfig() != nil && !cluster.AWS().STS().OidcConfig().Reusable() {
 			r.Reporter.Warnf("Cluster '%s' already has OIDC provider but has not yet started installation. "+
 				"Verify that the cluster operator roles exist and are configured correctly.", clusterKey)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		// Returns so that when called from create cluster does not interrupt flow
 		r.Reporter.Infof("OIDC provider already exists")
@@ -187,7 +187,7 @@ func run(cmd *cobra.Command, argv []string) {
 			confirmPromptMessage = fmt.Sprintf("Create the OIDC provider for cluster '%s'?", clusterKey)
 		}
 		if !confirm.Prompt(true, confirmPromptMessage) {
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 		err = createProvider(r, oidcEndpointURL, clusterId)
 		if err != nil {
@@ -196,7 +196,7 @@ func run(cmd *cobra.Command, argv []string) {
 				ocm.ClusterID: clusterKey,
 				ocm.Response:  ocm.Failure,
 			})
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		r.OCMClient.LogEvent("ROSACreateOIDCProviderModeAuto", map[string]string{
 			ocm.ClusterID: clusterKey,
@@ -206,7 +206,7 @@ func run(cmd *cobra.Command, argv []string) {
 		commands, err := buildCommands(r, oidcEndpointURL, clusterId)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 			r.OCMClient.LogEvent("ROSACreateOIDCProviderModeManual", map[string]string{
 				ocm.ClusterID: clusterKey,
 				ocm.Response:  ocm.Failure,
@@ -221,7 +221,7 @@ func run(cmd *cobra.Command, argv []string) {
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 }
 
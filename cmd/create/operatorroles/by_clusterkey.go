@@ -2,7 +2,6 @@ package operatorroles
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	common "github.com/openshift-online/ocm-common/pkg/aws/validations"
@@ -11,6 +10,8 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -26,7 +27,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 	cluster := r.FetchCluster()
 	if cluster.AWS().STS().RoleARN() == "" {
 		r.Reporter.Errorf("Cluster '%s' is not an STS cluster.", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Check to see if IAM operator roles have already created
@@ -36,7 +37,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 			r.Reporter.Debugf("Failed to verify if operator roles exist: '%v'", err)
 		} else {
 			r.Reporter.Errorf("Failed to verify if operator roles exist: '%v'", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -50,13 +51,13 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 	credRequests, err := r.OCMClient.GetCredRequests(cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM '%v'", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	managedPolicies := cluster.AWS().STS().ManagedPolicies()
 	if args.forcePolicyCreation && managedPolicies {
 		r.Reporter.Warnf("Forcing creation of policies only works for unmanaged policies")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	switch mode {
@@ -78,13 +79,13 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 		roleName, err := aws.GetInstallerAccountRoleName(cluster)
 		if err != nil {
 			r.Reporter.Errorf("Expected parsing role account role '%s': '%v'", cluster.AWS().STS().RoleARN(), err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		path, err := aws.GetPathFromAccountRole(cluster, aws.AccountRoles[aws.InstallerAccountRole].Name)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid path for '%s': '%v'", cluster.AWS().STS().RoleARN(), err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if path != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 			r.Reporter.Infof("ARN path '%s' detected in installer role '%s'. "+
@@ -99,7 +100,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 		accountRoleVersion, err = r.AWSClient.GetAccountRoleVersion(roleName)
 		if err != nil {
 			r.Reporter.Errorf("Error getting account role version '%v'", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		err = createRoles(r, operatorRolePolicyPrefix, permissionsBoundary, cluster,
 			accountRoleVersion, policies, defaultPolicyVersion, credRequests, managedPolicies, hostedCPPolicies)
@@ -114,7 +115,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 				ocm.Response:   ocm.Failure,
 				ocm.IsThrottle: isThrottle,
 			})
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		r.OCMClient.LogEvent("ROSACreateOperatorRolesModeAuto", map[string]string{
 			ocm.ClusterID: clusterKey,
@@ -125,7 +126,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 			cluster, policies, credRequests, managedPolicies, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: '%v'", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 			r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 				ocm.ClusterID: clusterKey,
 				ocm.Response:  ocm.Failure,
@@ -142,7 +143,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are '%s'", interactive.Modes)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	return nil
 }
@@ -168,7 +169,7 @@ func createRoles(
 			isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(ver.ID()), operator.MinVersion())
 			if err != nil {
 				r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			if !isSupported {
 				continue
@@ -282,8 +283,8 @@ func buildCommands(r *rosa.Runtime, env string,
 	if !managedPolicies {
 		err := aws.GenerateOperatorRolePolicyFiles(r.Reporter, policies, credRequests, sharedVpcRoleArn, r.Creator.Partition)
 		if err != nil {
-			r.Reporter.Errorf("There was an error generating the policy files: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"There was an error generating the policy files")))
 		}
 	}
 
@@ -295,7 +296,7 @@ func buildCommands(r *rosa.Runtime, env string,
 			isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(ver.ID()), operator.MinVersion())
 			if err != nil {
 				r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			if !isSupported {
 				continue
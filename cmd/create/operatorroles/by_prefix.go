@@ -3,7 +3,6 @@ package operatorroles
 import (
 	"fmt"
 	"net/url"
-	"os"
 	"strings"
 
 	awserr "github.com/openshift-online/ocm-common/pkg/aws/errors"
@@ -14,6 +13,8 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	interactiveOidc "github.com/openshift/rosa/pkg/interactive/oidc"
@@ -36,8 +37,7 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 		},
 	})
 	if err != nil {
-		r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a prefix for the operator IAM roles")))
 	}
 	args.prefix = operatorRolesPrefix
 
@@ -47,7 +47,7 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 
 	if cmd.Flags().Changed("hosted-cp") && r.Creator.IsGovcloud {
 		r.Reporter.Errorf("Setting `hosted-cp` is not supported for Govcloud AWS accounts")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	isHostedCP := args.hostedCp
@@ -61,8 +61,7 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 			Required: false,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid --hosted-cp value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid --hosted-cp value")))
 		}
 	}
 	args.hostedCp = isHostedCP
@@ -81,8 +80,8 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 	defaultPolicyVersion string) error {
 	oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", args.oidcConfigId, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem retrieving OIDC Config '%s'", args.oidcConfigId)))
 	}
 	includeHostedCpSet := args.hostedCp
 	operatorRolesPrefix := args.prefix
@@ -95,12 +94,12 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 	installerRoleName, err := aws.GetResourceIdFromARN(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	path, err := aws.GetPathFromARN(installerRoleArn)
 	if err != nil {
-		r.Reporter.Errorf("Expected a valid path for '%s': %v", installerRoleArn, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Expected a valid path for '%s'", installerRoleArn)))
 	}
 	if path != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 		r.Reporter.Infof("ARN path '%s' detected in installer role '%s'. "+
@@ -112,63 +111,62 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 		aws.AccountRoles[aws.InstallerAccountRole].Name)
 	if !hasStandardNamedInstallerRole {
 		r.Reporter.Infof("Can only use installer roles created through ROSA CLI for this flow.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	operatorRolePolicyPrefix := installerRolePrefix
 	credRequests, err := r.OCMClient.GetCredRequests(includeHostedCpSet)
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	managedPolicies, err := r.AWSClient.HasManagedPolicies(installerRoleArn)
 	if err != nil {
-		r.Reporter.Errorf("Failed to determine if cluster has managed policies: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to determine if cluster has managed policies")))
 	}
 	if managedPolicies && sharedVpcRoleArn != "" {
 		r.Reporter.Errorf("Installer role '%s' has managed policies, the 'shared-vpc-role-arn' flag is not "+
 			"supported for managed policies", installerRoleArn)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	awsCreator, err := r.AWSClient.GetCreator()
 	if err != nil {
-		r.Reporter.Errorf("Unable to get IAM credentials: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Unable to get IAM credentials")))
 	}
 
 	operatorIAMRoleList, err := convertCredRequestsOperatorRolesIntoV1OperatorIAMRole(credRequests,
 		args.prefix, awsCreator, path)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	var hostedCPPolicies bool
 	if args.hostedCp {
 		hostedCPPolicies, err = r.AWSClient.HasHostedCPPolicies(args.installerRoleArn)
 		if err != nil {
-			r.Reporter.Errorf("Failed to determine if the Installer role ARN has hosted CP policies: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Failed to determine if the Installer role ARN has hosted CP policies")))
 		}
 
 		if !hostedCPPolicies {
 			r.Reporter.Errorf(
 				"Failed to create the operator role since the Installer role ARN '%v' does not have managed policies",
 				args.installerRoleArn)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
 	operatorRolesList, err := convertV1OperatorIAMRoleIntoOcmOperatorIamRole(operatorIAMRoleList)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	err = ocm.ValidateOperatorRolesMatchOidcProvider(r.Reporter, r.AWSClient,
 		operatorRolesList, oidcConfig.IssuerUrl(), "4.0", path, managedPolicies, true)
 	if err != nil && !awserr.IsNoSuchEntityException(err) {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	switch mode {
@@ -192,7 +190,7 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 				ocm.Response:            ocm.Failure,
 				ocm.IsThrottle:          isThrottle,
 			})
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if r.Reporter.IsTerminal() {
 			hostedCpOutputParam := ""
@@ -220,7 +218,7 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 			oidcEndpointUrl, hostedCPPolicies, sharedVpcRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 			r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 				ocm.OperatorRolesPrefix: operatorRolesPrefix,
 				ocm.Response:            ocm.Failure,
@@ -236,7 +234,7 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	return nil
 }
@@ -263,29 +261,29 @@ func validateArgumentsOperatorRolesCreationByPrefix(r *rosa.Runtime, operatorRol
 	oidcEndpointUrl string, installerRoleArn string) {
 	if len(operatorRolesPrefix) == 0 {
 		r.Reporter.Errorf("Expected a prefix for the operator IAM roles")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if len(operatorRolesPrefix) > 32 {
 		r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if !aws.RoleNameRE.MatchString(operatorRolesPrefix) {
 		r.Reporter.Errorf("Expected valid operator roles prefix matching %s", aws.RoleNameRE.String())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	parsedURI, err := url.ParseRequestURI(oidcEndpointUrl)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if parsedURI.Scheme != helper.ProtocolHttps {
 		r.Reporter.Errorf("Expected OIDC endpoint URL '%s' to use an https:// scheme", oidcEndpointUrl)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	err = aws.ARNValidator(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 }
 
@@ -400,8 +398,8 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 	if !managedPolicies {
 		err := aws.GenerateOperatorRolePolicyFiles(r.Reporter, policies, credRequests, sharedVpcRoleArn, r.Creator.Partition)
 		if err != nil {
-			r.Reporter.Errorf("There was an error generating the policy files: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"There was an error generating the policy files")))
 		}
 	}
 
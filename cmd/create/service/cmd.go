@@ -19,7 +19,6 @@ package service
 import (
 	"fmt"
 	"net"
-	"os"
 	"regexp"
 	"strings"
 
@@ -31,6 +30,8 @@ import (
 
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper/roles"
 	"github.com/openshift/rosa/pkg/info"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -151,21 +152,20 @@ func run(cmd *cobra.Command, argv []string) {
 	if args.ServiceType == "" {
 		r.Reporter.Errorf("Service type not specified.")
 		cmd.Help()
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if args.ClusterName == "" {
 		r.Reporter.Errorf("Cluster name not specified.")
 		cmd.Help()
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Get AWS region
 	var err error
 	args.AwsRegion, err = aws.GetRegion(arguments.GetRegion())
 	if err != nil {
-		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Error getting region")))
 	}
 	r.Reporter.Debugf("Using AWS region: %q", args.AwsRegion)
 
@@ -183,15 +183,14 @@ func run(cmd *cobra.Command, argv []string) {
 	version, err := r.OCMClient.ManagedServiceVersionInquiry(args.ServiceType)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	versionMajorMinor := ocm.GetVersionMinor(version)
 
 	// Add-on parameter logic
 	addOn, err := r.OCMClient.GetAddOn(args.ServiceType)
 	if err != nil {
-		r.Reporter.Errorf("Failed to get add-on %q: %s", args.ServiceType, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get add-on %q", args.ServiceType)))
 	}
 	parameters := addOn.Parameters()
 
@@ -204,7 +203,7 @@ func run(cmd *cobra.Command, argv []string) {
 			flag := cmd.Flags().Lookup(param.ID())
 			if param.Required() && (flag == nil || flag.Value.String() == "") {
 				r.Reporter.Errorf("Required parameter --%s missing", param.ID())
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			if flag != nil {
 
@@ -221,7 +220,7 @@ func run(cmd *cobra.Command, argv []string) {
 							r.Reporter.Errorf("Failed to process parameter --%s: Expected %v to match /%s/",
 								param.ID(), val, param.Validation())
 						}
-						os.Exit(1)
+						cmdcontext.Exit(1)
 					}
 				}
 				args.Parameters[param.ID()] = flag.Value.String()
@@ -250,7 +249,7 @@ func run(cmd *cobra.Command, argv []string) {
 		}
 		r.Reporter.Errorf("Cannot create managed service with the following unknown flags: (%s)",
 			flagList)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// BYO-VPC Logic
@@ -262,8 +261,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if subnetsProvided {
 		subnets, err := r.AWSClient.ListSubnets()
 		if err != nil {
-			r.Reporter.Errorf("Failed to get the list of subnets: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get the list of subnets")))
 		}
 
 		mapSubnetToAZ := make(map[string]string)
@@ -279,7 +277,7 @@ func run(cmd *cobra.Command, argv []string) {
 			}
 			if !verifiedSubnet {
 				r.Reporter.Errorf("Could not find the following subnet provided: %s", subnetArg)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 
@@ -317,8 +315,7 @@ func run(cmd *cobra.Command, argv []string) {
 
 	roleARNs, err := r.AWSClient.FindRoleARNs(aws.InstallerAccountRole, versionMajorMinor)
 	if err != nil {
-		r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to find %s role", role.Name)))
 	}
 
 	if len(roleARNs) > 1 {
@@ -339,7 +336,7 @@ func run(cmd *cobra.Command, argv []string) {
 	} else {
 		r.Reporter.Errorf("No account roles found. " +
 			"You will need to run 'rosa create account-roles' to create them first.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if roleARN != "" {
@@ -347,7 +344,7 @@ func run(cmd *cobra.Command, argv []string) {
 		rolePrefix, err := getAccountRolePrefix(roleARN, role)
 		if err != nil {
 			r.Reporter.Errorf("Failed to find prefix from %q account role", role.Name)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		r.Reporter.Debugf("Using %q as the role prefix", rolePrefix)
 
@@ -358,8 +355,7 @@ func run(cmd *cobra.Command, argv []string) {
 			}
 			roleARNs, err := r.AWSClient.FindRoleARNs(roleType, versionMajorMinor)
 			if err != nil {
-				r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to find %s role", role.Name)))
 			}
 			selectedARN := ""
 			for _, rARN := range roleARNs {
@@ -371,7 +367,7 @@ func run(cmd *cobra.Command, argv []string) {
 				r.Reporter.Errorf("No %s account roles found. "+
 					"You will need to run 'rosa create account-roles' to create them first.",
 					role.Name)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			if !output.HasFlag() || r.Reporter.IsTerminal() {
 				r.Reporter.Infof("Using %q for the %s role", selectedARN, role.Name)
@@ -396,8 +392,7 @@ func run(cmd *cobra.Command, argv []string) {
 
 	path, err := aws.GetPathFromARN(roleARN)
 	if err != nil {
-		r.Reporter.Errorf("Expected a valid path for  '%s': %v", roleARN, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid path for  '%s'", roleARN)))
 	}
 
 	// operator role logic.
@@ -408,7 +403,7 @@ func run(cmd *cobra.Command, argv []string) {
 	credRequests, err := r.OCMClient.GetCredRequests(false)
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	for _, operator := range credRequests {
@@ -417,7 +412,7 @@ func run(cmd *cobra.Command, argv []string) {
 			isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(version), operator.MinVersion())
 			if err != nil {
 				r.Reporter.Errorf("Error validating operator role %q version %s", operator.Name(), err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			if !isSupported {
 				continue
@@ -435,13 +430,11 @@ func run(cmd *cobra.Command, argv []string) {
 	for _, role := range operatorIAMRoleList {
 		name, err := aws.GetResourceIdFromARN(role.RoleARN)
 		if err != nil {
-			r.Reporter.Errorf("Error validating role: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Error validating role")))
 		}
 		err = r.AWSClient.ValidateRoleNameAvailable(name)
 		if err != nil {
-			r.Reporter.Errorf("Error validating role: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Error validating role")))
 		}
 	}
 
@@ -451,8 +444,7 @@ func run(cmd *cobra.Command, argv []string) {
 	// Creating the service
 	service, err := r.OCMClient.CreateManagedService(args)
 	if err != nil {
-		r.Reporter.Errorf("Failed to create managed service: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to create managed service")))
 	}
 
 	r.Reporter.Infof("Service created!\n\n\tService ID: %s\n", service.ID())
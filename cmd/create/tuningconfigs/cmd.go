@@ -18,11 +18,12 @@ package tuningconfigs
 
 import (
 	"fmt"
-	"os"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/input"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -90,8 +91,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Required: true,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid name: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid name")))
 		}
 	}
 
@@ -108,21 +108,20 @@ func run(cmd *cobra.Command, _ []string) {
 			Required: true,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid spec path: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid spec path")))
 		}
 	}
 
 	tuningConfig, err := buildTuningConfigFromInputFile(specPath, name, clusterKey)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	_, err = r.OCMClient.CreateTuningConfig(cluster.ID(), tuningConfig)
 	if err != nil {
-		r.Reporter.Errorf("Failed to add tuning config to cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to add tuning config to cluster '%s'", clusterKey)))
 	}
 
 	r.Reporter.Infof("Tuning config '%s' has been created on cluster '%s'.", name, clusterKey)
@@ -18,13 +18,14 @@ package addon
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"strings"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -45,6 +46,10 @@ var Cmd = &cobra.Command{
 	},
 }
 
+func init() {
+	output.AddFlag(Cmd)
+}
+
 func run(_ *cobra.Command, argv []string) {
 	r := rosa.NewRuntime().WithOCM()
 	defer r.Cleanup()
@@ -57,7 +62,16 @@ func run(_ *cobra.Command, argv []string) {
 		r.Reporter.Errorf("Failed to get add-on '%s': %s\n"+
 			"Try running 'rosa list addons' to see all available add-ons.",
 			addOnID, err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
+	}
+
+	if output.HasFlag() {
+		err = output.Print(addOn)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			cmdcontext.Exit(1)
+		}
+		return
 	}
 
 	printDescription(addOn)
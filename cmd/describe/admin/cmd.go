@@ -17,13 +17,14 @@ limitations under the License.
 package admin
 
 import (
-	"os"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	cadmin "github.com/openshift/rosa/cmd/create/admin"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	"github.com/openshift/rosa/pkg/object"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -39,6 +40,7 @@ var Cmd = &cobra.Command{
 
 func init() {
 	ocm.AddClusterFlag(Cmd)
+	output.AddFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -50,14 +52,14 @@ func run(_ *cobra.Command, _ []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf(
 			"Describing the 'cluster-admin' user is not supported for clusters with external authentication configured.",
 		)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Try to find an existing htpasswd identity provider and
@@ -65,7 +67,23 @@ func run(_ *cobra.Command, _ []string) {
 	existingClusterAdminIdp, _, err := cadmin.FindIDPWithAdmin(cluster, r)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		cmdcontext.Exit(1)
+	}
+	if output.HasFlag() {
+		outputObject := object.Object{
+			"api_url":  cluster.API().URL(),
+			"username": cadmin.ClusterAdminUsername,
+			"exists":   existingClusterAdminIdp != nil,
+		}
+		if existingClusterAdminIdp != nil {
+			outputObject["identity_provider"] = existingClusterAdminIdp.Name()
+		}
+		err = output.Print(outputObject)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			cmdcontext.Exit(1)
+		}
+		return
 	}
 	if existingClusterAdminIdp != nil {
 		r.Reporter.Infof("There is '%s' user on cluster '%s'. To login, run the following command:\n"+
@@ -74,6 +92,6 @@ func run(_ *cobra.Command, _ []string) {
 	} else {
 		r.Reporter.Warnf("There is no '%s' user on cluster '%s'. To create it run the following command:\n"+
 			"   rosa create admin -c %s", cadmin.ClusterAdminUsername, clusterKey, clusterKey)
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 }
@@ -20,7 +20,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -29,6 +28,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper/rolepolicybindings"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -102,40 +103,40 @@ func run(cmd *cobra.Command, argv []string) {
 	if !isHypershift {
 		scheduledUpgrade, upgradeState, err = r.OCMClient.GetScheduledUpgrade(cluster.ID())
 		if err != nil {
-			r.Reporter.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Failed to get scheduled upgrades for cluster '%s'", clusterKey)))
 		}
 
 		if output.HasFlag() {
 			f, err := formatCluster(cluster, scheduledUpgrade, upgradeState, displayName)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			err = output.Print(f)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			return
 		}
 	} else {
 		controlPlaneScheduledUpgrade, err = r.OCMClient.GetControlPlaneScheduledUpgrade(cluster.ID())
 		if err != nil {
-			r.Reporter.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Failed to get scheduled upgrades for cluster '%s'", clusterKey)))
 		}
 
 		if output.HasFlag() {
 			f, err := formatClusterHypershift(cluster, controlPlaneScheduledUpgrade, displayName)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			err = output.Print(f)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			return
 		}
@@ -145,7 +146,7 @@ func run(cmd *cobra.Command, argv []string) {
 	creatorARN, err := arn.Parse(cluster.Properties()[ocmConsts.CreatorArn])
 	if err != nil {
 		r.Reporter.Errorf("Failed to parse creator ARN for cluster '%s'", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	phase := ""
 
@@ -209,8 +210,8 @@ func run(cmd *cobra.Command, argv []string) {
 		machinePools, err = r.OCMClient.GetMachinePools(cluster.ID())
 	}
 	if err != nil {
-		r.Reporter.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to get machine pools for cluster '%s'", clusterKey)))
 	}
 
 	// Print short cluster description:
@@ -303,8 +304,7 @@ func run(cmd *cobra.Command, argv []string) {
 		if args.getRolePolicyBindings {
 			rolePolicyBindings, err := r.OCMClient.ListRolePolicyBindings(cluster.ID(), true)
 			if err != nil {
-				r.Reporter.Errorf("Failed to get rolePolicyBinding: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get rolePolicyBinding")))
 			}
 			rolePolicyDetails = rolepolicybindings.TransformToRolePolicyDetails(rolePolicyBindings)
 		}
@@ -316,7 +316,7 @@ func run(cmd *cobra.Command, argv []string) {
 				"                            -")
 			if err != nil {
 				r.Reporter.Errorf(err.Error())
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			str = str + policyStr
 		}
@@ -334,7 +334,7 @@ func run(cmd *cobra.Command, argv []string) {
 					"                            -")
 				if err != nil {
 					r.Reporter.Errorf(err.Error())
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 				str = str + policyStr
 			}
@@ -352,7 +352,7 @@ func run(cmd *cobra.Command, argv []string) {
 						"                            -")
 					if err != nil {
 						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						cmdcontext.Exit(1)
 					}
 					str = str + policyStr
 				}
@@ -367,7 +367,7 @@ func run(cmd *cobra.Command, argv []string) {
 						"                            -")
 					if err != nil {
 						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						cmdcontext.Exit(1)
 					}
 					str = str + policyStr
 				}
@@ -385,7 +385,7 @@ func run(cmd *cobra.Command, argv []string) {
 						"   -")
 					if err != nil {
 						r.Reporter.Errorf(err.Error())
-						os.Exit(1)
+						cmdcontext.Exit(1)
 					}
 					str = str + policyStr
 				}
@@ -503,8 +503,8 @@ func run(cmd *cobra.Command, argv []string) {
 
 	limitedSupportReasons, err := r.OCMClient.GetLimitedSupportReasons(cluster.ID())
 	if err != nil {
-		r.Reporter.Errorf("Failed to get limited support reasons for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to get limited support reasons for cluster '%s'", cluster.ID())))
 	}
 	if len(limitedSupportReasons) > 0 {
 		str = fmt.Sprintf("%s"+"Limited Support:\n", str)
@@ -518,8 +518,8 @@ func run(cmd *cobra.Command, argv []string) {
 
 	inflightChecks, err := r.OCMClient.GetInflightChecks(cluster.ID())
 	if err != nil {
-		r.Reporter.Errorf("Failed to get inflight checks for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to get inflight checks for cluster '%s'", cluster.ID())))
 	}
 	if len(inflightChecks) > 0 {
 		summaries := []string{}
@@ -18,11 +18,13 @@ package service
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -49,6 +51,8 @@ func init() {
 		"",
 		"The id of the service to describe",
 	)
+
+	output.AddFlag(Cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -58,15 +62,23 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.ID == "" {
 		r.Reporter.Errorf("id not specified.")
 		cmd.Help()
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Try to find the cluster:
 	r.Reporter.Debugf("Loading service with id %q", args.ID)
 	service, err := r.OCMClient.GetManagedService(args)
 	if err != nil {
-		r.Reporter.Errorf("Failed to get service with id %q: %v", args.ID, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get service with id %q", args.ID)))
+	}
+
+	if output.HasFlag() {
+		err = output.Print(service)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			cmdcontext.Exit(1)
+		}
+		return
 	}
 
 	fmt.Printf(`%-28s%s
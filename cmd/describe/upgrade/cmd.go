@@ -18,14 +18,15 @@ package upgrade
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 	"github.com/openshift/rosa/pkg/upgrade"
 )
@@ -60,6 +61,7 @@ func init() {
 	)
 
 	confirm.AddFlag(flags)
+	output.AddFlag(Cmd)
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -68,7 +70,7 @@ func run(_ *cobra.Command, _ []string) {
 	err := runWithRuntime(r)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 }
 
@@ -99,6 +101,9 @@ func describeHypershiftUpgrades(r *rosa.Runtime, clusterID string, nodePoolID st
 		if err != nil {
 			return fmt.Errorf("Failed to get upgrades for cluster '%s': %v", clusterKey, err)
 		}
+		if output.HasFlag() {
+			return output.Print(upgrades)
+		}
 		if len(upgrades) < 1 {
 			r.Reporter.Infof("No scheduled upgrades for cluster '%s'", clusterKey)
 			return nil
@@ -113,6 +118,9 @@ func describeHypershiftUpgrades(r *rosa.Runtime, clusterID string, nodePoolID st
 			return fmt.Errorf("Failed to get upgrades for machine pool '%s' in cluster '%s': %v", nodePoolID,
 				clusterKey, err)
 		}
+		if output.HasFlag() {
+			return output.Print(upgrades)
+		}
 		if upgrades == nil || len(upgrades) < 1 {
 			r.Reporter.Infof("No scheduled upgrades for machine pool '%s' in cluster '%s'", nodePoolID, clusterKey)
 			return nil
@@ -193,6 +201,9 @@ func describeClassicUpgrades(r *rosa.Runtime, clusterID string) error {
 	if err != nil {
 		return fmt.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterID, err)
 	}
+	if output.HasFlag() {
+		return output.Print(upgrades)
+	}
 	if len(upgrades) < 1 {
 		r.Reporter.Infof("No scheduled upgrades for cluster id '%s'", clusterID)
 		return nil
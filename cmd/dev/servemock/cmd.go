@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servemock
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/mockserver"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "serve-mock"
+	short = "Run a local mock of the OCM API"
+	long  = "Run a local stand-in for the clusters_mgmt, accounts_mgmt and service_logs APIs of OCM. " +
+		"Clusters, node pools, identity providers and the rest of the resources are kept in memory " +
+		"and lost when the server stops. New clusters stay in the 'installing' state for the install " +
+		"duration before becoming 'ready', and deleted clusters stay in the 'uninstalling' state for " +
+		"the uninstall duration before they are removed. Commands that use AWS still need AWS " +
+		"credentials. The server runs until it is interrupted."
+	example = `  # Run the mock server on the default port and log in to it from another terminal
+  rosa dev serve-mock
+  rosa login --url http://127.0.0.1:8000 --token <token printed by the server>
+
+  # Make clusters ready after ten seconds
+  rosa dev serve-mock --install-duration=10s`
+
+	shutdownTimeout = 5 * time.Second
+)
+
+var args struct {
+	address           string
+	port              int
+	installDuration   time.Duration
+	uninstallDuration time.Duration
+}
+
+func NewServeMockCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.DefaultRuntime(), ServeMockRunner()),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&args.address,
+		"address",
+		"127.0.0.1",
+		"Address the server listens on.",
+	)
+	flags.IntVar(
+		&args.port,
+		"port",
+		8000,
+		"Port the server listens on. Use 0 to pick a free port.",
+	)
+	flags.DurationVar(
+		&args.installDuration,
+		"install-duration",
+		mockserver.DefaultInstallDuration,
+		"Time that new clusters stay in the 'installing' state.",
+	)
+	flags.DurationVar(
+		&args.uninstallDuration,
+		"uninstall-duration",
+		mockserver.DefaultUninstallDuration,
+		"Time that deleted clusters stay in the 'uninstalling' state.",
+	)
+	return cmd
+}
+
+func ServeMockRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if args.installDuration <= 0 || args.uninstallDuration <= 0 {
+			return fmt.Errorf("Install and uninstall durations must be greater than zero")
+		}
+		server := mockserver.New(mockserver.Options{
+			InstallDuration:   args.installDuration,
+			UninstallDuration: args.uninstallDuration,
+			Logger:            r.Logger,
+		})
+		token, err := server.Token()
+		if err != nil {
+			return fmt.Errorf("Failed to generate access token: %v", err)
+		}
+
+		address := net.JoinHostPort(args.address, strconv.Itoa(args.port))
+		listener, err := net.Listen("tcp", address)
+		if err != nil {
+			return fmt.Errorf("Failed to listen on '%s': %v", address, err)
+		}
+		url := "http://" + listener.Addr().String()
+		httpServer := &http.Server{
+			Handler:           server,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		failed := make(chan error, 1)
+		go func() {
+			failed <- httpServer.Serve(listener)
+		}()
+
+		r.Reporter.Infof("Mock OCM API listening on %s", url)
+		r.Reporter.Infof("To log in to it run 'rosa login --url %s --token %s'", url, token)
+
+		select {
+		case err = <-failed:
+			return fmt.Errorf("Mock OCM API stopped: %v", err)
+		case <-ctx.Done():
+		}
+
+		// Stopping the server is the expected way to finish, so neither an interrupt nor the end of
+		// the '--timeout' are reported as errors:
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		err = httpServer.Shutdown(shutdownCtx)
+		if err != nil {
+			return fmt.Errorf("Failed to stop mock OCM API: %v", err)
+		}
+		r.Reporter.Infof("Stopped the mock OCM API")
+		return nil
+	}
+}
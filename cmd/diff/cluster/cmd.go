@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clusterspec"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "cluster"
+	short = "Compare a cluster with a spec file"
+	long  = "Compare a cluster and its machine pools, identity providers, ingresses and kubelet configs " +
+		"with the spec file that describes them. Only the fields set in the spec file are compared. " +
+		"The command exits with code 0 when the cluster matches the spec file, with code 2 when it " +
+		"has drifted and with the code of the error, see 'rosa --help', when the comparison fails."
+	example = `  # Compare a cluster named "mycluster" with a spec file
+  rosa diff cluster --cluster=mycluster --file=mycluster.yaml
+
+  # Print the differences as JSON
+  rosa diff cluster --cluster=mycluster --file=mycluster.yaml --output=json`
+
+	// DriftExitCode is the exit code of the command when the cluster doesn't match the spec file
+	DriftExitCode = rosaerrors.DriftExitCode
+)
+
+type diffResult struct {
+	Drift       bool                     `json:"drift"`
+	Differences []clusterspec.Difference `json:"differences"`
+}
+
+var args struct {
+	file string
+}
+
+func NewDiffClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), DiffClusterRunner()),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(
+		&args.file,
+		"file",
+		"f",
+		"",
+		"Path of the spec file, in YAML or JSON, to compare the cluster with.",
+	)
+	cmd.MarkFlagRequired("file")
+	ocm.AddClusterFlag(cmd)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func DiffClusterRunner() rosa.CommandRunner {
+	return func(ctx context.Context, runtime *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		desired, err := clusterspec.Load(args.file)
+		if err != nil {
+			return err
+		}
+
+		cluster, err := runtime.OCMClient.GetCluster(runtime.GetClusterKey(), runtime.Creator)
+		if err != nil {
+			return err
+		}
+		live, err := clusterspec.FetchLiveResources(ctx, runtime.OCMClient, cluster)
+		if err != nil {
+			return err
+		}
+		differences, err := clusterspec.Diff(desired, clusterspec.LiveDocument(cluster, live, desired))
+		if err != nil {
+			return fmt.Errorf("Failed to compare cluster '%s' with spec file '%s': %v",
+				runtime.ClusterKey, args.file, err)
+		}
+
+		if output.HasFlag() {
+			if differences == nil {
+				differences = []clusterspec.Difference{}
+			}
+			err = output.Print(diffResult{
+				Drift:       len(differences) > 0,
+				Differences: differences,
+			})
+			if err != nil {
+				return err
+			}
+		} else if len(differences) == 0 {
+			runtime.Reporter.Infof("Cluster '%s' matches spec file '%s'", runtime.ClusterKey, args.file)
+		} else {
+			for _, difference := range differences {
+				fmt.Println(difference.String())
+			}
+		}
+
+		if len(differences) > 0 {
+			return rosaerrors.NewDriftError("Cluster '%s' doesn't match spec file '%s'",
+				runtime.ClusterKey, args.file)
+		}
+		return nil
+	}
+}
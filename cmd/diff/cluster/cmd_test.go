@@ -0,0 +1,123 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("rosa diff cluster", func() {
+	Context("Create Command", func() {
+		It("Returns Command", func() {
+			cmd := NewDiffClusterCommand()
+			Expect(cmd).NotTo(BeNil())
+
+			Expect(cmd.Use).To(Equal(use))
+			Expect(cmd.Example).To(Equal(example))
+			Expect(cmd.Short).To(Equal(short))
+			Expect(cmd.Long).To(Equal(long))
+			Expect(cmd.Args).NotTo(BeNil())
+			Expect(cmd.Run).NotTo(BeNil())
+
+			Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("file")).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+		})
+	})
+
+	Context("Execute command", func() {
+		var t *TestingRuntime
+		var cluster *cmv1.Cluster
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+
+			cluster = MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.Region(cmv1.NewCloudRegion().ID("us-east-1"))
+				c.Nodes(cmv1.NewClusterNodes().ComputeMachineType(cmv1.NewMachineType().ID("m5.xlarge")).Compute(2))
+			})
+			t.SetCluster(cluster.Name(), cluster)
+
+			prefix := fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s", cluster.ID())
+			t.ApiServer.RouteToHandler("GET", prefix+"/machine_pools",
+				RespondWithJSON(http.StatusOK, FormatMachinePoolList([]*cmv1.MachinePool{})))
+			t.ApiServer.RouteToHandler("GET", prefix+"/ingresses",
+				RespondWithJSON(http.StatusOK, FormatIngressList([]*cmv1.Ingress{})))
+			t.ApiServer.RouteToHandler("GET", prefix+"/autoscaler",
+				RespondWithJSON(http.StatusNotFound, "{}"))
+			t.ApiServer.RouteToHandler("GET", prefix+"/identity_providers",
+				RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{})))
+			t.ApiServer.RouteToHandler("GET", prefix+"/kubelet_configs",
+				RespondWithJSON(http.StatusOK, FormatKubeletConfigList([]*cmv1.KubeletConfig{})))
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+			args.file = ""
+		})
+
+		writeSpec := func(replicas int) {
+			args.file = filepath.Join(GinkgoT().TempDir(), "spec.yaml")
+			Expect(os.WriteFile(args.file, []byte(fmt.Sprintf(
+				"apiVersion: rosa.openshift.io/v1alpha1\nkind: Cluster\nspec:\n"+
+					"  name: cluster\n  compute:\n    replicas: %d\n", replicas)), 0600)).To(Succeed())
+		}
+
+		It("Returns an error if the spec file can't be read", func() {
+			args.file = filepath.Join(GinkgoT().TempDir(), "missing.yaml")
+
+			err := DiffClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(ContainSubstring("Failed to read spec file")))
+		})
+
+		It("Reports that the cluster matches the spec file", func() {
+			writeSpec(2)
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+
+			t.StdOutReader.Record()
+			err := DiffClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(ContainSubstring("matches spec file"))
+		})
+
+		It("Prints the differences and exits with the drift code", func() {
+			writeSpec(3)
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+
+			t.StdOutReader.Record()
+			err := DiffClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(BeAssignableToTypeOf(&rosaerrors.DriftError{}))
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(Equal("~ spec.compute.replicas: desired 3, live 2\n"))
+			Expect(rosaerrors.ExitCode(err)).To(Equal(DriftExitCode))
+		})
+
+		It("Prints the differences as JSON", func() {
+			output.SetOutput("json")
+			writeSpec(3)
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+
+			t.StdOutReader.Record()
+			err := DiffClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(BeAssignableToTypeOf(&rosaerrors.DriftError{}))
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(MatchJSON(`{"drift": true, "differences": [` +
+				`{"path": "spec.compute.replicas", "type": "changed", "desired": 3, "live": 2}]}`))
+			Expect(rosaerrors.ExitCode(err)).To(Equal(DriftExitCode))
+		})
+	})
+})
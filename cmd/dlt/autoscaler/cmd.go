@@ -17,10 +17,9 @@ limitations under the License.
 package autoscaler
 
 import (
-	"os"
-
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -50,11 +49,11 @@ func run(_ *cobra.Command, _ []string) {
 
 	if cluster.Hypershift().Enabled() {
 		r.Reporter.Errorf("Hosted Control Plane clusters do not support cluster-autoscaler configuration")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if !confirm.Confirm("delete cluster autoscaler?") {
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	r.Reporter.Debugf("Deleting autoscaler for cluster '%s''", clusterKey)
@@ -63,7 +62,7 @@ func run(_ *cobra.Command, _ []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed to delete autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	r.Reporter.Infof("Successfully deleted autoscaler configuration for cluster '%s'", cluster.ID())
 }
@@ -18,7 +18,6 @@ package cluster
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -28,6 +27,7 @@ import (
 	"github.com/openshift/rosa/cmd/dlt/operatorrole"
 	uninstallLogs "github.com/openshift/rosa/cmd/logs/uninstall"
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -88,7 +88,7 @@ func run(_ *cobra.Command, _ []string) {
 	}
 
 	if !confirm.Confirm("delete cluster %s", clusterKey) {
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	cluster := r.FetchCluster()
@@ -96,7 +96,7 @@ func run(_ *cobra.Command, _ []string) {
 	err := handleClusterDelete(r, cluster, clusterKey, args.bestEffort)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if cluster.AWS().STS().RoleARN() != "" {
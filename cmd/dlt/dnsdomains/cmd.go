@@ -18,10 +18,10 @@ package dnsdomains
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -54,7 +54,7 @@ func run(_ *cobra.Command, argv []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed to delete dns domain '%s': %s",
 			id, err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	r.Reporter.Infof("Successfully deleted dns domain '%s'", id)
 }
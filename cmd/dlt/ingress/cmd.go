@@ -18,12 +18,13 @@ package ingress
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -68,7 +69,7 @@ func run(_ *cobra.Command, argv []string) {
 			"Ingress  identifier '%s' isn't valid: it must contain only four letters or digits",
 			ingressID,
 		)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	clusterKey := r.GetClusterKey()
@@ -78,8 +79,8 @@ func run(_ *cobra.Command, argv []string) {
 	r.Reporter.Debugf("Loading ingresses for cluster '%s'", clusterKey)
 	ingresses, err := r.OCMClient.GetIngresses(cluster.ID())
 	if err != nil {
-		r.Reporter.Errorf("Failed to get ingresses for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to get ingresses for cluster '%s'", clusterKey)))
 	}
 
 	var ingress *cmv1.Ingress
@@ -96,7 +97,7 @@ func run(_ *cobra.Command, argv []string) {
 	}
 	if ingress == nil {
 		r.Reporter.Errorf("Ingress '%s' does not exist on cluster '%s'", ingressID, clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if confirm.Confirm("delete ingress %s on cluster %s", ingressID, clusterKey) {
@@ -105,7 +106,7 @@ func run(_ *cobra.Command, argv []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed to delete ingress '%s' on cluster '%s': %s",
 				ingress.ID(), clusterKey, err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		r.Reporter.Infof("Successfully deleted ingress '%s' from cluster '%s'", ingressID, clusterKey)
 	}
@@ -18,7 +18,6 @@ package oidcconfig
 
 import (
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -31,6 +30,8 @@ import (
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	interactiveOidc "github.com/openshift/rosa/pkg/interactive/oidc"
@@ -82,14 +83,13 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Get AWS region
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
-		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Error getting region")))
 	}
 	args.region = region
 
@@ -101,8 +101,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if interactive.Enabled() {
 		mode, err = interactive.GetOptionMode(cmd, mode, "OIDC Config deletion mode")
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid OIDC provider creation mode: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid OIDC provider creation mode")))
 		}
 	}
 
@@ -114,7 +113,7 @@ func run(cmd *cobra.Command, _ []string) {
 	oidcConfigStrategy, err := getOidcConfigStrategy(mode, oidcConfigInput)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	oidcConfigStrategy.execute(r)
 	arguments.DisableRegionDeprecationWarning = true // disable region deprecation warning
@@ -140,8 +139,8 @@ type OidcConfigInput struct {
 func buildOidcConfigInput(r *rosa.Runtime) OidcConfigInput {
 	oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem retrieving the OIDC Config '%s': %v", args.oidcConfigId, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem retrieving the OIDC Config '%s'", args.oidcConfigId)))
 	}
 	secretArn := oidcConfig.SecretArn()
 	bucketName := ""
@@ -150,12 +149,12 @@ func buildOidcConfigInput(r *rosa.Runtime) OidcConfigInput {
 		if args.region != parsedSecretArn.Region {
 			r.Reporter.Errorf("Secret region '%s' differs from chosen region '%s', "+
 				"please run the command supplying region parameter.", parsedSecretArn.Region, args.region)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		secretResourceName, err := aws.GetResourceIdFromSecretArn(secretArn)
 		if err != nil {
-			r.Reporter.Errorf("There was a problem parsing secret ARN '%s' : %v", secretArn, err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"There was a problem parsing secret ARN '%s' ", secretArn)))
 		}
 		// The secret when creating from ROSA options has the following format
 		// rosa-private-key-<prefix>-oidc-<random-hash-length-4>-<random-aws-created-hash>
@@ -170,12 +169,12 @@ func buildOidcConfigInput(r *rosa.Runtime) OidcConfigInput {
 	issuerUrl := oidcConfig.IssuerUrl()
 	hasClusterUsingOidcConfig, err := r.OCMClient.HasAClusterUsingOidcEndpointUrl(issuerUrl)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem checking if any clusters are using OIDC config '%s' : %v", issuerUrl, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem checking if any clusters are using OIDC config '%s' ", issuerUrl)))
 	}
 	if hasClusterUsingOidcConfig {
 		r.Reporter.Errorf("There are clusters using OIDC config '%s', can't delete the configuration", issuerUrl)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	return OidcConfigInput{
 		BucketName:          bucketName,
@@ -206,13 +205,13 @@ func (s *deleteUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 	}
 	err := r.AWSClient.DeleteSecretInSecretsManager(privateKeySecretArn)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem deleting private key from secrets manager: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem deleting private key from secrets manager")))
 	}
 	err = r.AWSClient.DeleteS3Bucket(bucketName)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem deleting S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem deleting S3 bucket '%s'", bucketName)))
 	}
 	if spin != nil {
 		spin.Stop()
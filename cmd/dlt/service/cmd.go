@@ -18,12 +18,13 @@ package service
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	msv1 "github.com/openshift-online/ocm-sdk-go/servicemgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -62,26 +63,25 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.ID == "" {
 		r.Reporter.Errorf("id not specified.")
 		cmd.Help()
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if !confirm.Confirm("delete service with id '%s'", args.ID) {
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// First get the service to report additional resources
 	// that must be manually deleted.
 	service, err := r.OCMClient.GetManagedService(ocm.DescribeManagedServiceArgs{ID: args.ID})
 	if err != nil {
-		r.Reporter.Errorf("Failed to get Managed Service: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get Managed Service")))
 	}
 
 	r.Reporter.Debugf("Deleting service with id %q", args.ID)
 	_, err = r.OCMClient.DeleteManagedService(args)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	r.Reporter.Infof("Service %q will start uninstalling now", args.ID)
 
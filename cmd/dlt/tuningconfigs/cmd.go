@@ -18,10 +18,10 @@ package tuningconfigs
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/input"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -66,7 +66,7 @@ func run(_ *cobra.Command, argv []string) {
 	tuningConfig, err := r.OCMClient.FindTuningConfigByName(cluster.ID(), tuningConfigName)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if confirm.Confirm("delete tuning config %s on cluster %s", tuningConfigName, clusterKey) {
@@ -75,7 +75,7 @@ func run(_ *cobra.Command, argv []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed to delete tuning config '%s' on cluster '%s': %v",
 				tuningConfigName, clusterKey, err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		r.Reporter.Infof("Successfully deleted tuning config '%s' from cluster '%s'", tuningConfigName, clusterKey)
 	}
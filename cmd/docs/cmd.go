@@ -18,12 +18,13 @@ package docs
 
 import (
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -81,8 +82,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	r := rosa.NewRuntime()
 	if err != nil {
-		r.Reporter.Errorf("Failed to generate documents: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to generate documents")))
 	}
 
 	r.Reporter.Infof("Documents generated successfully on '%s'", args.dir)
@@ -18,12 +18,12 @@ package oc
 
 import (
 	"fmt"
-	"os"
 	"runtime"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/cmd/verify/oc"
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	helper "github.com/openshift/rosa/pkg/helper/download"
 	rprtr "github.com/openshift/rosa/pkg/reporter"
 )
@@ -56,7 +56,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err := helper.Download(downloadURL, filename)
 	if err != nil {
 		reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	reporter.Infof("Successfully downloaded %s", filename)
@@ -18,7 +18,6 @@ package addon
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"strings"
 
@@ -26,6 +25,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -76,24 +77,23 @@ func run(cmd *cobra.Command, argv []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	addonParameters, err := r.OCMClient.GetAddOnParameters(cluster.ID(), addOnID)
 	if err != nil {
-		r.Reporter.Errorf("Failed to get add-on '%s' parameters: %v", addOnID, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get add-on '%s' parameters", addOnID)))
 	}
 
 	addOnInstallation, err := r.OCMClient.GetAddOnInstallation(cluster.ID(), addOnID)
 	if err != nil {
-		r.Reporter.Errorf("Failed to get add-on '%s' installation: %v", addOnID, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to get add-on '%s' installation", addOnID)))
 	}
 
 	if addonParameters.Len() == 0 {
 		r.Reporter.Errorf("Add-on '%s' has no parameters to edit", addOnID)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Determine if all required parameters have already been set as flags and ensure
@@ -105,7 +105,7 @@ func run(cmd *cobra.Command, argv []string) {
 			flag := cmd.Flags().Lookup(param.ID())
 			if flag != nil && !param.Editable() {
 				r.Reporter.Errorf("Parameter '%s' on addon '%s' cannot be modified", param.ID(), addOnID)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			return true
 		})
@@ -157,7 +157,7 @@ func run(cmd *cobra.Command, argv []string) {
 			val, err = interactive.GetAddonArgument(*param, dflt)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 		val = strings.Trim(val, " ")
@@ -165,13 +165,13 @@ func run(cmd *cobra.Command, argv []string) {
 			isValid, err := regexp.MatchString(param.Validation(), val)
 			if err != nil || !isValid {
 				r.Reporter.Errorf("Expected %v to match /%s/", val, param.Validation())
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 
 		if len(options) > 0 && !helper.Contains(values, val) {
 			r.Reporter.Errorf("Expected %v to match one of the options /%v/", val, values)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		addonArguments = append(addonArguments, ocm.AddOnParam{Key: param.ID(), Val: val})
 
@@ -181,8 +181,8 @@ func run(cmd *cobra.Command, argv []string) {
 	r.Reporter.Debugf("Updating add-on parameters for '%s' on cluster '%s'", addOnID, clusterKey)
 	err = r.OCMClient.UpdateAddOnInstallation(cluster.ID(), addOnID, addonArguments)
 	if err != nil {
-		r.Reporter.Errorf("Failed to update add-on installation '%s' for cluster '%s': %v", addOnID, clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to update add-on installation '%s' for cluster '%s'", addOnID, clusterKey)))
 	}
 	r.Reporter.Infof("Add-on '%s' is now updating. To check the status run 'rosa list addons -c %s'", addOnID, clusterKey)
 }
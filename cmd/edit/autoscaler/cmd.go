@@ -17,7 +17,6 @@ limitations under the License.
 package autoscaler
 
 import (
-	"os"
 	"strconv"
 
 	commonUtils "github.com/openshift-online/ocm-common/pkg/utils"
@@ -25,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/clusterautoscaler"
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -74,25 +74,25 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if cluster.Hypershift().Enabled() {
 		r.Reporter.Errorf("Hosted Control Plane clusters do not support cluster-autoscaler configuration")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready. Current state is '%s'", clusterKey, cluster.State())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	autoscaler, err := r.OCMClient.GetClusterAutoscaler(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed updating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if autoscaler == nil {
 		r.Reporter.Errorf("No autoscaler for cluster '%s' has been found. "+
 			"You should first create it via 'rosa create autoscaler'", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if !clusterautoscaler.IsAutoscalerSetViaCLI(cmd.Flags(), argsPrefix) && !interactive.Enabled() {
@@ -135,7 +135,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed updating autoscaler configuration for cluster '%s': %s",
 				cluster.ID(), err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		autoscalerArgs.ScaleDown.UtilizationThreshold = utilizationThreshold
 	}
@@ -144,21 +144,21 @@ func run(cmd *cobra.Command, _ []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed updating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	autoscalerConfig, err := clusterautoscaler.CreateAutoscalerConfig(autoscalerArgs)
 	if err != nil {
 		r.Reporter.Errorf("Failed updating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	_, err = r.OCMClient.UpdateClusterAutoscaler(cluster.ID(), autoscalerConfig)
 	if err != nil {
 		r.Reporter.Errorf("Failed updating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	r.Reporter.Infof("Successfully updated autoscaler configuration for cluster '%s'", cluster.ID())
@@ -27,6 +27,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/helper/roles"
 	"github.com/openshift/rosa/pkg/input"
@@ -192,7 +194,7 @@ func run(cmd *cobra.Command, _ []string) {
 	expiration, err := validateExpiration()
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if interactive.Enabled() {
@@ -243,7 +245,7 @@ func run(cmd *cobra.Command, _ []string) {
 			len(noProxySlice) > 0 ||
 			(additionalTrustBundleFile != nil && *additionalTrustBundleFile != "")) {
 		r.Reporter.Errorf("Cluster-wide proxy is not supported on clusters using the default VPC")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	var additionalAllowedPrincipals []string
@@ -266,7 +268,7 @@ func run(cmd *cobra.Command, _ []string) {
 	privateWarning, err = warnUserForOAuthHCPVisibility(r, clusterKey, cluster, privateWarning)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if interactive.Enabled() {
 		privateValue, err = interactive.GetBool(interactive.Input{
@@ -275,14 +277,13 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  privateValue,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid private value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid private value")))
 		}
 		private = &privateValue
 	} else if privateValue {
 		r.Reporter.Warnf("You are choosing to make your cluster API private. %s", privateWarning)
 		if !confirm.Confirm("set cluster '%s' as private", clusterKey) {
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 	}
 
@@ -303,13 +304,13 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  disableWorkloadMonitoringValue,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid disable-workload-monitoring value: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid disable-workload-monitoring value")))
 		}
 		disableWorkloadMonitoring = &disableWorkloadMonitoringValue
 	} else if disableWorkloadMonitoringValue {
 		if !confirm.Confirm("disable workload monitoring for your cluster %s", clusterKey) {
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 	}
 
@@ -324,8 +325,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Default: enableProxy,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid proxy-enabled value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid proxy-enabled value")))
 		}
 		enableProxy = enableProxyValue
 	}
@@ -358,8 +358,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  def,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid http proxy: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid http proxy")))
 		}
 
 		if len(httpProxyValue) == 0 {
@@ -377,7 +376,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = ocm.ValidateHTTPProxy(*httpProxy)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -400,8 +399,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  def,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid https proxy: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid https proxy")))
 		}
 		if len(httpsProxyValue) == 0 {
 			//user skipped the prompt by pressing 'enter'
@@ -418,7 +416,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = interactive.IsURL(*httpsProxy)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -434,14 +432,14 @@ func run(cmd *cobra.Command, _ []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid set of no proxy domains/CIDR's: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid set of no proxy domains/CIDR's")))
 		}
 		noProxySlice = helper.HandleEmptyStringOnSlice(strings.Split(noProxyInput, ","))
 	}
 	if isExpectedHTTPProxyOrHTTPSProxy(httpProxy, httpsProxy, noProxySlice, cluster) {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if len(noProxySlice) > 0 {
@@ -452,13 +450,13 @@ func run(cmd *cobra.Command, _ []string) {
 		duplicate, found := aws.HasDuplicates(noProxySlice)
 		if found {
 			r.Reporter.Errorf("Invalid no-proxy list, duplicate key '%s' found", duplicate)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		for _, domain := range noProxySlice {
 			err := aws.UserNoProxyValidator(domain)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 	}
@@ -475,8 +473,8 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  updateAdditionalTrustBundle,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid -update-additional-trust-bundle value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid -update-additional-trust-bundle value")))
 		}
 		updateAdditionalTrustBundle = updateAdditionalTrustBundleValue
 	}
@@ -498,8 +496,8 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  def,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid additional trust bundle file name: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid additional trust bundle file name")))
 		}
 
 		if len(additionalTrustBundleFileValue) == 0 {
@@ -517,7 +515,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = ocm.ValidateAdditionalTrustBundle(*additionalTrustBundleFile)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -533,8 +531,8 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  updateAdditionalAllowedPrincipals,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid update-additional-allowed-principals value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid update-additional-allowed-principals value")))
 		}
 		updateAdditionalAllowedPrincipals = updateAdditionalAllowedPrincipalsValue
 	}
@@ -545,8 +543,8 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  cluster.AWS().AdditionalAllowedPrincipals(),
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value for Additional Allowed Principal ARNs: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid value for Additional Allowed Principal ARNs")))
 		}
 		additionalAllowedPrincipals = helper.HandleEmptyStringOnSlice(strings.Split(aapInputs, ","))
 	}
@@ -557,7 +555,7 @@ func run(cmd *cobra.Command, _ []string) {
 		} else {
 			if err := roles.ValidateAdditionalAllowedPrincipals(additionalAllowedPrincipals); err != nil {
 				r.Reporter.Errorf(err.Error())
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 	}
@@ -566,13 +564,13 @@ func run(cmd *cobra.Command, _ []string) {
 	auditLogRole, err := setAuditLogForwarding(r, cmd, cluster, args.auditLogRoleARN)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if interactive.Enabled() && aws.IsHostedCP(cluster) {
 		auditLogRole, err = auditLogInteractivePrompt(r, cmd, cluster)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -603,8 +601,7 @@ func run(cmd *cobra.Command, _ []string) {
 			if len(*additionalTrustBundleFile) > 0 {
 				cert, err := os.ReadFile(*additionalTrustBundleFile)
 				if err != nil {
-					r.Reporter.Errorf("Failed to read additional trust bundle file: %s", err)
-					os.Exit(1)
+					cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to read additional trust bundle file")))
 				}
 				*clusterConfig.AdditionalTrustBundle = string(cert)
 			}
@@ -635,8 +632,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Default:  deleteProtection,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid value: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value")))
 		}
 	}
 
@@ -644,21 +640,19 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Debugf("Updating cluster deletion protection to : %t", deleteProtection)
 		newDeleteProtection, err := cmv1.NewDeleteProtection().Enabled(deleteProtection).Build()
 		if err != nil {
-			r.Reporter.Errorf("Failed to build delete protection: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to build delete protection")))
 		}
 
 		if err := r.OCMClient.UpdateClusterDeletionProtection(cluster.ID(), newDeleteProtection); err != nil {
 			r.Reporter.Errorf("Failed to update cluster delete protection: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
 	r.Reporter.Debugf("Updating cluster '%s'", clusterKey)
 	err = r.OCMClient.UpdateCluster(cluster.ID(), r.Creator, clusterConfig)
 	if err != nil {
-		r.Reporter.Errorf("Failed to update cluster: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to update cluster")))
 	}
 	r.Reporter.Infof("Updated cluster '%s'", clusterKey)
 }
@@ -768,13 +762,13 @@ func confirmAuditLogForwarding(r *rosa.Runtime, auditLogArn *string) {
 	if *auditLogArn != "" {
 		r.Reporter.Warnf("You are choosing to enable audit log forwarding")
 		if !confirm.Confirm("enable audit log forwarding for cluster with the provided role arn '%s'", *auditLogArn) {
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 		return
 	}
 	r.Reporter.Warnf("You are choosing to disable audit log forwarding.")
 	if !confirm.Confirm("disable audit log forwarding for cluster") {
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 }
 
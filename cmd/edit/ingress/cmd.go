@@ -18,7 +18,6 @@ package ingress
 
 import (
 	"fmt"
-	"os"
 	"reflect"
 	"regexp"
 	"strings"
@@ -27,6 +26,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	utils "github.com/openshift/rosa/pkg/helper"
 	helper "github.com/openshift/rosa/pkg/ingress"
 	"github.com/openshift/rosa/pkg/interactive"
@@ -162,7 +163,7 @@ func run(cmd *cobra.Command, argv []string) {
 			"Ingress  identifier '%s' isn't valid: it must contain only letters or digits",
 			ingressKey,
 		)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	clusterKey := r.GetClusterKey()
@@ -180,8 +181,8 @@ func run(cmd *cobra.Command, argv []string) {
 		var err error
 		hasLegacyIngressSupport, err = r.OCMClient.HasLegacyIngressSupport(cluster)
 		if err != nil {
-			r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"There was a problem checking version compatibility")))
 		}
 	}
 
@@ -191,12 +192,12 @@ func run(cmd *cobra.Command, argv []string) {
 				"New ingress attributes %s can't be supplied for Hosted Control Plane clusters",
 				utils.SliceToSortedString(exclusivelyIngressV2Flags),
 			)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		} else if hasLegacyIngressSupport {
 			r.Reporter.Errorf("New ingress attributes %s can't be supplied for legacy supported clusters."+
 				" For more information on how to be supported please check: %s",
 				utils.SliceToSortedString(exclusivelyIngressV2Flags), ingressV2DocLink)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -204,7 +205,7 @@ func run(cmd *cobra.Command, argv []string) {
 		r.Reporter.Errorf(
 			"Classic cluster '%s' is PrivateLink on legacy ingress support and does not allow updating ingresses",
 			clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	var private *bool
@@ -217,8 +218,7 @@ func run(cmd *cobra.Command, argv []string) {
 			Default:  args.private,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid private value: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid private value")))
 		}
 		private = &privArg
 	}
@@ -230,28 +230,27 @@ func run(cmd *cobra.Command, argv []string) {
 
 		err := r.OCMClient.UpdateCluster(clusterKey, r.Creator, clusterConfig)
 		if err != nil {
-			r.Reporter.Errorf("Failed to update cluster API on cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Failed to update cluster API on cluster '%s'", clusterKey)))
 		}
 		r.Reporter.Infof("Updated ingress '%s' on cluster '%s'", ingressKey, clusterKey)
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	ingress, err := r.OCMClient.GetIngress(cluster.ID(), ingressKey)
 	if err != nil {
-		r.Reporter.Errorf("Failed to fetch ingress: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to fetch ingress")))
 	}
 
 	var routeSelector *string
 	if cmd.Flags().Changed(routeSelectorFlag) || cmd.Flags().Changed(labelMatchFlag) {
 		if ocm.IsHyperShiftCluster(cluster) {
 			r.Reporter.Errorf("Updating route selectors is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if ingress.Default() && hasLegacyIngressSupport {
 			r.Reporter.Errorf("Updating route selectors for default ingress is not allowed for legacy ingress support")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		routeSelector = &args.routeSelector
 	} else if interactive.Enabled() && !ocm.IsHyperShiftCluster(cluster) &&
@@ -268,8 +267,8 @@ func run(cmd *cobra.Command, argv []string) {
 			},
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Expected a valid comma-separated list of attributes")))
 		}
 		routeSelector = &routeSelectorArg
 	}
@@ -278,11 +277,11 @@ func run(cmd *cobra.Command, argv []string) {
 	if cmd.Flags().Changed(lbTypeFlag) {
 		if ocm.IsHyperShiftCluster(cluster) {
 			r.Reporter.Errorf("Updating Load Balancer Type is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if ocm.IsSts(cluster) && hasLegacyIngressSupport {
 			r.Reporter.Errorf("Updating Load Balancer Type is not supported for STS clusters on legacy ingress support")
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		lbType = &args.lbType
 	} else if interactive.Enabled() && (!ocm.IsHyperShiftCluster(cluster) &&
@@ -298,8 +297,7 @@ func run(cmd *cobra.Command, argv []string) {
 			Default:  *lbType,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid Load Balancer type: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid Load Balancer type")))
 		}
 		lbType = &lbTypeArg
 	}
@@ -313,7 +311,7 @@ func run(cmd *cobra.Command, argv []string) {
 		if cmd.Flags().Changed(excludedNamespacesFlag) {
 			if ocm.IsHyperShiftCluster(cluster) {
 				r.Reporter.Errorf("Updating excluded namespace is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			excludedNamespaces = &args.excludedNamespaces
 		} else if isInteractiveEnabledAndNotHcp {
@@ -323,15 +321,15 @@ func run(cmd *cobra.Command, argv []string) {
 				Default:  args.excludedNamespaces,
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"Expected a valid comma-separated list of attributes")))
 			}
 			excludedNamespaces = &excludedNamespacesArg
 		}
 		if cmd.Flags().Changed(wildcardPolicyFlag) {
 			if ocm.IsHyperShiftCluster(cluster) {
 				r.Reporter.Errorf("Updating Wildcard Policy is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			wildcardPolicy = &args.wildcardPolicy
 		} else if isInteractiveEnabledAndNotHcp {
@@ -342,8 +340,7 @@ func run(cmd *cobra.Command, argv []string) {
 				Default:  args.wildcardPolicy,
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid Wildcard Policy: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid Wildcard Policy")))
 			}
 			wildcardPolicy = &wildcardPolicyArg
 		}
@@ -352,7 +349,7 @@ func run(cmd *cobra.Command, argv []string) {
 				r.Reporter.Errorf(
 					"Updating Namespace Ownership Policy is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			namespaceOwnershipPolicy = &args.namespaceOwnershipPolicy
 		} else if isInteractiveEnabledAndNotHcp {
@@ -363,8 +360,7 @@ func run(cmd *cobra.Command, argv []string) {
 				Default:  args.namespaceOwnershipPolicy,
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid Namespace Ownership Policy: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid Namespace Ownership Policy")))
 			}
 			namespaceOwnershipPolicy = &namespaceOwnershipPolicyArg
 		}
@@ -374,12 +370,12 @@ func run(cmd *cobra.Command, argv []string) {
 				r.Reporter.Errorf(
 					"Updating Cluster Component Routes is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			componentRoutes, err = parseComponentRoutes(args.componentRoutes)
 			if err != nil {
-				r.Reporter.Errorf("An error occurred whilst parsing the supplied component routes: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"An error occurred whilst parsing the supplied component routes")))
 			}
 		} else if isInteractiveEnabledAndNotHcp {
 			componentRoutes = map[string]*cmv1.ComponentRouteBuilder{}
@@ -399,8 +395,8 @@ func run(cmd *cobra.Command, argv []string) {
 							Default:  defaultValue,
 						})
 						if err != nil {
-							r.Reporter.Errorf("Expected a valid component route '%s': %s", parameterName, err)
-							os.Exit(1)
+							cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+								"Expected a valid component route '%s'", parameterName)))
 						}
 						// TODO: use reflection, couldn't get it to work
 						if parameterName == hostnameParameter {
@@ -439,7 +435,7 @@ func run(cmd *cobra.Command, argv []string) {
 			routeSelectors, err = helper.GetRouteSelector(*routeSelector)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 		ingressBuilder = ingressBuilder.RouteSelectors(routeSelectors)
@@ -471,8 +467,8 @@ func run(cmd *cobra.Command, argv []string) {
 
 	ingress, err = ingressBuilder.Build()
 	if err != nil {
-		r.Reporter.Errorf("Failed to create ingress for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to create ingress for cluster '%s'", clusterKey)))
 	}
 
 	sameRouteSelectors := routeSelector == nil || reflect.DeepEqual(curRouteSelectors, ingress.RouteSelectors())
@@ -496,7 +492,7 @@ func run(cmd *cobra.Command, argv []string) {
 		sameExcludedNamespaces && sameWildcardPolicy && sameNamespaceOwnershipPolicy &&
 		sameComponentRoutes {
 		r.Reporter.Warnf("No need to update ingress as there are no changes")
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	r.Reporter.Debugf("Updating ingress '%s' on cluster '%s'", ingress.ID(), clusterKey)
@@ -504,7 +500,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed to update ingress '%s' on cluster '%s': %s",
 			ingress.ID(), clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	r.Reporter.Infof("Updated ingress '%s' on cluster '%s'", ingress.ID(), clusterKey)
 }
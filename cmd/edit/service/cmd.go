@@ -17,12 +17,12 @@ limitations under the License.
 package service
 
 import (
-	"os"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -59,28 +59,25 @@ func run(cmd *cobra.Command, argv []string) {
 
 	err := arguments.ParseKnownFlags(cmd, argv, false)
 	if err != nil {
-		r.Reporter.Errorf("Failed to parse flags: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to parse flags")))
 	}
 
 	if args.ID == "" {
 		r.Reporter.Errorf("Service id not specified.")
 		cmd.Help()
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Try to find the service:
 	r.Reporter.Debugf("Loading service %q", args.ID)
 	service, err := r.OCMClient.GetManagedService(ocm.DescribeManagedServiceArgs{ID: args.ID})
 	if err != nil {
-		r.Reporter.Errorf("Failed to get service %q: %v", args.ID, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get service %q", args.ID)))
 	}
 
 	addOn, err := r.OCMClient.GetAddOn(service.Service())
 	if err != nil {
-		r.Reporter.Errorf("Failed to get add-on %q: %s", service.Service(), err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get add-on %q", service.Service())))
 	}
 
 	addonParameters := addOn.Parameters()
@@ -91,8 +88,7 @@ func run(cmd *cobra.Command, argv []string) {
 
 	err = arguments.ParseKnownFlags(cmd, argv, true)
 	if err != nil {
-		r.Reporter.Errorf("Failed to parse flags: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to parse flags")))
 	}
 
 	args.Parameters = map[string]string{}
@@ -108,8 +104,7 @@ func run(cmd *cobra.Command, argv []string) {
 	r.Reporter.Debugf("Updating parameters for service %q", args.ID)
 	err = r.OCMClient.UpdateManagedService(args)
 	if err != nil {
-		r.Reporter.Errorf("Failed to update service %q: %v", args.ID, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to update service %q", args.ID)))
 	}
 	r.Reporter.Infof("Service %q is now updating. To check the status run 'rosa describe service --id %s'",
 		args.ID, args.ID)
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/clusterspec"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "cluster"
+	short = "Export a cluster and its resources"
+	long  = "Export a cluster and its machine pools, identity providers, ingresses, kubelet configs, " +
+		"tuning configs and autoscaler as the rosa commands that create them, or as a spec document " +
+		"that can be passed to 'rosa create cluster --from-file'. Settings that can't be reproduced, " +
+		"like secrets, are reported as warnings. The commands read the secrets from shell variables, " +
+		"that the warnings name."
+	example = `  # Print the commands that create a cluster named "mycluster" and its resources
+  rosa export cluster --cluster=mycluster
+
+  # Save the spec document of a cluster named "mycluster"
+  rosa export cluster --cluster=mycluster --output=yaml > mycluster.yaml`
+)
+
+func NewExportClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ExportClusterRunner()),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func ExportClusterRunner() rosa.CommandRunner {
+	return func(ctx context.Context, runtime *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		cluster, err := runtime.OCMClient.GetCluster(runtime.GetClusterKey(), runtime.Creator)
+		if err != nil {
+			return err
+		}
+
+		live, err := clusterspec.FetchLiveResources(ctx, runtime.OCMClient, cluster)
+		if err != nil {
+			return err
+		}
+
+		doc, warnings := clusterspec.FromCluster(cluster, live)
+		if output.HasFlag() {
+			for _, warning := range warnings {
+				runtime.Reporter.Warnf("%s", warning)
+			}
+			return output.Print(doc)
+		}
+
+		commands, commandWarnings := clusterspec.Commands(doc)
+		for _, warning := range append(warnings, commandWarnings...) {
+			runtime.Reporter.Warnf("%s", warning)
+		}
+		for _, command := range commands {
+			fmt.Println(command)
+		}
+		return nil
+	}
+}
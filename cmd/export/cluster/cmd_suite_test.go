@@ -0,0 +1,13 @@
+package cluster
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestExportCluster(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa export cluster")
+}
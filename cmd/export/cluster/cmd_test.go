@@ -0,0 +1,116 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("rosa export cluster", func() {
+	Context("Create Command", func() {
+		It("Returns Command", func() {
+			cmd := NewExportClusterCommand()
+			Expect(cmd).NotTo(BeNil())
+
+			Expect(cmd.Use).To(Equal(use))
+			Expect(cmd.Example).To(Equal(example))
+			Expect(cmd.Short).To(Equal(short))
+			Expect(cmd.Long).To(Equal(long))
+			Expect(cmd.Args).NotTo(BeNil())
+			Expect(cmd.Run).NotTo(BeNil())
+
+			Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+		})
+	})
+
+	Context("Execute command", func() {
+		var t *TestingRuntime
+		var cluster *cmv1.Cluster
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+
+			cluster = MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.Region(cmv1.NewCloudRegion().ID("us-east-1"))
+				c.Nodes(cmv1.NewClusterNodes().ComputeMachineType(cmv1.NewMachineType().ID("m5.xlarge")).Compute(2))
+			})
+			t.SetCluster(cluster.Name(), cluster)
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		routeResources := func(machinePools []*cmv1.MachinePool) {
+			prefix := fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s", cluster.ID())
+			t.ApiServer.RouteToHandler("GET", prefix+"/machine_pools",
+				RespondWithJSON(http.StatusOK, FormatMachinePoolList(machinePools)))
+			t.ApiServer.RouteToHandler("GET", prefix+"/ingresses",
+				RespondWithJSON(http.StatusOK, FormatIngressList([]*cmv1.Ingress{})))
+			t.ApiServer.RouteToHandler("GET", prefix+"/autoscaler",
+				RespondWithJSON(http.StatusNotFound, "{}"))
+			t.ApiServer.RouteToHandler("GET", prefix+"/identity_providers",
+				RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{})))
+			t.ApiServer.RouteToHandler("GET", prefix+"/kubelet_configs",
+				RespondWithJSON(http.StatusOK, FormatKubeletConfigList([]*cmv1.KubeletConfig{})))
+		}
+
+		It("Returns an error if the cluster does not exist", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList(make([]*cmv1.Cluster, 0))))
+
+			err := ExportClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("There is no cluster with identifier or name 'cluster'"))
+		})
+
+		It("Returns an error if the machine pools can't be listed", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.RouteToHandler("GET",
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/machine_pools", cluster.ID()),
+				RespondWithJSON(http.StatusInternalServerError, "{}"))
+
+			err := ExportClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(ContainSubstring("Failed to get machine pools")))
+		})
+
+		It("Prints the create commands", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			machinePool, err := cmv1.NewMachinePool().ID("mp-1").InstanceType("m5.xlarge").Replicas(1).Build()
+			Expect(err).NotTo(HaveOccurred())
+			routeResources([]*cmv1.MachinePool{machinePool})
+
+			t.StdOutReader.Record()
+			err = ExportClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(Equal(
+				"rosa create cluster --cluster-name cluster --non-sts --region us-east-1" +
+					" --compute-machine-type m5.xlarge --replicas 2\n" +
+					"rosa create machinepool --cluster cluster --name mp-1 --instance-type m5.xlarge --replicas 1\n"))
+		})
+
+		It("Prints the spec document", func() {
+			output.SetOutput("yaml")
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			routeResources([]*cmv1.MachinePool{})
+
+			t.StdOutReader.Record()
+			err := ExportClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(ContainSubstring("apiVersion: rosa.openshift.io/v1alpha1\n"))
+			Expect(stdout).To(ContainSubstring("kind: Cluster\n"))
+			Expect(stdout).To(ContainSubstring("  name: cluster\n"))
+		})
+	})
+})
@@ -17,11 +17,11 @@ limitations under the License.
 package cluster
 
 import (
-	"os"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -54,20 +54,19 @@ func run(_ *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Hibernating a cluster is only supported for 'Ready' clusters."+
 			" Cluster '%s' is in '%s' state",
 			clusterKey, cluster.State())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if !confirm.Yes() {
 		r.Reporter.Infof(limitedSupportWarning)
 		if !confirm.Prompt(false, confirmationPrompt) {
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
 	err := r.OCMClient.HibernateCluster(cluster.ID())
 	if err != nil {
-		r.Reporter.Errorf("Failed to update cluster: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to update cluster")))
 	}
 	r.Reporter.Infof(hibernationPeriodWarning)
 	r.Reporter.Infof("Cluster '%s' is hibernating.", clusterKey)
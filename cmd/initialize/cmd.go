@@ -18,7 +18,6 @@ package initialize
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -30,6 +29,8 @@ import (
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/aws/region"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -110,15 +111,13 @@ func run(cmd *cobra.Command, argv []string) {
 	// longer checks.
 	err := login.Call(cmd, argv, r.Reporter)
 	if err != nil {
-		r.Reporter.Errorf("Failed to login to OCM: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to login to OCM")))
 	}
 
 	// Get AWS region
 	awsRegion, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
-		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Error getting region")))
 	}
 	supportedRegions, err := r.OCMClient.GetDatabaseRegionList()
 	if err != nil {
@@ -127,7 +126,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if !helper.Contains(supportedRegions, awsRegion) {
 		r.Reporter.Errorf("Unsupported region '%s', available regions: %s",
 			awsRegion, helper.SliceToSortedString(supportedRegions))
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	// Create the AWS client:
 	client, err := aws.NewClient().
@@ -142,7 +141,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r.OCMClient.LogEvent("ROSAInitCredentialsSTS", nil)
 		}
 		r.Reporter.Errorf("Error creating AWS client: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Validate AWS credentials for current user
@@ -151,12 +150,12 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		r.OCMClient.LogEvent("ROSAInitCredentialsFailed", nil)
 		r.Reporter.Errorf("Error validating AWS credentials: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if !ok {
 		r.OCMClient.LogEvent("ROSAInitCredentialsInvalid", nil)
 		r.Reporter.Errorf("AWS credentials are invalid")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	r.Reporter.Infof("AWS credentials are valid!")
 
@@ -165,17 +164,17 @@ func run(cmd *cobra.Command, argv []string) {
 	// Delete CloudFormation stack and exit
 	if args.dlt {
 		if !confirm.Confirm("delete cluster administrator user '%s'", aws.AdminUserName) {
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 		r.Reporter.Infof("Deleting cluster administrator user '%s'...", aws.AdminUserName)
 		err = deleteStack(cfClient, r.OCMClient)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		r.Reporter.Infof("Admin user '%s' deleted successfully!", aws.AdminUserName)
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Validate AWS SCP/IAM Permissions
@@ -200,7 +199,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		r.OCMClient.LogEvent("ROSAInitCreateStackFailed", nil)
 		r.Reporter.Errorf("Failed to create user '%s': %v", aws.AdminUserName, err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if created {
 		r.Reporter.Infof("Admin user '%s' created successfully!", aws.AdminUserName)
@@ -216,14 +215,14 @@ func run(cmd *cobra.Command, argv []string) {
 
 		policies, err := r.OCMClient.GetPolicies("OSDSCPPolicy")
 		if err != nil {
-			r.Reporter.Errorf("Failed to get 'osdscppolicy' for '%s': %v", aws.AdminUserName, err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Failed to get 'osdscppolicy' for '%s'", aws.AdminUserName)))
 		}
 		isValid, err := client.ValidateSCP(&target, policies)
 		if !isValid {
 			r.OCMClient.LogEvent("ROSAInitSCPPoliciesFailed", nil)
 			r.Reporter.Errorf("Failed to verify permissions for user '%s': %v", target, err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		r.Reporter.Infof("AWS SCP policies ok")
 	} else {
@@ -18,7 +18,6 @@ package addon
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"strings"
 
@@ -32,6 +31,8 @@ import (
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -106,7 +107,7 @@ func run(cmd *cobra.Command, argv []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	ensureAddonNotInstalled(r, cluster.ID(), addOnID)
@@ -114,7 +115,7 @@ func run(cmd *cobra.Command, argv []string) {
 	addOn, err := r.OCMClient.GetAddOn(addOnID)
 	if err != nil {
 		r.Reporter.Warnf("Failed to get add-on '%s'", addOnID)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Verify if addon requires STS authentication
@@ -124,7 +125,7 @@ func run(cmd *cobra.Command, argv []string) {
 	}
 
 	if !confirm.Confirm("install add-on '%s' on cluster '%s'", addOnID, clusterKey) {
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	if isSTS {
@@ -139,11 +140,11 @@ func run(cmd *cobra.Command, argv []string) {
 					err = createAddonRole(r, roleName, cr, cmd, cluster)
 					if err != nil {
 						r.Reporter.Errorf("%s", err)
-						os.Exit(1)
+						cmdcontext.Exit(1)
 					}
 				} else {
 					r.Reporter.Errorf("%s", err)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 			}
 			// TODO : verify the role has the right permissions
@@ -155,22 +156,20 @@ func run(cmd *cobra.Command, argv []string) {
 				ServiceAccount(cr.ServiceAccount()).
 				Build()
 			if err != nil {
-				r.Reporter.Errorf("Failed to build operator role '%s': %s", roleName, err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to build operator role '%s'", roleName)))
 			}
 
 			err = r.OCMClient.AddClusterOperatorRole(cluster, operatorRole)
 			if err != nil {
-				r.Reporter.Errorf("Failed to add operator role to cluster '%s': %s", clusterKey, err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"Failed to add operator role to cluster '%s'", clusterKey)))
 			}
 		}
 	}
 
 	addonParameters, err := r.OCMClient.GetAddOnParameters(cluster.ID(), addOnID)
 	if err != nil {
-		r.Reporter.Errorf("Failed to get add-on '%s' parameters: %v", addOnID, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get add-on '%s' parameters", addOnID)))
 	}
 
 	var addonArguments []ocm.AddOnParam
@@ -212,20 +211,20 @@ func run(cmd *cobra.Command, argv []string) {
 				val, err = interactive.GetAddonArgument(*param, param.DefaultValue())
 				if err != nil {
 					r.Reporter.Errorf("%s", err)
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 			}
 
 			val = strings.Trim(val, " ")
 			if len(options) > 0 && !helper.Contains(values, val) {
 				r.Reporter.Errorf("Expected %v to match one of the options /%v/", val, options)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			if val != "" && param.Validation() != "" {
 				isValid, err := regexp.MatchString(param.Validation(), val)
 				if err != nil || !isValid {
 					r.Reporter.Errorf("Expected %v to match /%s/", val, param.Validation())
-					os.Exit(1)
+					cmdcontext.Exit(1)
 				}
 			}
 			addonArguments = append(addonArguments, ocm.AddOnParam{Key: param.ID(), Val: val})
@@ -248,8 +247,7 @@ func run(cmd *cobra.Command, argv []string) {
 				Required: true,
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid billing model: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid billing model")))
 			}
 		}
 	}
@@ -261,8 +259,7 @@ func run(cmd *cobra.Command, argv []string) {
 			Required: true,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid account id: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid account id")))
 		}
 	}
 
@@ -274,8 +271,8 @@ func run(cmd *cobra.Command, argv []string) {
 	r.Reporter.Debugf("Installing add-on '%s' on cluster '%s'", addOnID, clusterKey)
 	err = r.OCMClient.InstallAddOn(cluster.ID(), addOnID, addonArguments, billing)
 	if err != nil {
-		r.Reporter.Errorf("Failed to add add-on installation '%s' for cluster '%s': %v", addOnID, clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to add add-on installation '%s' for cluster '%s'", addOnID, clusterKey)))
 	}
 	r.Reporter.Infof("Add-on '%s' is now installing. To check the status run 'rosa list addons -c %s'",
 		addOnID, clusterKey)
@@ -289,11 +286,11 @@ func ensureAddonNotInstalled(r *rosa.Runtime, clusterID, addOnID string) {
 	installation, err := r.OCMClient.GetAddOnInstallation(clusterID, addOnID)
 	if err != nil && errors.GetType(err) != errors.NotFound {
 		r.Reporter.Errorf("An error occurred while trying to get addon installation : %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if installation != nil {
 		r.Reporter.Warnf("Addon '%s' is already installed on cluster '%s'", addOnID, clusterID)
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 }
 
@@ -25,6 +25,8 @@ import (
 	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -64,14 +66,14 @@ func run(_ *cobra.Command, _ []string) {
 	versionList, err := ocm.GetVersionMinorList(r.OCMClient)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	_, err = r.OCMClient.ValidateVersion(args.version, versionList,
 		r.Cluster.Version().ChannelGroup(), r.Cluster.AWS().STS().RoleARN() == "", r.Cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Version '%s' is invalid", args.version)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	var spin *spinner.Spinner
@@ -90,22 +92,21 @@ func run(_ *cobra.Command, _ []string) {
 	}
 
 	if err != nil {
-		r.Reporter.Errorf("Failed to get account roles: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get account roles")))
 	}
 
 	if output.HasFlag() {
 		err = output.Print(accountRoles)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	if len(accountRoles) == 0 {
 		r.Reporter.Infof("No account roles available")
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Create the writer that will be used to print the tabulated results:
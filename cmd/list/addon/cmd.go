@@ -24,6 +24,8 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -63,22 +65,21 @@ func listAllAddOns(r *rosa.Runtime) {
 	r.Reporter.Debugf("Fetching all available add-ons")
 	addOnResources, err := r.OCMClient.GetAvailableAddOns()
 	if err != nil {
-		r.Reporter.Errorf("Failed to fetch add-ons: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to fetch add-ons")))
 	}
 
 	if output.HasFlag() {
 		err = output.Print(addOnResources)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	if len(addOnResources) == 0 {
 		r.Reporter.Infof("There are no add-ons available")
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Create the writer that will be used to print the tabulated results:
@@ -93,7 +94,7 @@ func listAllAddOns(r *rosa.Runtime) {
 	}
 	writer.Flush()
 
-	os.Exit(0)
+	cmdcontext.Exit(0)
 }
 
 // When the user specifies a clusterKey, this function lists the AddOns for that cluster
@@ -110,36 +111,36 @@ func listClusterAddOns(clusterKey string, r *rosa.Runtime) {
 				"must contain only letters, digits, dashes and underscores",
 			clusterKey,
 		)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady &&
 		cluster.State() != cmv1.ClusterStateHibernating {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Load any existing Add-Ons for this cluster
 	r.Reporter.Debugf("Loading add-ons installations for cluster '%s'", clusterKey)
 	clusterAddOns, err := r.OCMClient.GetClusterAddOns(cluster)
 	if err != nil {
-		r.Reporter.Errorf("Failed to get add-ons for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to get add-ons for cluster '%s'", clusterKey)))
 	}
 
 	if output.HasFlag() {
 		err = output.Print(clusterAddOns)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	if len(clusterAddOns) == 0 {
 		r.Reporter.Infof("There are no add-ons installed on cluster '%s'", clusterKey)
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Create the writer that will be used to print the tabulated results:
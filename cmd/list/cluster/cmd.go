@@ -19,12 +19,15 @@ package cluster
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
-	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -33,9 +36,16 @@ var Cmd = &cobra.Command{
 	Use:     "clusters",
 	Aliases: []string{"cluster"},
 	Short:   "List clusters",
-	Long:    "List clusters.",
+	Long: "List clusters. The clusters are filtered and sorted by OCM, and requested page by page. " +
+		"The table is printed once all the pages are received, so that its columns are aligned.",
 	Example: `  # List all clusters
-  rosa list clusters`,
+  rosa list clusters
+
+  # List the ready Hosted Control Plane clusters of every AWS account of the organization
+  rosa list clusters --all-accounts --search "state = 'ready' AND hypershift.enabled = 'true'"
+
+  # List the 10 most recent clusters with their version and region
+  rosa list clusters --columns id,name,version,region,created --sort-by created:desc --limit 10`,
 	Args: cobra.NoArgs,
 	Run:  run,
 }
@@ -45,6 +55,10 @@ const clusterCount = 1000
 var args struct {
 	listAll        bool
 	accountRoleArn string
+	search         string
+	columns        string
+	sortBy         string
+	limit          int
 }
 
 func init() {
@@ -52,80 +66,106 @@ func init() {
 	flags.SortFlags = false
 
 	output.AddFlag(Cmd)
-	flags.BoolVarP(&args.listAll, "all", "a", false, "List all clusters across different AWS "+
+	flags.BoolVarP(&args.listAll, "all-accounts", "a", false, "List all clusters across different AWS "+
 		"accounts under the same Red Hat organization")
+	flags.BoolVar(&args.listAll, "all", false, "List all clusters across different AWS "+
+		"accounts under the same Red Hat organization")
+	flags.MarkDeprecated("all", "use --all-accounts instead")
 	flags.StringVar(&args.accountRoleArn, "account-role-arn", "", "List all clusters "+
 		"using the account role identified by the ARN")
+	flags.StringVar(&args.search, "search", "", "OCM search expression used to filter the clusters, "+
+		"for example \"state = 'ready' AND region.id = 'us-east-1'\"")
+	flags.StringVar(&args.columns, "columns", defaultColumns, fmt.Sprintf("Comma separated list of "+
+		"columns to print. Supported columns are: %s", strings.Join(columnNames, ", ")))
+	flags.StringVar(&args.sortBy, "sort-by", "", "Column used to sort the clusters, optionally followed by "+
+		"':asc' or ':desc', for example 'created:desc'")
+	flags.IntVar(&args.limit, "limit", 0, "Maximum number of clusters to list, 0 lists all clusters")
 }
 
-func listClustersUsingAccountRole(creator *aws.Creator, runtime *rosa.Runtime) ([]*v1.Cluster, error) {
-	role, err := runtime.AWSClient.GetAccountRoleByArn(args.accountRoleArn)
+func listOptions(runtime *rosa.Runtime) (ocm.ClusterListOptions, error) {
+	options := ocm.ClusterListOptions{
+		Search:   args.search,
+		PageSize: clusterCount,
+	}
+	if !args.listAll {
+		options.Creator = runtime.Creator
+	}
+	if args.limit < 0 {
+		return options, fmt.Errorf("Invalid limit '%d', it should be a positive number", args.limit)
+	}
+	options.Limit = args.limit
+
+	order, err := parseSortBy(args.sortBy)
 	if err != nil {
-		return []*v1.Cluster{}, err
+		return options, err
 	}
+	options.Order = order
 
-	return runtime.OCMClient.GetClustersUsingAccountRole(creator, role, clusterCount)
+	if args.accountRoleArn != "" {
+		role, err := runtime.AWSClient.GetAccountRoleByArn(args.accountRoleArn)
+		if err != nil {
+			return options, err
+		}
+		options.AccountRole = &role
+	}
+	return options, nil
 }
 
 func run(_ *cobra.Command, _ []string) {
 	r := rosa.NewRuntime().WithAWS().WithOCM()
 	defer r.Cleanup()
 
-	// Retrieve the list of clusters:
-	var creator *aws.Creator
-	if args.listAll {
-		creator = nil
-	} else {
-		creator = r.Creator
-	}
-
-	var clusters []*v1.Cluster
-	var err error
-
-	if args.accountRoleArn != "" {
-		clusters, err = listClustersUsingAccountRole(creator, r)
-	} else {
-		clusters, err = r.OCMClient.GetClusters(creator, clusterCount)
+	selectedColumns, err := parseColumns(args.columns)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		cmdcontext.Exit(1)
 	}
-
+	options, err := listOptions(r)
 	if err != nil {
-		r.Reporter.Errorf("Failed to get clusters: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get clusters")))
 	}
 
 	if output.HasFlag() {
+		// The structured output is a single document, so it needs every cluster
+		clusters := []*v1.Cluster{}
+		err = r.OCMClient.ListClusters(options, func(page []*v1.Cluster) error {
+			clusters = append(clusters, page...)
+			return nil
+		})
+		if err != nil {
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get clusters")))
+		}
 		err = output.Print(clusters)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
-	}
-
-	if len(clusters) == 0 {
-		r.Reporter.Infof("No clusters available")
-		os.Exit(0)
+		return
 	}
 
-	// Create the writer that will be used to print the tabulated results:
+	// Create the writer that will be used to print the tabulated results. The pages are still
+	// requested one at a time and only their rows are kept, but the table is written at the end so
+	// that all its rows have the same column widths:
 	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\tNAME\tSTATE\tTOPOLOGY\n")
-	for _, cluster := range clusters {
-		typeOutput := "Classic"
-		if cluster.AWS() != nil && cluster.AWS().STS() != nil && cluster.AWS().STS().Enabled() {
-			typeOutput = "Classic (STS)"
-		}
-		if cluster.Hypershift().Enabled() {
-			typeOutput = "Hosted CP"
+	count := 0
+	err = r.OCMClient.ListClusters(options, func(page []*v1.Cluster) error {
+		if count == 0 && len(page) > 0 {
+			printHeader(writer, selectedColumns)
 		}
-		fmt.Fprintf(
-			writer,
-			"%s\t%s\t%s\t%s\n",
-			cluster.ID(),
-			cluster.Name(),
-			cluster.State(),
-			typeOutput,
-		)
+		count += len(page)
+		printClusters(writer, selectedColumns, page)
+		return nil
+	})
+	if err != nil {
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get clusters")))
+	}
+	if count == 0 {
+		r.Reporter.Infof("No clusters available")
+		return
+	}
+	err = writer.Flush()
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		cmdcontext.Exit(1)
 	}
-	writer.Flush()
 }
@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+type column struct {
+	header string
+	// field is the OCM field used to sort the clusters by this column, empty when the clusters can't
+	// be sorted by it
+	field string
+	value func(cluster *v1.Cluster) string
+}
+
+const defaultColumns = "id,name,state,topology"
+
+// Order in which the supported columns are listed in the help
+var columnNames = []string{"id", "name", "state", "topology", "version", "region", "multi-az", "external-id",
+	"created"}
+
+var columns = map[string]column{
+	"id": {
+		header: "ID",
+		field:  "id",
+		value:  (*v1.Cluster).ID,
+	},
+	"name": {
+		header: "NAME",
+		field:  "name",
+		value:  (*v1.Cluster).Name,
+	},
+	"state": {
+		header: "STATE",
+		field:  "state",
+		value: func(cluster *v1.Cluster) string {
+			return string(cluster.State())
+		},
+	},
+	"topology": {
+		header: "TOPOLOGY",
+		value:  topology,
+	},
+	"version": {
+		header: "VERSION",
+		field:  "version.id",
+		value: func(cluster *v1.Cluster) string {
+			return cluster.Version().RawID()
+		},
+	},
+	"region": {
+		header: "REGION",
+		field:  "region.id",
+		value: func(cluster *v1.Cluster) string {
+			return cluster.Region().ID()
+		},
+	},
+	"multi-az": {
+		header: "MULTI-AZ",
+		field:  "multi_az",
+		value: func(cluster *v1.Cluster) string {
+			return fmt.Sprintf("%t", cluster.MultiAZ())
+		},
+	},
+	"external-id": {
+		header: "EXTERNAL ID",
+		field:  "external_id",
+		value:  (*v1.Cluster).ExternalID,
+	},
+	"created": {
+		header: "CREATED",
+		field:  "creation_timestamp",
+		value: func(cluster *v1.Cluster) string {
+			return cluster.CreationTimestamp().Format(time.RFC3339)
+		},
+	},
+}
+
+func topology(cluster *v1.Cluster) string {
+	if cluster.Hypershift().Enabled() {
+		return "Hosted CP"
+	}
+	if cluster.AWS() != nil && cluster.AWS().STS() != nil && cluster.AWS().STS().Enabled() {
+		return "Classic (STS)"
+	}
+	return "Classic"
+}
+
+// parseColumns returns the columns of a comma separated list of column names.
+func parseColumns(value string) ([]column, error) {
+	result := []column{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		col, ok := columns[name]
+		if !ok {
+			return nil, fmt.Errorf("Invalid column '%s', supported columns are: %s",
+				name, strings.Join(columnNames, ", "))
+		}
+		result = append(result, col)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("At least one column is required")
+	}
+	return result, nil
+}
+
+// parseSortBy converts a column name, optionally followed by ':asc' or ':desc', to an OCM order
+// expression.
+func parseSortBy(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	name, direction, found := strings.Cut(strings.ToLower(strings.TrimSpace(value)), ":")
+	if !found {
+		direction = "asc"
+	}
+	if direction != "asc" && direction != "desc" {
+		return "", fmt.Errorf("Invalid sort direction '%s', it should be 'asc' or 'desc'", direction)
+	}
+	col, ok := columns[name]
+	if !ok {
+		return "", fmt.Errorf("Invalid sort column '%s', supported columns are: %s",
+			name, strings.Join(columnNames, ", "))
+	}
+	if col.field == "" {
+		return "", fmt.Errorf("Clusters can't be sorted by column '%s'", name)
+	}
+	return fmt.Sprintf("%s %s", col.field, direction), nil
+}
+
+func printHeader(writer io.Writer, selected []column) {
+	headers := make([]string, 0, len(selected))
+	for _, col := range selected {
+		headers = append(headers, col.header)
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+}
+
+func printClusters(writer io.Writer, selected []column, clusters []*v1.Cluster) {
+	for _, cluster := range clusters {
+		values := make([]string, 0, len(selected))
+		for _, col := range selected {
+			values = append(values, col.value(cluster))
+		}
+		fmt.Fprintf(writer, "%s\n", strings.Join(values, "\t"))
+	}
+}
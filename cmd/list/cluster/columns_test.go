@@ -0,0 +1,76 @@
+package cluster
+
+import (
+	"bytes"
+	"text/tabwriter"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("List clusters columns", func() {
+	Context("Columns", func() {
+		It("Prints the selected columns", func() {
+			selected, err := parseColumns("name, topology,region")
+			Expect(err).NotTo(HaveOccurred())
+
+			hcp := test.MockCluster(func(c *v1.ClusterBuilder) {
+				c.Name("hcp")
+				c.Region(v1.NewCloudRegion().ID("us-east-1"))
+				c.Hypershift(v1.NewHypershift().Enabled(true))
+			})
+			classic := test.MockCluster(func(c *v1.ClusterBuilder) {
+				c.Name("classic")
+				c.Region(v1.NewCloudRegion().ID("eu-west-1"))
+				c.Hypershift(v1.NewHypershift().Enabled(false))
+				c.AWS(v1.NewAWS().STS(v1.NewSTS().Enabled(false)))
+			})
+
+			var b bytes.Buffer
+			writer := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+			printHeader(writer, selected)
+			printClusters(writer, selected, []*v1.Cluster{hcp, classic})
+			Expect(writer.Flush()).To(Succeed())
+			Expect(b.String()).To(Equal("NAME     TOPOLOGY   REGION\n" +
+				"hcp      Hosted CP  us-east-1\n" +
+				"classic  Classic    eu-west-1\n"))
+		})
+
+		It("Fails with an unknown column", func() {
+			_, err := parseColumns("id,owner")
+			Expect(err).To(MatchError(ContainSubstring("Invalid column 'owner'")))
+		})
+
+		It("Fails without columns", func() {
+			_, err := parseColumns(" , ")
+			Expect(err).To(MatchError("At least one column is required"))
+		})
+	})
+
+	Context("Sort by", func() {
+		It("Sorts in ascending order by default", func() {
+			order, err := parseSortBy("name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order).To(Equal("name asc"))
+		})
+
+		It("Sorts in descending order", func() {
+			order, err := parseSortBy("created:desc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order).To(Equal("creation_timestamp desc"))
+		})
+
+		It("Fails with an invalid direction", func() {
+			_, err := parseSortBy("name:up")
+			Expect(err).To(MatchError("Invalid sort direction 'up', it should be 'asc' or 'desc'"))
+		})
+
+		It("Fails with a column that can't be sorted", func() {
+			_, err := parseSortBy("topology")
+			Expect(err).To(MatchError("Clusters can't be sorted by column 'topology'"))
+		})
+	})
+})
@@ -24,6 +24,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -68,22 +70,21 @@ func run(_ *cobra.Command, _ []string) {
 	}
 	dnsDomains, err := r.OCMClient.ListDNSDomains(search)
 	if err != nil {
-		r.Reporter.Errorf("Failed to list DNS Domains: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to list DNS Domains")))
 	}
 
 	if output.HasFlag() {
 		err = output.Print(dnsDomains)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	if len(dnsDomains) == 0 {
 		r.Reporter.Infof("There are no DNS Domains for your organization")
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Create the writer that will be used to print the tabulated results:
@@ -27,6 +27,8 @@ import (
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -116,7 +118,7 @@ func run(_ *cobra.Command, _ []string) {
 
 		if cluster.State() != v1.ClusterStateReady {
 			r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		upgradePolicyBuilder := v1.NewUpgradePolicy().
@@ -125,8 +127,8 @@ func run(_ *cobra.Command, _ []string) {
 
 		upgradePolicy, err := upgradePolicyBuilder.Build()
 		if err != nil {
-			r.Reporter.Errorf("Failed to schedule upgrade for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Failed to schedule upgrade for cluster '%s'", clusterKey)))
 		}
 
 		// check if the cluster upgrade requires gate agreements
@@ -134,7 +136,7 @@ func run(_ *cobra.Command, _ []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed to check for missing gate agreements upgrade for "+
 				"cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	} else {
 		// Query OCM for available OCP gates
@@ -143,34 +145,34 @@ func run(_ *cobra.Command, _ []string) {
 		case GateSTS:
 			versionGates, err = r.OCMClient.ListStsGates(version)
 			if err != nil {
-				r.Reporter.Errorf("Failed to fetch available %s gates for OCP version %s: %v", args.gate, args.version, err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"Failed to fetch available %s gates for OCP version %s", args.gate, args.version)))
 			}
 		case GateOCP:
 			versionGates, err = r.OCMClient.ListOcpGates(version)
 			if err != nil {
-				r.Reporter.Errorf("Failed to fetch available %s gates for OCP version %s: %v", args.gate, args.version, err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"Failed to fetch available %s gates for OCP version %s", args.gate, args.version)))
 			}
 		case "":
 			versionGates, err = r.OCMClient.ListAllOcpGates(version)
 			if err != nil {
-				r.Reporter.Errorf("Failed to fetch available %s gates for OCP version %s: %v", args.gate, args.version, err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+					"Failed to fetch available %s gates for OCP version %s", args.gate, args.version)))
 			}
 		default:
 			r.Reporter.Errorf("Invalid gate. Allowed values are %s and \"\" for all", strings.Join(Gates, ","))
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		if err != nil {
 			r.Reporter.Errorf("Failed to fetch available OCP gates for OCP version %s: %v", err, args.version)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		if len(versionGates) == 0 {
 			r.Reporter.Warnf("There are no gates for OCP version %s", args.version)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 
@@ -178,9 +180,9 @@ func run(_ *cobra.Command, _ []string) {
 		err = output.Print(versionGates)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Create the writer that will be used to print the tabulated results:
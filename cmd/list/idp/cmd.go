@@ -24,6 +24,8 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -55,34 +57,34 @@ func run(_ *cobra.Command, _ []string) {
 	if cluster.State() != cmv1.ClusterStateReady &&
 		cluster.State() != cmv1.ClusterStateHibernating {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf("Listing identity providers is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Load any existing IDPs for this cluster
 	r.Reporter.Debugf("Loading identity providers for cluster '%s'", clusterKey)
 	idps, err := r.OCMClient.GetIdentityProviders(cluster.ID())
 	if err != nil {
-		r.Reporter.Errorf("Failed to get identity providers for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to get identity providers for cluster '%s'", clusterKey)))
 	}
 
 	if output.HasFlag() {
 		err = output.Print(idps)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	if len(idps) == 0 {
 		r.Reporter.Infof("There are no identity providers configured for cluster '%s'", clusterKey)
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Create the writer that will be used to print the tabulated results:
@@ -27,6 +27,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -85,8 +87,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.oidcConfigId != "" {
 		config, err = r.OCMClient.GetOidcConfig(args.oidcConfigId)
 		if err != nil {
-			r.Reporter.Errorf("Failed to get OIDC config: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get OIDC config")))
 		}
 	}
 	providers, err := r.AWSClient.ListOidcProviders(clusterId, config)
@@ -95,8 +96,7 @@ func run(cmd *cobra.Command, _ []string) {
 		spin.Stop()
 	}
 	if err != nil {
-		r.Reporter.Errorf("Failed to get OIDC providers: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get OIDC providers")))
 	}
 
 	providersInUse := map[string]bool{}
@@ -104,14 +104,14 @@ func run(cmd *cobra.Command, _ []string) {
 		resourceName, err := aws.GetResourceIdFromOidcProviderARN(provider.Arn)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		has, err := r.OCMClient.
 			HasAClusterUsingOidcProvider(
 				fmt.Sprintf("https://%s", resourceName), r.Creator.AccountID)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		providersInUse[provider.Arn] = has
 	}
@@ -125,14 +125,14 @@ func run(cmd *cobra.Command, _ []string) {
 		err = output.Print(outList)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	if len(providers) == 0 {
 		r.Reporter.Infof("No OIDC providers available")
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Create the writer that will be used to print the tabulated results:
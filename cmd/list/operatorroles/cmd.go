@@ -25,6 +25,8 @@ import (
 	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -83,14 +85,14 @@ func run(cmd *cobra.Command, _ []string) {
 	versionList, err := ocm.GetVersionMinorList(r.OCMClient)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	_, err = r.OCMClient.ValidateVersion(args.version, versionList,
 		r.Cluster.Version().ChannelGroup(), r.Cluster.AWS().STS().RoleARN() == "", r.Cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Version '%s' is invalid", args.version)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	var spin *spinner.Spinner
@@ -108,8 +110,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
 		if err != nil {
-			r.Reporter.Errorf("Failed to get cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get cluster '%s'", clusterKey)))
 		}
 		clusterId = cluster.ID()
 		args.prefix = cluster.AWS().STS().OperatorRolePrefix()
@@ -124,8 +125,7 @@ func run(cmd *cobra.Command, _ []string) {
 	}
 
 	if err != nil {
-		r.Reporter.Errorf("Failed to get operator roles: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get operator roles")))
 	}
 
 	if len(operatorsMap) == 0 {
@@ -136,11 +136,11 @@ func run(cmd *cobra.Command, _ []string) {
 		if args.prefix != "" {
 			if _, ok := operatorsMap[args.prefix]; !ok {
 				r.Reporter.Infof("No operator roles available for prefix '%s'", args.prefix)
-				os.Exit(0)
+				cmdcontext.Exit(0)
 			}
 		}
 		r.Reporter.Infof(noOperatorRolesOutput)
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 	if output.HasFlag() {
 		var resource interface{} = operatorsMap
@@ -150,9 +150,9 @@ func run(cmd *cobra.Command, _ []string) {
 		err = output.Print(resource)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Create the writer that will be used to print the tabulated results:
@@ -176,10 +176,10 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 		writer.Flush()
 		if !interactive.Enabled() {
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 		if !confirm.Prompt(true, "Would you like to detail a specific prefix") {
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 		args.prefix, err = interactive.GetOption(interactive.Input{
 			Question: "Operator Role Prefix",
@@ -189,8 +189,7 @@ func run(cmd *cobra.Command, _ []string) {
 			Required: true,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid OIDC Config ID: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid OIDC Config ID")))
 		}
 	}
 	if args.prefix != "" {
@@ -201,13 +200,13 @@ func run(cmd *cobra.Command, _ []string) {
 					fmt.Sprintf("%s in version '%s'", noOperatorRolesPrefixOutput, args.version)
 			}
 			r.Reporter.Infof(noOperatorRolesPrefixOutput)
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 		hasClusterUsingOperatorRolesPrefix, err := r.OCMClient.HasAClusterUsingOperatorRolesPrefix(args.prefix)
 		if err != nil {
 			r.Reporter.Errorf("There was a problem checking if any clusters"+
 				" are using Operator Roles Prefix '%s' : %v", args.prefix, err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		fmt.Fprintf(writer, "OPERATOR NAME\tOPERATOR NAMESPACE\tROLE NAME\t"+
@@ -24,6 +24,8 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -84,8 +86,7 @@ func run(cmd *cobra.Command, _ []string) {
 	r.Reporter.Debugf("Fetching regions")
 	regions, err := r.OCMClient.GetRegions(args.roleARN, args.externalID)
 	if err != nil {
-		r.Reporter.Errorf("Failed to fetch regions: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to fetch regions")))
 	}
 
 	// Filter out unwanted regions
@@ -111,14 +112,14 @@ func run(cmd *cobra.Command, _ []string) {
 		err = output.Print(availableRegions)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	if len(availableRegions) == 0 {
 		r.Reporter.Warnf("There are no regions available for this AWS account")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Create the writer that will be used to print the tabulated results:
@@ -9,8 +9,11 @@ import (
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/config"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -44,6 +47,7 @@ ocm list rh-regions`,
 			"file or "+sdk.DefaultURL+" as a last resort. The value should be a complete URL "+
 			"or a valid URL alias: "+strings.Join(ocm.ValidOCMUrlAliases(), ", "),
 	)
+	output.AddFlag(Cmd)
 	return Cmd
 }
 
@@ -52,8 +56,7 @@ func run(cmd *cobra.Command, argv []string) {
 
 	err := ListRhRegions(args.discoveryURL, r)
 	if err != nil {
-		r.Reporter.Errorf("Failed to determine gateway URL: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to determine gateway URL")))
 	}
 }
 
@@ -68,11 +71,15 @@ func ListRhRegions(discoveryURL string, r *rosa.Runtime) error {
 		return fmt.Errorf("Failed to determine gateway URL: %v", err)
 	}
 
-	fmt.Fprintf(writer, "Discovery URL: %s\n\n", gatewayURL)
 	regions, err := sdk.GetRhRegions(gatewayURL)
 	if err != nil {
 		return fmt.Errorf("Failed to get OCM regions: %v", err)
 	}
+	if output.HasFlag() {
+		return output.Print(regions)
+	}
+
+	fmt.Fprintf(writer, "Discovery URL: %s\n\n", gatewayURL)
 
 	// If there are no regions, print a warning message and return early
 	if len(regions) == 0 {
@@ -24,6 +24,8 @@ import (
 	msv1 "github.com/openshift-online/ocm-sdk-go/servicemgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -59,8 +61,7 @@ func run(cmd *cobra.Command, argv []string) {
 
 	servicesList, err := r.OCMClient.ListManagedServices(1000)
 	if err != nil {
-		r.Reporter.Errorf("Failed to retrieve list of managed services: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to retrieve list of managed services")))
 	}
 
 	if output.HasFlag() {
@@ -72,9 +73,9 @@ func run(cmd *cobra.Command, argv []string) {
 		output.Print(outList)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
@@ -23,6 +23,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/input"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -58,22 +60,22 @@ func run(_ *cobra.Command, _ []string) {
 	r.Reporter.Debugf("Loading tuning configs for cluster '%s'", clusterKey)
 	tuningConfigs, err := r.OCMClient.GetTuningConfigs(cluster.ID())
 	if err != nil {
-		r.Reporter.Errorf("Failed to get tuning configs for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to get tuning configs for cluster '%s'", cluster.ID())))
 	}
 
 	if output.HasFlag() {
 		err = output.Print(tuningConfigs)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	if len(tuningConfigs) == 0 {
 		r.Reporter.Infof("There are no tuning configs for this cluster.")
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Create the writer that will be used to print the tabulated results:
@@ -26,6 +26,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/cmd/list/upgrade"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -76,8 +78,7 @@ func run(_ *cobra.Command, _ []string) {
 	r.Reporter.Debugf("Fetching versions")
 	versions, err := r.OCMClient.GetVersionsWithProduct(product, args.channelGroup, false)
 	if err != nil {
-		r.Reporter.Errorf("Failed to fetch versions: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to fetch versions")))
 	}
 
 	var availableVersions []*cmv1.Version
@@ -94,14 +95,14 @@ func run(_ *cobra.Command, _ []string) {
 		err = output.Print(availableVersions)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	if len(availableVersions) == 0 {
 		r.Reporter.Warnf("There are no OpenShift versions available")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Create the writer that will be used to print the tabulated results:
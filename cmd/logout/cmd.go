@@ -17,20 +17,21 @@ limitations under the License.
 package logout
 
 import (
-	"os"
-
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/config"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	rprtr "github.com/openshift/rosa/pkg/reporter"
 )
 
 var Cmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Log out",
-	Long:  "Log out, removing the configuration file.",
-	Run:   run,
-	Args:  cobra.NoArgs,
+	Long: "Log out, removing the configuration file. When other configuration contexts are stored " +
+		"only the settings of the current context are removed.",
+	Run:  run,
+	Args: cobra.NoArgs,
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -38,7 +39,6 @@ func run(_ *cobra.Command, _ []string) {
 	// Remove the configuration file:
 	err := config.Remove()
 	if err != nil {
-		reporter.Errorf("Failed to remove config file: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err, "Failed to remove config file")))
 	}
 }
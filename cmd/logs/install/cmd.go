@@ -18,7 +18,6 @@ package install
 
 import (
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -27,6 +26,8 @@ import (
 	"github.com/spf13/cobra"
 	errors "github.com/zgalor/weberr"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -90,7 +91,7 @@ func run(cmd *cobra.Command, argv []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() == cmv1.ClusterStateReady {
 		r.Reporter.Infof("Cluster '%s' has been successfully installed", clusterKey)
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	pendingMessage := fmt.Sprintf(
@@ -103,17 +104,17 @@ func run(cmd *cobra.Command, argv []string) {
 				"Cluster '%s' has been in %s state for too long. Please contact support",
 				clusterKey, cluster.State(),
 			)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		r.Reporter.Warnf(pendingMessage)
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	if cluster.State() == cmv1.ClusterStateUninstalling {
 		r.Reporter.Errorf("Cluster '%s' is in '%s' state and no installation logs are available",
 			clusterKey, cluster.State(),
 		)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Get logs from Hive
@@ -123,7 +124,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Infof(pendingMessage)
 		} else {
 			r.Reporter.Errorf("Failed to get logs for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 	printLog(logs, nil)
@@ -131,7 +132,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if watch {
 		if cluster.State() == cmv1.ClusterStateReady {
 			r.Reporter.Infof("Cluster '%s' is successfully installed", clusterKey)
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 
 		var spin *spinner.Spinner
@@ -147,26 +148,33 @@ func run(cmd *cobra.Command, argv []string) {
 			state, _ := r.OCMClient.GetClusterState(cluster.ID())
 			if state == cmv1.ClusterStateError {
 				r.Reporter.Errorf("There was an error installing cluster '%s'", clusterKey)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 			if state == cmv1.ClusterStateReady {
 				r.Reporter.Infof("Cluster '%s' is now ready", clusterKey)
-				os.Exit(0)
+				cmdcontext.Exit(0)
 			}
 			printLog(logResponse.Body(), spin)
 
 			err = r.OCMClient.KeepTokensAlive()
 			if err != nil {
 				r.Reporter.Errorf(fmt.Sprintf("Failed to keep tokens alive for polling: %v", err))
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 
 			return false
 		})
+		if cause := cmdcontext.Err(); cause != nil {
+			if spin != nil {
+				spin.Stop()
+			}
+			r.Reporter.Infof("Stopped watching the logs of cluster '%s': %v", clusterKey, cause)
+			cmdcontext.Exit(rosaerrors.ExitCode(cause))
+		}
 		if err != nil {
 			if errors.GetType(err) != errors.NotFound {
 				r.Reporter.Errorf(fmt.Sprintf("Failed to watch logs for cluster '%s': %v", clusterKey, err))
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 		printLog(response, spin)
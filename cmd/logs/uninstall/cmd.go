@@ -18,7 +18,6 @@ package uninstall
 
 import (
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -27,6 +26,8 @@ import (
 	"github.com/spf13/cobra"
 	errors "github.com/zgalor/weberr"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -90,7 +91,7 @@ func run(cmd *cobra.Command, argv []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateUninstalling && !watch {
 		r.Reporter.Warnf("Cluster '%s' is not currently uninstalling", clusterKey)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if cluster.State() == cmv1.ClusterStateInstalling ||
@@ -99,7 +100,7 @@ func run(cmd *cobra.Command, argv []string) {
 		r.Reporter.Errorf("Cluster '%s' is in '%s' state and no uninstallation logs are available",
 			clusterKey, cluster.State(),
 		)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Get logs from Hive
@@ -109,7 +110,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Warnf("Logs for cluster '%s' are not available", clusterKey)
 		} else {
 			r.Reporter.Errorf("Failed to get logs for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	}
 	printLog(logs, nil)
@@ -128,22 +129,29 @@ func run(cmd *cobra.Command, argv []string) {
 			state, err := r.OCMClient.GetClusterState(cluster.ID())
 			if err != nil || state == cmv1.ClusterState("") {
 				r.Reporter.Infof("Cluster '%s' completed uninstallation", clusterKey)
-				os.Exit(0)
+				cmdcontext.Exit(0)
 			}
 			printLog(logResponse.Body(), spin)
 
 			err = r.OCMClient.KeepTokensAlive()
 			if err != nil {
 				r.Reporter.Errorf(fmt.Sprintf("Failed to keep tokens alive for polling: %v", err))
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 
 			return false
 		})
+		if cause := cmdcontext.Err(); cause != nil {
+			if spin != nil {
+				spin.Stop()
+			}
+			r.Reporter.Infof("Stopped watching the logs of cluster '%s': %v", clusterKey, cause)
+			cmdcontext.Exit(rosaerrors.ExitCode(cause))
+		}
 		if err != nil {
 			if errors.GetType(err) != errors.NotFound {
 				r.Reporter.Errorf(fmt.Sprintf("Failed to watch logs for cluster '%s': %v", clusterKey, err))
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 		printLog(response, spin)
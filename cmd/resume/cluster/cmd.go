@@ -17,11 +17,11 @@ limitations under the License.
 package cluster
 
 import (
-	"os"
-
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -53,15 +53,14 @@ func run(_ *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Resuming a cluster from hibernation is only supported for clusters in "+
 			"'Hibernating' state. Cluster '%s' is in '%s' state",
 			clusterKey, cluster.State())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if !confirm.Yes() && !confirm.Confirm("resume cluster %s", clusterKey) {
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	err := r.OCMClient.ResumeCluster(cluster.ID())
 	if err != nil {
-		r.Reporter.Errorf("Failed to update cluster: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to update cluster")))
 	}
 	r.Reporter.Infof("Cluster '%s' is resuming.", clusterKey)
 }
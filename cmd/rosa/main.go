@@ -23,16 +23,21 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/cmd/apply"
 	"github.com/openshift/rosa/cmd/attach"
+	cachecmd "github.com/openshift/rosa/cmd/cache"
 	"github.com/openshift/rosa/cmd/completion"
 	"github.com/openshift/rosa/cmd/config"
 	"github.com/openshift/rosa/cmd/create"
 	"github.com/openshift/rosa/cmd/describe"
 	"github.com/openshift/rosa/cmd/detach"
+	"github.com/openshift/rosa/cmd/dev"
+	"github.com/openshift/rosa/cmd/diff"
 	"github.com/openshift/rosa/cmd/dlt"
 	"github.com/openshift/rosa/cmd/docs"
 	"github.com/openshift/rosa/cmd/download"
 	"github.com/openshift/rosa/cmd/edit"
+	"github.com/openshift/rosa/cmd/export"
 	"github.com/openshift/rosa/cmd/grant"
 	"github.com/openshift/rosa/cmd/hibernate"
 	"github.com/openshift/rosa/cmd/initialize"
@@ -45,27 +50,62 @@ import (
 	"github.com/openshift/rosa/cmd/register"
 	"github.com/openshift/rosa/cmd/resume"
 	"github.com/openshift/rosa/cmd/revoke"
+	"github.com/openshift/rosa/cmd/supportbundle"
 	"github.com/openshift/rosa/cmd/token"
 	"github.com/openshift/rosa/cmd/uninstall"
 	"github.com/openshift/rosa/cmd/unlink"
 	"github.com/openshift/rosa/cmd/upgrade"
 	"github.com/openshift/rosa/cmd/verify"
 	"github.com/openshift/rosa/cmd/version"
+	"github.com/openshift/rosa/cmd/wait"
 	"github.com/openshift/rosa/cmd/whoami"
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/cassette"
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/color"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/info"
+	"github.com/openshift/rosa/pkg/logformat"
+	"github.com/openshift/rosa/pkg/offline"
+	"github.com/openshift/rosa/pkg/redact"
 	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/timings"
+	"github.com/openshift/rosa/pkg/tracing"
 	versionUtils "github.com/openshift/rosa/pkg/version"
 )
 
+// exitCodes describes the exit codes of the commands, see the errors package for the categories.
+const exitCodes = `Exit codes:
+  0    Success
+  1    General error
+  2    Differences found by 'rosa diff cluster' or 'rosa verify roles'
+  3    Invalid usage, like a missing or invalid flag
+  4    Resource not found
+  5    Conflict with an existing resource
+  6    Not logged in to OCM, or the OCM token expired
+  7    Operation not allowed for the user
+  8    Quota exceeded in OCM or AWS
+  9    Missing or invalid AWS credentials
+  10   Other error returned by AWS
+  11   OCM is unavailable or returned a server error
+  12   Timed out waiting, or the time given with '--timeout' expired
+  130  Interrupted with Ctrl-C or SIGTERM
+
+Errors that don't fit any of the other categories exit with code 1, as do the invalid flag values
+detected by some of the older commands. When '--output json' is used the error is also written to the
+standard error stream as a JSON document with the category, the exit code and the OCM or AWS error
+code.
+`
+
 var root = &cobra.Command{
 	Use:   "rosa",
 	Short: "Command line tool for ROSA.",
 	Long: "Command line tool for Red Hat OpenShift Service on AWS.\n" +
 		"For further documentation visit " +
-		"https://access.redhat.com/documentation/en-us/red_hat_openshift_service_on_aws\n",
-	PersistentPreRun: versionCheck,
+		"https://access.redhat.com/documentation/en-us/red_hat_openshift_service_on_aws\n" +
+		"\n" + exitCodes,
+	PersistentPreRun: preRun,
 	Args:             cobra.NoArgs,
 }
 
@@ -73,16 +113,27 @@ func init() {
 	// Add the command line flags:
 	fs := root.PersistentFlags()
 	color.AddFlag(root)
+	logformat.AddFlag(root)
 	arguments.AddDebugFlag(fs)
+	arguments.AddContextFlag(fs)
+	arguments.AddTimeoutFlag(fs)
+	cassette.AddFlags(fs)
+	tracing.AddFlag(fs)
+	timings.AddFlag(fs)
+	cache.AddFlag(fs)
+	offline.AddFlag(fs)
 
 	// Register the subcommands:
 	root.AddCommand(completion.Cmd)
 	root.AddCommand(create.Cmd)
 	root.AddCommand(describe.Cmd)
 	root.AddCommand(dlt.Cmd)
+	root.AddCommand(dev.Cmd)
+	root.AddCommand(diff.Cmd)
 	root.AddCommand(docs.Cmd)
 	root.AddCommand(download.Cmd)
 	root.AddCommand(edit.Cmd)
+	root.AddCommand(export.Cmd)
 	root.AddCommand(grant.Cmd)
 	root.AddCommand(list.Cmd)
 	root.AddCommand(initialize.Cmd)
@@ -96,6 +147,7 @@ func init() {
 	root.AddCommand(upgrade.Cmd)
 	root.AddCommand(verify.Cmd)
 	root.AddCommand(version.NewRosaVersionCommand())
+	root.AddCommand(wait.Cmd)
 	root.AddCommand(whoami.Cmd)
 	root.AddCommand(hibernate.GenerateCommand())
 	root.AddCommand(resume.GenerateCommand())
@@ -105,6 +157,9 @@ func init() {
 	root.AddCommand(config.Cmd)
 	root.AddCommand(attach.NewRosaAttachCommand())
 	root.AddCommand(detach.NewRosaDetachCommand())
+	root.AddCommand(apply.NewApplyCommand())
+	root.AddCommand(supportbundle.NewSupportBundleCommand())
+	root.AddCommand(cachecmd.Cmd)
 }
 
 func main() {
@@ -115,12 +170,46 @@ func main() {
 		if !strings.Contains(err.Error(), "Did you mean this?") {
 			fmt.Fprintf(os.Stderr, "Failed to execute root command: %s\n", err)
 		}
-		os.Exit(1)
+		cmdcontext.Exit(1)
+	}
+	cmdcontext.Finish(0)
+}
+
+func preRun(cmd *cobra.Command, args []string) {
+	if err := logformat.Validate(); err != nil {
+		reporter.CreateReporter().Errorf("%v", err)
+		cmdcontext.Exit(rosaerrors.CategoryUsage.ExitCode())
+	}
+	if err := cassette.Validate(); err != nil {
+		reporter.CreateReporter().Errorf("%v", err)
+		cmdcontext.Exit(rosaerrors.CategoryUsage.ExitCode())
+	}
+	if err := offline.Validate(cmd); err != nil {
+		reporter.CreateReporter().Errorf("%v", err)
+		cmdcontext.Exit(rosaerrors.CategoryUsage.ExitCode())
+	}
+	if err := redact.Load(); err != nil {
+		reporter.CreateReporter().Errorf("%v", err)
+		cmdcontext.Exit(rosaerrors.CategoryUsage.ExitCode())
+	}
+	if err := tracing.StartCommand(cmd.CommandPath()); err != nil {
+		reporter.CreateReporter().Errorf("%v", err)
+		cmdcontext.Exit(rosaerrors.CategoryUsage.ExitCode())
+	}
+	if !cassette.Replaying() {
+		// The AWS client can be created before the OCM client, so the AWS profile and region of
+		// the configuration context are applied before either of them:
+		arguments.ApplyContextDefaults()
 	}
+	cmdcontext.OnExit(tracing.FinishCommand)
+	timings.Start()
+	cmdcontext.OnExit(func(int) { timings.Finish() })
+	logformat.SetCommand(cmd.CommandPath())
+	versionCheck(cmd, args)
 }
 
 func versionCheck(cmd *cobra.Command, _ []string) {
-	if !versionUtils.ShouldRunCheck(cmd) {
+	if offline.Enabled() || !versionUtils.ShouldRunCheck(cmd) {
 		return
 	}
 
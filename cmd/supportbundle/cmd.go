@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportbundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/supportbundle"
+)
+
+const (
+	use   = "support-bundle"
+	short = "Collect the diagnostics of a cluster into one archive"
+	long  = "Collect the diagnostics of a cluster into a compressed tar archive that can be attached " +
+		"to a support case: the cluster description, the install and uninstall logs, the inflight " +
+		"checks, the limited support reasons, the results of the subnet verifications and the state " +
+		"of the account roles, operator roles and OIDC provider in AWS. Tokens, passwords and other " +
+		"credentials are redacted, as well as the fields, headers and patterns given in the " +
+		"'rosa/redaction.yaml' file of the user configuration directory or in the file given in the " +
+		"'ROSA_REDACTION_RULES' environment variable. Diagnostics that can't be collected are recorded " +
+		"in the manifest of the archive instead of failing the command."
+	example = `  # Collect the diagnostics of the cluster named "mycluster"
+  rosa support-bundle --cluster=mycluster
+
+  # Write the archive to a specific file
+  rosa support-bundle --cluster=mycluster --file=/tmp/mycluster.tar.gz`
+)
+
+var args struct {
+	file string
+}
+
+func NewSupportBundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), SupportBundleRunner()),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddClusterFlag(cmd)
+	arguments.AddProfileFlag(flags)
+	arguments.AddRegionFlag(flags)
+	flags.StringVar(
+		&args.file,
+		"file",
+		"",
+		"File where the archive is written. The default is a file in the current directory "+
+			"named after the cluster and the time.",
+	)
+	return cmd
+}
+
+func SupportBundleRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		cluster := r.FetchCluster()
+
+		// The diagnostics stored in OCM are useful even when AWS can't be reached, so failing to
+		// create the AWS client is recorded in the bundle instead of failing the command.
+		awsClient, awsErr := newAWSClient(r)
+		collector, err := supportbundle.NewCollector().
+			Logger(r.Logger).
+			OCMClient(r.OCMClient).
+			AWSClient(awsClient).
+			AWSError(awsErr).
+			Cluster(cluster).
+			Build()
+		if err != nil {
+			return err
+		}
+
+		file := args.file
+		if file == "" {
+			file = fmt.Sprintf("rosa-support-bundle-%s-%s.tar.gz", cluster.ID(),
+				time.Now().UTC().Format("20060102150405"))
+		}
+		output, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			return fmt.Errorf("Failed to create support bundle file: %v", err)
+		}
+		defer output.Close()
+
+		r.Reporter.Infof("Collecting diagnostics of cluster '%s'", r.ClusterKey)
+		manifest, err := collector.Write(output)
+		if err != nil {
+			return err
+		}
+		err = output.Close()
+		if err != nil {
+			return fmt.Errorf("Failed to write support bundle file: %v", err)
+		}
+
+		for _, collection := range manifest.Failed() {
+			r.Reporter.Warnf("Failed to collect %s: %s", collection.Name, collection.Reason)
+		}
+		r.Reporter.Infof("Wrote support bundle to '%s'", file)
+		return nil
+	}
+}
+
+// newAWSClient returns the AWS client of the runtime, creating it if needed, or the reason why it
+// can't be created.
+func newAWSClient(r *rosa.Runtime) (aws.Client, error) {
+	if r.AWSClient != nil {
+		return r.AWSClient, nil
+	}
+	err := r.OCMClient.ValidateAwsClientRegion()
+	if err != nil {
+		return nil, err
+	}
+	client, err := aws.NewClient().
+		Logger(r.Logger).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create AWS client: %v", err)
+	}
+	r.AWSClient = client
+	return client, nil
+}
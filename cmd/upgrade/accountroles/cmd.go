@@ -18,7 +18,6 @@ package accountroles
 
 import (
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -31,6 +30,8 @@ import (
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	awscbRoles "github.com/openshift/rosa/pkg/aws/commandbuilder/helper/roles"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper/roles"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
@@ -108,7 +109,7 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	prefix := args.prefix
 
@@ -117,14 +118,12 @@ func run(cmd *cobra.Command, _ []string) {
 	channelGroup := args.channelGroup
 	policyVersion, err := ocmClient.GetPolicyVersion(version, channelGroup)
 	if err != nil {
-		reporter.Errorf("Error getting version: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err, "Error getting version")))
 	}
 
 	env, err := ocm.GetEnv()
 	if err != nil {
-		reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err, "Failed to determine OCM environment")))
 	}
 
 	var role aws.AccountRole
@@ -143,32 +142,31 @@ func run(cmd *cobra.Command, _ []string) {
 			r.Reporter.Errorf("Failed to get classic account roles ARN: %v. "+
 				"To upgrade hosted CP account roles use the '--hosted-cp' flag", err)
 		}
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	managedPolicies, err := awsClient.HasManagedPolicies(roleARN)
 	if err != nil {
-		r.Reporter.Errorf("Failed to determine if the role has managed policies: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"Failed to determine if the role has managed policies")))
 	}
 
 	if managedPolicies {
 		hostedCPPolicies, err := awsClient.HasHostedCPPolicies(roleARN)
 		if err != nil {
-			r.Reporter.Errorf("Failed to determine if the role has hosted CP managed policies: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+				"Failed to determine if the role has hosted CP managed policies")))
 		}
 
 		if hostedCPPolicies && !args.hostedCP {
 			r.Reporter.Errorf("Role with ARN '%s' has hosted CP managed policies, "+
 				"please run the command with the flag '--hosted-cp'", roleARN)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		err = roles.ValidateAccountRolesManagedPolicies(r, prefix, hostedCPPolicies)
 		if err != nil {
-			r.Reporter.Errorf("Failed while validating managed policies: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed while validating managed policies")))
 		}
 
 		r.Reporter.Infof("Account roles with the prefix '%s' have attached managed policies. "+
@@ -178,8 +176,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	creator, err := awsClient.GetCreator()
 	if err != nil {
-		reporter.Errorf("Failed to get IAM credentials: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err, "Failed to get IAM credentials")))
 	}
 
 	var spin *spinner.Spinner
@@ -195,7 +192,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if err != nil {
 		reporter.Errorf("%s", err)
 		LogError(roles.RosaUpgradeAccRolesModeAuto, ocmClient, policyVersion, err, reporter)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	if spin != nil {
@@ -204,13 +201,13 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if !isUpgradeNeedForAccountRolePolicies {
 		reporter.Infof("Account roles with the prefix '%s' are already up-to-date.", prefix)
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	policyPath, err := getAccountPolicyPath(awsClient, prefix)
 	if err != nil {
-		reporter.Errorf("Error trying to determine the path for the account policies. Error: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err,
+			"Error trying to determine the path for the account policies. Error")))
 	}
 
 	// Determine if interactive mode is needed
@@ -221,15 +218,13 @@ func run(cmd *cobra.Command, _ []string) {
 	if interactive.Enabled() && !skipInteractive {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Account role upgrade mode")
 		if err != nil {
-			reporter.Errorf("Expected a valid Account role upgrade mode: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err, "Expected a valid Account role upgrade mode")))
 		}
 		interactive.SetModeKey(mode)
 	}
 	policies, err := ocmClient.GetPolicies("")
 	if err != nil {
-		reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err, "Expected a valid role creation mode")))
 	}
 
 	switch mode {
@@ -241,15 +236,14 @@ func run(cmd *cobra.Command, _ []string) {
 			if err != nil {
 				LogError(roles.RosaUpgradeAccRolesModeAuto, ocmClient, policyVersion, err, reporter)
 				reporter.Errorf("Error upgrading the role polices: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(1)
 			}
 		}
 	case interactive.ModeManual:
 		if isUpgradeNeedForAccountRolePolicies {
 			err = aws.GenerateAccountRolePolicyFiles(reporter, env, policies, false, aws.AccountRoles, creator.Partition)
 			if err != nil {
-				reporter.Errorf("There was an error generating the policy files: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err, "There was an error generating the policy files")))
 			}
 		}
 		if reporter.IsTerminal() {
@@ -263,7 +257,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	default:
 		reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 }
 
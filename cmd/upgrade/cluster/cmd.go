@@ -18,7 +18,6 @@ package cluster
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
@@ -28,6 +27,8 @@ import (
 
 	"github.com/openshift/rosa/cmd/upgrade/roles"
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -142,7 +143,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 }
 
@@ -244,8 +245,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 	if isSTS && mode == "" {
 		mode, err = interactive.GetOptionMode(cmd, mode, "IAM Roles/Policies upgrade mode")
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid role upgrade mode: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid role upgrade mode")))
 		}
 	}
 
@@ -337,12 +337,12 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		}
 
 		if r.Reporter.IsTerminal() && !confirm.Confirm("upgrade cluster to version '%s'", version) {
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 	} else {
 		if r.Reporter.IsTerminal() && !confirm.Confirm("schedule automatic cluster upgrades at '%s'",
 			currentUpgradeScheduling.Schedule) {
-			os.Exit(0)
+			cmdcontext.Exit(0)
 		}
 	}
 
@@ -520,8 +520,7 @@ func buildNodeDrainGracePeriod(r *rosa.Runtime, cmd *cobra.Command, cluster *cmv
 			Required: true,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid node drain grace period: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid node drain grace period")))
 		}
 	}
 	isValidNodeDrainGracePeriod := false
@@ -534,13 +533,12 @@ func buildNodeDrainGracePeriod(r *rosa.Runtime, cmd *cobra.Command, cluster *cmv
 	if !isValidNodeDrainGracePeriod {
 		r.Reporter.Errorf("Expected a valid node drain grace period. Options are [%s]",
 			strings.Join(nodeDrainOptions, ", "))
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	nodeDrainParsed := strings.Split(nodeDrainGracePeriod, " ")
 	nodeDrainValue, err := strconv.ParseFloat(nodeDrainParsed[0], commonUtils.MaxByteSize)
 	if err != nil {
-		r.Reporter.Errorf("Expected a valid node drain grace period: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid node drain grace period")))
 	}
 	if nodeDrainParsed[1] == "hours" || nodeDrainParsed[1] == "hour" {
 		nodeDrainValue = nodeDrainValue * 60
@@ -599,7 +597,7 @@ func checkGates(r *rosa.Runtime, cluster *cmv1.Cluster, gates []*cmv1.VersionGat
 			}
 			// for non sts gates we require user agreement
 			if !confirm.Prompt(true, "I acknowledge") {
-				os.Exit(0)
+				cmdcontext.Exit(0)
 			} else {
 				r.Reporter.Infof("Gate %s acknowledged", gate.ID())
 			}
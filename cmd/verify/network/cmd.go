@@ -17,18 +17,21 @@ limitations under the License.
 package network
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
+	"github.com/kballard/go-shellquote"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -141,8 +144,7 @@ func run(cmd *cobra.Command, _ []string) {
 	defer r.Cleanup()
 	err := runWithRuntime(r, cmd)
 	if err != nil {
-		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, err))
 	}
 }
 
@@ -261,7 +263,15 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 			}
 
 			if len(args.subnetIDs) > 0 {
-				time.Sleep(delay)
+				select {
+				case <-time.After(delay):
+				case <-r.Context.Done():
+					if spin != nil {
+						spin.Stop()
+					}
+					return rosaerrors.Wrapf(context.Cause(r.Context), "Stopped watching the verification of subnets %s",
+						helper.SliceToSortedString(args.subnetIDs))
+				}
 			}
 		}
 
@@ -284,7 +294,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 				"rosa verify network --watch --status-only --region %s --subnet-ids %s",
 				args.region, strings.Join(args.subnetIDs, ","))
 			if output.HasFlag() {
-				watchCommandOutput += fmt.Sprintf(" --output %s", output.Output())
+				watchCommandOutput += fmt.Sprintf(" --output %s", shellquote.Join(output.Output()))
 			}
 			r.Reporter.Infof(watchCommandOutput)
 		}
@@ -0,0 +1,500 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roles
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	awserr "github.com/openshift-online/ocm-common/pkg/aws/errors"
+	common "github.com/openshift-online/ocm-common/pkg/aws/validations"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "roles"
+	short = "Verify that account and operator roles match the policies from OCM"
+	long  = "Compare, statement by statement, the permission and trust policies of the account roles " +
+		"with the policies that OCM expects them to have, and report the missing, extra and modified " +
+		"permissions. When a cluster is given its account and operator roles are verified, otherwise " +
+		"the account roles with the given prefix are verified. Inline policies and policies that weren't " +
+		"created by ROSA count as extra permissions. The command exits with code 0 when the " +
+		"roles match the policies, with code 2 when they have drifted and with the code of the error, " +
+		"see 'rosa --help', when the comparison fails."
+	example = `  # Verify the classic account roles with the default prefix
+  rosa verify roles
+
+  # Verify the hosted control plane account roles with the prefix "myprefix"
+  rosa verify roles --prefix=myprefix --hosted-cp
+
+  # Verify the account and operator roles of a cluster named "mycluster"
+  rosa verify roles --cluster=mycluster
+
+  # Print the differences as JSON
+  rosa verify roles --cluster=mycluster --output=json`
+
+	// DriftExitCode is the exit code of the command when the roles don't match the policies
+	DriftExitCode = rosaerrors.DriftExitCode
+
+	trustPolicyKey         = "sts_%s_trust_policy"
+	operatorTrustPolicyKey = "operator_iam_role_policy"
+)
+
+var args struct {
+	prefix   string
+	hostedCP bool
+}
+
+// roleDrift contains the differences between the policies of a role and the expected ones.
+type roleDrift struct {
+	Role        string            `json:"role"`
+	Missing     bool              `json:"missing,omitempty"`
+	Trust       []aws.PolicyDrift `json:"trust,omitempty"`
+	Permissions []aws.PolicyDrift `json:"permissions,omitempty"`
+}
+
+type verifyResult struct {
+	Drift bool        `json:"drift"`
+	Roles []roleDrift `json:"roles"`
+}
+
+// roleCheck is a role to verify. The operator is nil for account roles.
+type roleCheck struct {
+	name     string
+	roleType string
+	operator *cmv1.STSOperator
+}
+
+func NewVerifyRolesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), VerifyRolesRunner()),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&args.prefix,
+		"prefix",
+		aws.DefaultPrefix,
+		"Prefix of the account roles to verify. Ignored when a cluster is given.",
+	)
+	flags.BoolVar(
+		&args.hostedCP,
+		"hosted-cp",
+		false,
+		"Verify the account roles for hosted control plane clusters. Ignored when a cluster is given.",
+	)
+	ocm.AddOptionalClusterFlag(cmd)
+	arguments.AddProfileFlag(flags)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func VerifyRolesRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, _ []string) error {
+		v := &verifier{
+			runtime:  r,
+			prefix:   args.prefix,
+			hostedCP: args.hostedCP,
+		}
+		if r.Cluster != nil || command != nil && command.Flags().Changed("cluster") {
+			v.cluster = r.FetchCluster()
+		}
+
+		drifts, err := v.verify()
+		if err != nil {
+			return err
+		}
+
+		if output.HasFlag() {
+			if drifts == nil {
+				drifts = []roleDrift{}
+			}
+			err = output.Print(verifyResult{
+				Drift: len(drifts) > 0,
+				Roles: drifts,
+			})
+			if err != nil {
+				return err
+			}
+		} else if len(drifts) == 0 {
+			r.Reporter.Infof("The roles match the policies from OCM")
+		} else {
+			printDrifts(drifts)
+		}
+
+		if len(drifts) > 0 {
+			return rosaerrors.NewDriftError("The policies of %d roles don't match the policies from OCM",
+				len(drifts))
+		}
+		return nil
+	}
+}
+
+func printDrifts(drifts []roleDrift) {
+	for _, drift := range drifts {
+		if drift.Missing {
+			fmt.Printf("Role '%s' doesn't exist\n", drift.Role)
+			continue
+		}
+		if len(drift.Trust) > 0 {
+			fmt.Printf("Role '%s' trust policy:\n", drift.Role)
+			for _, statement := range drift.Trust {
+				fmt.Printf("  %s\n", statement)
+			}
+		}
+		if len(drift.Permissions) > 0 {
+			fmt.Printf("Role '%s' permission policies:\n", drift.Role)
+			for _, statement := range drift.Permissions {
+				fmt.Printf("  %s\n", statement)
+			}
+		}
+	}
+}
+
+type verifier struct {
+	runtime  *rosa.Runtime
+	cluster  *cmv1.Cluster
+	prefix   string
+	hostedCP bool
+	env      string
+	policies map[string]*cmv1.AWSSTSPolicy
+}
+
+func (v *verifier) verify() ([]roleDrift, error) {
+	r := v.runtime
+	env, err := ocm.GetEnv()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to determine OCM environment: %v", err)
+	}
+	v.env = env
+
+	v.policies, err = r.OCMClient.GetPolicies("")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the expected policies from OCM: %v", err)
+	}
+
+	accountRoles, operatorRoles, err := v.roles()
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := []roleDrift{}
+	for _, checks := range [][]roleCheck{accountRoles, operatorRoles} {
+		roleDrifts, err := v.verifyRoles(checks)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, roleDrifts...)
+	}
+	return drifts, nil
+}
+
+// roles returns the account and operator roles to verify.
+func (v *verifier) roles() ([]roleCheck, []roleCheck, error) {
+	if v.cluster == nil {
+		accountRoles := aws.AccountRoles
+		if v.hostedCP {
+			accountRoles = aws.HCPAccountRoles
+		}
+		checks := []roleCheck{}
+		for roleType, role := range accountRoles {
+			checks = append(checks, roleCheck{
+				name:     common.GetRoleName(v.prefix, role.Name),
+				roleType: roleType,
+			})
+		}
+		sortChecks(checks)
+		return checks, nil, nil
+	}
+
+	cluster := v.cluster
+	if cluster.AWS().STS().RoleARN() == "" {
+		return nil, nil, fmt.Errorf("Cluster '%s' doesn't use STS, so it has no account or operator roles",
+			cluster.Name())
+	}
+	v.hostedCP = aws.IsHostedCP(cluster)
+	v.prefix, _ = aws.GetPrefixFromInstallerAccountRole(cluster)
+
+	roleARNs := map[string]string{
+		aws.InstallerAccountRole:    cluster.AWS().STS().RoleARN(),
+		aws.SupportAccountRole:      cluster.AWS().STS().SupportRoleARN(),
+		aws.ControlPlaneAccountRole: cluster.AWS().STS().InstanceIAMRoles().MasterRoleARN(),
+		aws.WorkerAccountRole:       cluster.AWS().STS().InstanceIAMRoles().WorkerRoleARN(),
+	}
+	accountRoles := []roleCheck{}
+	for roleType, roleARN := range roleARNs {
+		if roleARN == "" {
+			continue
+		}
+		name, err := aws.GetResourceIdFromARN(roleARN)
+		if err != nil {
+			return nil, nil, err
+		}
+		accountRoles = append(accountRoles, roleCheck{
+			name:     name,
+			roleType: roleType,
+		})
+	}
+	sortChecks(accountRoles)
+
+	credRequests, err := v.runtime.OCMClient.GetCredRequests(v.hostedCP)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to get the operator credential requests from OCM: %v", err)
+	}
+	operatorRoles := []roleCheck{}
+	for roleType, operator := range credRequests {
+		name, exists := aws.FindOperatorRoleNameBySTSOperator(cluster, operator)
+		if !exists {
+			continue
+		}
+		operatorRoles = append(operatorRoles, roleCheck{
+			name:     name,
+			roleType: roleType,
+			operator: operator,
+		})
+	}
+	sortChecks(operatorRoles)
+	return accountRoles, operatorRoles, nil
+}
+
+func sortChecks(checks []roleCheck) {
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].name < checks[j].name
+	})
+}
+
+// verifyRoles compares the policies of roles of the same kind with the expected ones.
+func (v *verifier) verifyRoles(checks []roleCheck) ([]roleDrift, error) {
+	r := v.runtime
+	drifts := []roleDrift{}
+	existing := []roleCheck{}
+	names := []string{}
+	roles := map[string]iamtypes.Role{}
+	for _, check := range checks {
+		r.Reporter.Debugf("Verifying role '%s'", check.name)
+		role, err := r.AWSClient.GetRoleByName(check.name)
+		if awserr.IsNoSuchEntityException(err) {
+			drifts = append(drifts, roleDrift{Role: check.name, Missing: true})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get role '%s': %v", check.name, err)
+		}
+		existing = append(existing, check)
+		names = append(names, check.name)
+		roles[check.name] = role
+	}
+	if len(existing) == 0 {
+		return drifts, nil
+	}
+
+	livePolicies, err := v.livePolicies(existing, names)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, check := range existing {
+		drift, err := v.verifyRole(check, roles[check.name], livePolicies[check.name])
+		if err != nil {
+			return nil, err
+		}
+		if len(drift.Trust) > 0 || len(drift.Permissions) > 0 {
+			drifts = append(drifts, drift)
+		}
+	}
+	return drifts, nil
+}
+
+// livePolicies returns the policies attached to the roles, and their inline policies, including the
+// ones that weren't created by ROSA.
+func (v *verifier) livePolicies(checks []roleCheck, names []string) (map[string][]aws.PolicyDetail, error) {
+	r := v.runtime
+	result := map[string][]aws.PolicyDetail{}
+	if checks[0].operator == nil {
+		policies, excludedPolicies, err := r.AWSClient.GetAccountRolePolicies(names, v.prefix)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get the policies of the account roles: %v", err)
+		}
+		for _, name := range names {
+			result[name] = append(policies[name], excludedPolicies[name]...)
+		}
+		return result, nil
+	}
+
+	policies, excludedPolicies, err := r.AWSClient.GetOperatorRolePolicies(names)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the policies of the operator roles: %v", err)
+	}
+	for _, name := range names {
+		for _, policyARN := range append(policies[name], excludedPolicies[name]...) {
+			result[name] = append(result[name], aws.PolicyDetail{
+				PolicyArn:  policyARN,
+				PolicyType: aws.Attached,
+			})
+		}
+		// The operator role policies are only the attached ones, so the inline ones are added
+		// from the complete list of policies of the role
+		allPolicies, err := r.AWSClient.GetAttachedPolicy(awssdk.String(name))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get the policies of role '%s': %v", name, err)
+		}
+		for _, policy := range allPolicies {
+			if policy.PolicyType == aws.Inline {
+				result[name] = append(result[name], policy)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (v *verifier) verifyRole(check roleCheck, role iamtypes.Role, policies []aws.PolicyDetail) (roleDrift, error) {
+	drift := roleDrift{Role: check.name}
+
+	liveTrust, err := url.QueryUnescape(awssdk.ToString(role.AssumeRolePolicyDocument))
+	if err != nil {
+		return drift, fmt.Errorf("Failed to decode the trust policy of role '%s': %v", check.name, err)
+	}
+	expectedTrust, err := v.expectedTrustPolicy(check)
+	if err != nil {
+		return drift, err
+	}
+	drift.Trust, err = aws.ComparePolicyDocuments([]string{expectedTrust}, []string{liveTrust})
+	if err != nil {
+		return drift, fmt.Errorf("Failed to compare the trust policy of role '%s': %v", check.name, err)
+	}
+
+	managed := common.IamResourceHasTag(role.Tags, common.ManagedPolicies, tags.True)
+	expectedPermissions, err := v.expectedPermissionPolicies(check, managed)
+	if err != nil {
+		return drift, err
+	}
+	livePermissions, err := v.livePermissionPolicies(check, policies)
+	if err != nil {
+		return drift, err
+	}
+	drift.Permissions, err = aws.ComparePolicyDocuments(expectedPermissions, livePermissions)
+	if err != nil {
+		return drift, fmt.Errorf("Failed to compare the permission policies of role '%s': %v", check.name, err)
+	}
+	return drift, nil
+}
+
+func (v *verifier) expectedTrustPolicy(check roleCheck) (string, error) {
+	r := v.runtime
+	if check.operator != nil {
+		return aws.GenerateOperatorRolePolicyDoc(r.Creator.Partition, v.cluster, r.Creator.AccountID,
+			check.operator, aws.GetPolicyDetails(v.policies, operatorTrustPolicyKey))
+	}
+	key := fmt.Sprintf(trustPolicyKey, check.roleType)
+	details := aws.GetPolicyDetails(v.policies, key)
+	if details == "" {
+		return "", fmt.Errorf("Failed to find policy '%s' in OCM", key)
+	}
+	return aws.InterpolatePolicyDocument(r.Creator.Partition, details, map[string]string{
+		"partition":      r.Creator.Partition,
+		"aws_account_id": aws.GetJumpAccount(v.env),
+	}), nil
+}
+
+// expectedPermissionPolicies returns the permission policy documents that the role should have. The
+// documents of managed policies are read from AWS, as OCM only knows their ARNs.
+func (v *verifier) expectedPermissionPolicies(check roleCheck, managed bool) ([]string, error) {
+	r := v.runtime
+	replacements := map[string]string{
+		"partition": r.Creator.Partition,
+	}
+	var keys []string
+	switch {
+	case check.operator != nil:
+		sharedVpcRoleARN := v.cluster.AWS().PrivateHostedZoneRoleARN()
+		keys = []string{aws.GetOperatorPolicyKey(check.roleType, v.hostedCP, sharedVpcRoleARN != "")}
+		if sharedVpcRoleARN != "" {
+			replacements["shared_vpc_role_arn"] = sharedVpcRoleARN
+		}
+	case v.hostedCP:
+		keys = []string{fmt.Sprintf("sts_hcp_%s_permission_policy", check.roleType)}
+	case managed:
+		keys = aws.GetAccountRolePolicyKeys(check.roleType)
+	default:
+		keys = []string{fmt.Sprintf("sts_%s_permission_policy", check.roleType)}
+	}
+
+	documents := []string{}
+	for _, key := range keys {
+		policy, ok := v.policies[key]
+		if !ok {
+			return nil, fmt.Errorf("Failed to find policy '%s' in OCM", key)
+		}
+		if (managed || v.hostedCP) && policy.ARN() != "" {
+			policyARN := aws.InterpolatePolicyDocument(r.Creator.Partition, policy.ARN(), nil)
+			document, err := r.AWSClient.GetDefaultPolicyDocument(policyARN)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to get managed policy '%s': %v", policyARN, err)
+			}
+			documents = append(documents, document)
+			continue
+		}
+		documents = append(documents,
+			aws.InterpolatePolicyDocument(r.Creator.Partition, policy.Details(), replacements))
+	}
+	return documents, nil
+}
+
+func (v *verifier) livePermissionPolicies(check roleCheck, policies []aws.PolicyDetail) ([]string, error) {
+	r := v.runtime
+	documents := []string{}
+	for _, policy := range policies {
+		if policy.PolicyType == aws.Inline {
+			output, err := r.AWSClient.IsRolePolicyExists(check.name, policy.PolicyName)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to get inline policy '%s' of role '%s': %v",
+					policy.PolicyName, check.name, err)
+			}
+			document, err := url.QueryUnescape(awssdk.ToString(output.PolicyDocument))
+			if err != nil {
+				return nil, fmt.Errorf("Failed to decode inline policy '%s' of role '%s': %v",
+					policy.PolicyName, check.name, err)
+			}
+			documents = append(documents, document)
+			continue
+		}
+		document, err := r.AWSClient.GetDefaultPolicyDocument(policy.PolicyArn)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get policy '%s' of role '%s': %v", policy.PolicyArn, check.name, err)
+		}
+		documents = append(documents, document)
+	}
+	return documents, nil
+}
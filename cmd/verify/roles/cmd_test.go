@@ -0,0 +1,281 @@
+package roles
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/mock/gomock"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/constants"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	trustPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Principal": {"AWS": ["arn:%{partition}:iam::%{aws_account_id}:role/RH-Managed-OpenShift-Installer"]}, ` +
+		`"Action": ["sts:AssumeRole"]}]}`
+	liveTrustPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Principal": {"AWS": "arn:aws:iam::765374464689:role/RH-Managed-OpenShift-Installer"}, ` +
+		`"Action": "sts:AssumeRole"}]}`
+	permissionPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Action": ["ec2:DescribeInstances", "ec2:DescribeSubnets"], "Resource": "*"}]}`
+	operatorTrustPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Principal": {"Federated": "%{oidc_provider_arn}"}, "Action": "sts:AssumeRoleWithWebIdentity", ` +
+		`"Condition": {"StringEquals": {"%{issuer_url}:sub": ["%{service_accounts}"]}}}]}`
+	operatorPermissionPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Action": ["elasticloadbalancing:DescribeLoadBalancers"], "Resource": "*"}]}`
+	inlinePolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", ` +
+		`"Resource": "*"}]}`
+)
+
+var _ = Describe("rosa verify roles", func() {
+	Context("Create Command", func() {
+		It("Returns Command", func() {
+			cmd := NewVerifyRolesCommand()
+			Expect(cmd).NotTo(BeNil())
+
+			Expect(cmd.Use).To(Equal(use))
+			Expect(cmd.Example).To(Equal(example))
+			Expect(cmd.Short).To(Equal(short))
+			Expect(cmd.Long).To(Equal(long))
+			Expect(cmd.Args).NotTo(BeNil())
+			Expect(cmd.Run).NotTo(BeNil())
+
+			Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("prefix")).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("hosted-cp")).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+		})
+	})
+
+	Context("Execute command", func() {
+		var t *TestingRuntime
+		var awsClient *aws.MockClient
+
+		roleTypes := []string{
+			aws.InstallerAccountRole,
+			aws.SupportAccountRole,
+			aws.ControlPlaneAccountRole,
+			aws.WorkerAccountRole,
+		}
+
+		policyARN := func(roleName string) string {
+			return "arn:aws:iam::123:policy/" + roleName + "-Policy"
+		}
+
+		role := func(trust string) iamtypes.Role {
+			return iamtypes.Role{
+				AssumeRolePolicyDocument: awssdk.String(url.QueryEscape(trust)),
+			}
+		}
+
+		BeforeEach(func() {
+			config := filepath.Join(GinkgoT().TempDir(), "ocm.json")
+			Expect(os.WriteFile(config, []byte(`{"url": "http://localhost:8000"}`), 0600)).To(Succeed())
+			GinkgoT().Setenv(constants.OcmConfig, config)
+
+			t = NewTestRuntime()
+			t.RosaRuntime.Creator.Partition = "aws"
+			awsClient = t.RosaRuntime.AWSClient.(*aws.MockClient)
+			output.SetOutput("")
+			args.prefix = aws.DefaultPrefix
+			args.hostedCP = false
+
+			policies := []*cmv1.AWSSTSPolicy{}
+			for _, roleType := range roleTypes {
+				trust, err := cmv1.NewAWSSTSPolicy().ID("sts_" + roleType + "_trust_policy").
+					Details(trustPolicy).Build()
+				Expect(err).NotTo(HaveOccurred())
+				permission, err := cmv1.NewAWSSTSPolicy().ID("sts_" + roleType + "_permission_policy").
+					Details(permissionPolicy).Build()
+				Expect(err).NotTo(HaveOccurred())
+				policies = append(policies, trust, permission)
+			}
+			operatorTrust, err := cmv1.NewAWSSTSPolicy().ID("operator_iam_role_policy").
+				Details(operatorTrustPolicy).Build()
+			Expect(err).NotTo(HaveOccurred())
+			operatorPermission, err := cmv1.NewAWSSTSPolicy().
+				ID("openshift_ingress_operator_cloud_credentials_policy").
+				Details(operatorPermissionPolicy).Build()
+			Expect(err).NotTo(HaveOccurred())
+			policies = append(policies, operatorTrust, operatorPermission)
+			t.ApiServer.RouteToHandler("GET", "/api/clusters_mgmt/v1/aws_inquiries/sts_policies",
+				RespondWithJSON(http.StatusOK, FormatSTSPolicyList(policies)))
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		// expectAccountRoles sets up the account roles with the default prefix so that they match the
+		// policies, except for the changes made by the given function to the permission policies.
+		expectAccountRoles := func(change func(roleName string) string) {
+			names := []string{}
+			policies := map[string][]aws.PolicyDetail{}
+			for _, roleName := range []string{
+				"ManagedOpenShift-ControlPlane-Role",
+				"ManagedOpenShift-Installer-Role",
+				"ManagedOpenShift-Support-Role",
+				"ManagedOpenShift-Worker-Role",
+			} {
+				names = append(names, roleName)
+				awsClient.EXPECT().GetRoleByName(roleName).Return(role(liveTrustPolicy), nil)
+				policies[roleName] = []aws.PolicyDetail{{
+					PolicyName: roleName + "-Policy",
+					PolicyArn:  policyARN(roleName),
+					PolicyType: aws.Attached,
+				}}
+				awsClient.EXPECT().GetDefaultPolicyDocument(policyARN(roleName)).Return(change(roleName), nil)
+			}
+			awsClient.EXPECT().GetAccountRolePolicies(names, aws.DefaultPrefix).
+				Return(policies, map[string][]aws.PolicyDetail{}, nil)
+		}
+
+		It("Reports that the roles match the policies", func() {
+			expectAccountRoles(func(string) string { return permissionPolicy })
+
+			t.StdOutReader.Record()
+			err := VerifyRolesRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(ContainSubstring("The roles match the policies from OCM"))
+		})
+
+		It("Prints the changed permissions and exits with the drift code", func() {
+			expectAccountRoles(func(roleName string) string {
+				if roleName == "ManagedOpenShift-Installer-Role" {
+					return `{"Statement": [{"Effect": "Allow", "Action": ["ec2:DescribeInstances", ` +
+						`"ec2:RunInstances"], "Resource": "*"}]}`
+				}
+				return permissionPolicy
+			})
+
+			t.StdOutReader.Record()
+			err := VerifyRolesRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(BeAssignableToTypeOf(&rosaerrors.DriftError{}))
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(Equal("Role 'ManagedOpenShift-Installer-Role' permission policies:\n" +
+				"  ~ modified statement 'Allow ec2:DescribeInstances, ec2:DescribeSubnets on *': " +
+				"added ec2:RunInstances; removed ec2:DescribeSubnets\n"))
+			Expect(rosaerrors.ExitCode(err)).To(Equal(DriftExitCode))
+		})
+
+		It("Reports the roles that don't exist", func() {
+			for _, roleName := range []string{
+				"ManagedOpenShift-ControlPlane-Role",
+				"ManagedOpenShift-Installer-Role",
+				"ManagedOpenShift-Support-Role",
+				"ManagedOpenShift-Worker-Role",
+			} {
+				awsClient.EXPECT().GetRoleByName(roleName).
+					Return(iamtypes.Role{}, &iamtypes.NoSuchEntityException{})
+			}
+
+			t.StdOutReader.Record()
+			err := VerifyRolesRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(BeAssignableToTypeOf(&rosaerrors.DriftError{}))
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(ContainSubstring("Role 'ManagedOpenShift-Installer-Role' doesn't exist\n"))
+			Expect(rosaerrors.ExitCode(err)).To(Equal(DriftExitCode))
+		})
+
+		It("Prints the differences as JSON", func() {
+			output.SetOutput("json")
+			expectAccountRoles(func(roleName string) string {
+				if roleName == "ManagedOpenShift-Worker-Role" {
+					return `{"Statement": [{"Effect": "Allow", "Action": "ec2:DescribeInstances", "Resource": "*"}]}`
+				}
+				return permissionPolicy
+			})
+
+			t.StdOutReader.Record()
+			err := VerifyRolesRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(BeAssignableToTypeOf(&rosaerrors.DriftError{}))
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(MatchJSON(`{"drift": true, "roles": [{"role": "ManagedOpenShift-Worker-Role", ` +
+				`"permissions": [{"type": "modified", ` +
+				`"expected": {"Effect": "Allow", "Action": ["ec2:DescribeInstances", "ec2:DescribeSubnets"], ` +
+				`"Resource": ["*"]}, ` +
+				`"live": {"Effect": "Allow", "Action": ["ec2:DescribeInstances"], "Resource": ["*"]}, ` +
+				`"removedActions": ["ec2:DescribeSubnets"]}]}]}`))
+			Expect(rosaerrors.ExitCode(err)).To(Equal(DriftExitCode))
+		})
+
+		It("Verifies the account and operator roles of a cluster", func() {
+			const (
+				installerRole = "prefix-Installer-Role"
+				operatorRole  = "prefix-openshift-ingress-operator-cloud-credentials"
+			)
+			operator := cmv1.NewSTSOperator().
+				Namespace("openshift-ingress-operator").
+				Name("cloud-credentials").
+				ServiceAccounts("ingress-operator")
+			credRequest, err := cmv1.NewSTSCredentialRequest().
+				Name("ingress_operator_cloud_credentials").
+				Operator(operator).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.RouteToHandler("GET", "/api/clusters_mgmt/v1/aws_inquiries/sts_credential_requests",
+				RespondWithJSON(http.StatusOK, FormatSTSCredentialRequestList(
+					[]*cmv1.STSCredentialRequest{credRequest})))
+
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.AWS(cmv1.NewAWS().STS(cmv1.NewSTS().
+					RoleARN("arn:aws:iam::123:role/" + installerRole).
+					OIDCEndpointURL("https://oidc.example.com/1234").
+					OperatorIAMRoles(cmv1.NewOperatorIAMRole().
+						Namespace("openshift-ingress-operator").
+						Name("cloud-credentials").
+						RoleARN("arn:aws:iam::123:role/" + operatorRole))))
+			})
+			t.SetCluster(cluster.Name(), cluster)
+
+			expectedOperatorTrust, err := aws.GenerateOperatorRolePolicyDoc("aws", cluster, "123",
+				credRequest.Operator(), operatorTrustPolicy)
+			Expect(err).NotTo(HaveOccurred())
+
+			awsClient.EXPECT().GetRoleByName(installerRole).Return(role(liveTrustPolicy), nil)
+			awsClient.EXPECT().GetAccountRolePolicies([]string{installerRole}, "prefix").Return(
+				map[string][]aws.PolicyDetail{installerRole: {{
+					PolicyArn:  policyARN(installerRole),
+					PolicyType: aws.Attached,
+				}}}, map[string][]aws.PolicyDetail{}, nil)
+			awsClient.EXPECT().GetDefaultPolicyDocument(policyARN(installerRole)).Return(permissionPolicy, nil)
+
+			awsClient.EXPECT().GetRoleByName(operatorRole).Return(role(expectedOperatorTrust), nil)
+			awsClient.EXPECT().GetOperatorRolePolicies([]string{operatorRole}).Return(
+				map[string][]string{operatorRole: {policyARN(operatorRole)}}, map[string][]string{}, nil)
+			awsClient.EXPECT().GetAttachedPolicy(gomock.Any()).Return([]aws.PolicyDetail{
+				{PolicyArn: policyARN(operatorRole), PolicyType: aws.Attached},
+				{PolicyName: "debug", PolicyType: aws.Inline},
+			}, nil)
+			awsClient.EXPECT().GetDefaultPolicyDocument(policyARN(operatorRole)).
+				Return(operatorPermissionPolicy, nil)
+			awsClient.EXPECT().IsRolePolicyExists(operatorRole, "debug").Return(&iam.GetRolePolicyOutput{
+				PolicyDocument: awssdk.String(url.QueryEscape(inlinePolicy)),
+			}, nil)
+
+			t.StdOutReader.Record()
+			err = VerifyRolesRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(BeAssignableToTypeOf(&rosaerrors.DriftError{}))
+			stdout, _ := t.StdOutReader.Read()
+			Expect(stdout).To(Equal("Role '" + operatorRole + "' permission policies:\n" +
+				"  - extra statement 'Allow s3:* on *'\n"))
+			Expect(rosaerrors.ExitCode(err)).To(Equal(DriftExitCode))
+		})
+	})
+})
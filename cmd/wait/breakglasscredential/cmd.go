@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package breakglasscredential
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+const (
+	use   = "break-glass-credential"
+	alias = "break-glass-credentials"
+	short = "Wait for a break glass credential to reach a condition"
+	long  = "Wait for a break glass credential of a cluster to be issued or revoked. Waiting fails " +
+		"when the credential fails or expires."
+	example = `  # Wait for a break glass credential of a cluster named "mycluster" to be issued
+  rosa wait break-glass-credential --cluster=mycluster --id=mycredential --for=issued`
+)
+
+var conditions = []string{
+	string(cmv1.BreakGlassCredentialStatusIssued),
+	string(cmv1.BreakGlassCredentialStatusRevoked),
+}
+
+var args struct {
+	id string
+}
+
+func NewWaitBreakGlassCredentialCommand() *cobra.Command {
+	options := &wait.Options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Aliases: []string{alias},
+		Example: example,
+		Args:    cobra.MaximumNArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), WaitBreakGlassCredentialRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&args.id,
+		"id",
+		"",
+		"ID of the break glass credential to wait for.",
+	)
+	ocm.AddClusterFlag(cmd)
+	wait.AddFlags(cmd, options, conditions)
+	return cmd
+}
+
+func WaitBreakGlassCredentialRunner(options *wait.Options) rosa.CommandRunner {
+	return func(ctx context.Context, runtime *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		err := options.Validate(conditions)
+		if err != nil {
+			return err
+		}
+		credentialID := args.id
+		// Allow the credential to be given as a positional parameter
+		if len(argv) == 1 && !cmd.Flag("id").Changed {
+			credentialID = argv[0]
+		}
+		if credentialID == "" {
+			return fmt.Errorf("You need to specify a break glass credential with the '--id' parameter")
+		}
+
+		clusterKey := runtime.GetClusterKey()
+		cluster, err := runtime.OCMClient.GetCluster(clusterKey, runtime.Creator)
+		if err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("break glass credential '%s' of cluster '%s'", credentialID, clusterKey)
+		err = wait.Until(ctx, runtime.Reporter, options, description, func() (string, bool, error) {
+			credential, err := runtime.OCMClient.GetBreakGlassCredential(cluster.ID(), credentialID)
+			if err != nil {
+				return "", false, fmt.Errorf("Failed to get break glass credential '%s': %v", credentialID, err)
+			}
+			return checkCredential(credential, options.For)
+		})
+		if err != nil {
+			return err
+		}
+		runtime.Reporter.Infof("Break glass credential '%s' of cluster '%s' is %s",
+			credentialID, clusterKey, options.For)
+		return nil
+	}
+}
+
+func checkCredential(credential *cmv1.BreakGlassCredential, condition string) (string, bool, error) {
+	status := credential.Status()
+	switch status {
+	case cmv1.BreakGlassCredentialStatusFailed, cmv1.BreakGlassCredentialStatusExpired:
+		return "", false, fmt.Errorf("Break glass credential '%s' is %s", credential.ID(), status)
+	case cmv1.BreakGlassCredentialStatusRevoked, cmv1.BreakGlassCredentialStatusAwaitingRevocation:
+		if condition == string(cmv1.BreakGlassCredentialStatusIssued) {
+			return "", false, fmt.Errorf("Break glass credential '%s' is %s", credential.ID(),
+				cmv1.BreakGlassCredentialStatusRevoked)
+		}
+	}
+	return string(status), string(status) == condition, nil
+}
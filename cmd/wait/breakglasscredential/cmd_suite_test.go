@@ -0,0 +1,13 @@
+package breakglasscredential
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestWaitBreakGlassCredential(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Wait break glass credential suite")
+}
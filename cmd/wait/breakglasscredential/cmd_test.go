@@ -0,0 +1,52 @@
+package breakglasscredential
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Wait break glass credential", func() {
+	build := func(status cmv1.BreakGlassCredentialStatus) *cmv1.BreakGlassCredential {
+		credential, err := cmv1.NewBreakGlassCredential().ID("mycredential").Status(status).Build()
+		Expect(err).ToNot(HaveOccurred())
+		return credential
+	}
+
+	It("Keeps waiting while the credential is being issued", func() {
+		state, done, err := checkCredential(build(cmv1.BreakGlassCredentialStatusCreated), "issued")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal("created"))
+		Expect(done).To(BeFalse())
+	})
+
+	It("Is done when the credential reaches the condition", func() {
+		state, done, err := checkCredential(build(cmv1.BreakGlassCredentialStatusIssued), "issued")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal("issued"))
+		Expect(done).To(BeTrue())
+
+		state, done, err = checkCredential(build(cmv1.BreakGlassCredentialStatusRevoked), "revoked")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal("revoked"))
+		Expect(done).To(BeTrue())
+	})
+
+	It("Keeps waiting while the credential awaits revocation", func() {
+		state, done, err := checkCredential(build(cmv1.BreakGlassCredentialStatusAwaitingRevocation), "revoked")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal("awaiting_revocation"))
+		Expect(done).To(BeFalse())
+	})
+
+	It("Fails when the credential fails, expires or is revoked while waiting for it to be issued", func() {
+		_, _, err := checkCredential(build(cmv1.BreakGlassCredentialStatusFailed), "issued")
+		Expect(err).To(MatchError("Break glass credential 'mycredential' is failed"))
+
+		_, _, err = checkCredential(build(cmv1.BreakGlassCredentialStatusExpired), "revoked")
+		Expect(err).To(MatchError("Break glass credential 'mycredential' is expired"))
+
+		_, _, err = checkCredential(build(cmv1.BreakGlassCredentialStatusAwaitingRevocation), "issued")
+		Expect(err).To(MatchError("Break glass credential 'mycredential' is revoked"))
+	})
+})
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+const (
+	use   = "cluster"
+	short = "Wait for a cluster to reach a condition"
+	long  = "Wait for a cluster to reach a state, or to be deleted. Waiting fails when the cluster " +
+		"reaches the 'error' state, or starts uninstalling while waiting for another state."
+	example = `  # Wait up to 90 minutes for a cluster named "mycluster" to be ready
+  rosa wait cluster --cluster=mycluster --for=ready --timeout=90m
+
+  # Wait for a cluster named "mycluster" to be deleted
+  rosa wait cluster --cluster=mycluster --for=deleted`
+
+	conditionDeleted = "deleted"
+)
+
+var conditions = []string{
+	string(cmv1.ClusterStateReady),
+	string(cmv1.ClusterStateInstalling),
+	string(cmv1.ClusterStateHibernating),
+	string(cmv1.ClusterStateUninstalling),
+	conditionDeleted,
+}
+
+func NewWaitClusterCommand() *cobra.Command {
+	options := &wait.Options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), WaitClusterRunner(options)),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	wait.AddFlags(cmd, options, conditions)
+	return cmd
+}
+
+func WaitClusterRunner(options *wait.Options) rosa.CommandRunner {
+	return func(ctx context.Context, runtime *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		err := options.Validate(conditions)
+		if err != nil {
+			return err
+		}
+
+		clusterKey := runtime.GetClusterKey()
+		cluster, err := runtime.OCMClient.GetCluster(clusterKey, runtime.Creator)
+		if err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("cluster '%s'", clusterKey)
+		err = wait.Until(ctx, runtime.Reporter, options, description, func() (string, bool, error) {
+			return checkCluster(runtime.OCMClient, cluster.ID(), clusterKey, options.For)
+		})
+		if err != nil {
+			return err
+		}
+		runtime.Reporter.Infof("Cluster '%s' is %s", clusterKey, options.For)
+		return nil
+	}
+}
+
+func checkCluster(client *ocm.Client, clusterID string, clusterKey string, condition string) (string, bool, error) {
+	state, err := client.GetClusterState(clusterID)
+	if err != nil || state == "" {
+		// The status can't be fetched once the cluster is gone, check that it was actually deleted
+		_, lookupErr := client.GetClusterByID(clusterID, nil)
+		if errors.GetType(lookupErr) == errors.NotFound {
+			if condition == conditionDeleted {
+				return conditionDeleted, true, nil
+			}
+			return "", false, fmt.Errorf("Cluster '%s' was deleted", clusterKey)
+		}
+		if lookupErr != nil {
+			err = lookupErr
+		}
+		if err != nil {
+			return "", false, fmt.Errorf("Failed to get state of cluster '%s': %v", clusterKey, err)
+		}
+	}
+
+	switch {
+	case string(state) == condition:
+		return string(state), true, nil
+	case state == cmv1.ClusterStateError:
+		return "", false, fmt.Errorf("Cluster '%s' is in 'error' state", clusterKey)
+	case state == cmv1.ClusterStateUninstalling && condition != conditionDeleted:
+		return "", false, fmt.Errorf("Cluster '%s' is uninstalling", clusterKey)
+	}
+	return string(state), false, nil
+}
@@ -0,0 +1,84 @@
+package cluster
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/test"
+)
+
+const statusPath = "/api/clusters_mgmt/v1/clusters/cluster1/status"
+
+var _ = Describe("Wait cluster", func() {
+	var testRuntime test.TestingRuntime
+
+	BeforeEach(func() {
+		testRuntime.InitRuntime()
+	})
+
+	It("Is done when the cluster reaches the condition", func() {
+		testRuntime.ApiServer.RouteToHandler(http.MethodGet, statusPath,
+			RespondWithJSON(http.StatusOK, `{"kind": "ClusterStatus", "state": "ready"}`))
+		state, done, err := checkCluster(testRuntime.RosaRuntime.OCMClient, "cluster1", "mycluster", "ready")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal("ready"))
+		Expect(done).To(BeTrue())
+	})
+
+	It("Keeps waiting while the cluster is installing", func() {
+		testRuntime.ApiServer.RouteToHandler(http.MethodGet, statusPath,
+			RespondWithJSON(http.StatusOK, `{"kind": "ClusterStatus", "state": "installing"}`))
+		state, done, err := checkCluster(testRuntime.RosaRuntime.OCMClient, "cluster1", "mycluster", "ready")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal("installing"))
+		Expect(done).To(BeFalse())
+	})
+
+	It("Fails when the cluster is in error state", func() {
+		testRuntime.ApiServer.RouteToHandler(http.MethodGet, statusPath,
+			RespondWithJSON(http.StatusOK, `{"kind": "ClusterStatus", "state": "error"}`))
+		_, _, err := checkCluster(testRuntime.RosaRuntime.OCMClient, "cluster1", "mycluster", "ready")
+		Expect(err).To(MatchError("Cluster 'mycluster' is in 'error' state"))
+	})
+
+	It("Fails when the cluster starts uninstalling", func() {
+		testRuntime.ApiServer.RouteToHandler(http.MethodGet, statusPath,
+			RespondWithJSON(http.StatusOK, `{"kind": "ClusterStatus", "state": "uninstalling"}`))
+		_, _, err := checkCluster(testRuntime.RosaRuntime.OCMClient, "cluster1", "mycluster", "ready")
+		Expect(err).To(MatchError("Cluster 'mycluster' is uninstalling"))
+
+		_, done, err := checkCluster(testRuntime.RosaRuntime.OCMClient, "cluster1", "mycluster", "deleted")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeFalse())
+	})
+
+	It("Is done when waiting for a deleted cluster", func() {
+		notFound := `{"kind": "Error", "id": "404", "reason": "Cluster 'cluster1' not found"}`
+		testRuntime.ApiServer.RouteToHandler(http.MethodGet, statusPath,
+			RespondWithJSON(http.StatusNotFound, notFound))
+		testRuntime.ApiServer.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/clusters",
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{})))
+		state, done, err := checkCluster(testRuntime.RosaRuntime.OCMClient, "cluster1", "mycluster", "deleted")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal("deleted"))
+		Expect(done).To(BeTrue())
+	})
+
+	It("Fails when the state can't be fetched but the cluster exists", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.ID("cluster1")
+		})
+		testRuntime.ApiServer.RouteToHandler(http.MethodGet, statusPath,
+			RespondWithJSON(http.StatusInternalServerError, `{"kind": "Error", "reason": "Internal error"}`))
+		testRuntime.ApiServer.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/clusters",
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{cluster})))
+		_, done, err := checkCluster(testRuntime.RosaRuntime.OCMClient, "cluster1", "mycluster", "ready")
+		Expect(err).To(MatchError(ContainSubstring("Failed to get state of cluster 'mycluster'")))
+		Expect(err).To(MatchError(ContainSubstring("Internal error")))
+		Expect(done).To(BeFalse())
+	})
+})
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wait
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/wait/breakglasscredential"
+	"github.com/openshift/rosa/cmd/wait/cluster"
+	"github.com/openshift/rosa/cmd/wait/machinepool"
+	"github.com/openshift/rosa/cmd/wait/upgrade"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "wait",
+	Short: "Wait for a resource to reach a condition",
+	Long: "Wait for a resource to reach a condition. The command exits with a non-zero code when the " +
+		"timeout expires or when the resource reaches a state it won't leave. The timeout is set with " +
+		"the global '--timeout' flag, and is 60 minutes when that flag isn't set.",
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	Cmd.AddCommand(cluster.NewWaitClusterCommand())
+	Cmd.AddCommand(machinepool.NewWaitMachinePoolCommand())
+	Cmd.AddCommand(upgrade.NewWaitUpgradeCommand())
+	Cmd.AddCommand(breakglasscredential.NewWaitBreakGlassCredentialCommand())
+}
@@ -0,0 +1,175 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+const (
+	use   = "machinepool"
+	alias = "machine-pool"
+	short = "Wait for a machine pool to reach a condition"
+	long  = "Wait for a machine pool to be ready or deleted. A machine pool that doesn't exist yet is " +
+		"waited for. A machine pool of a Hosted Control Plane cluster is ready when all its nodes are " +
+		"running. OCM doesn't report the nodes of each machine pool of a classic cluster, so such a " +
+		"machine pool is ready when the cluster runs the nodes of all its machine pools."
+	example = `  # Wait for a machine pool named "mymachinepool" of a cluster named "mycluster" to be ready
+  rosa wait machinepool --cluster=mycluster --machinepool=mymachinepool --for=ready --timeout=30m`
+
+	conditionReady   = "ready"
+	conditionDeleted = "deleted"
+)
+
+var conditions = []string{conditionReady, conditionDeleted}
+
+var args struct {
+	machinePool string
+}
+
+func NewWaitMachinePoolCommand() *cobra.Command {
+	options := &wait.Options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Aliases: []string{alias},
+		Example: example,
+		Args:    cobra.MaximumNArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), WaitMachinePoolRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&args.machinePool,
+		"machinepool",
+		"",
+		"Machine pool of the cluster to target",
+	)
+	ocm.AddClusterFlag(cmd)
+	wait.AddFlags(cmd, options, conditions)
+	return cmd
+}
+
+func WaitMachinePoolRunner(options *wait.Options) rosa.CommandRunner {
+	return func(ctx context.Context, runtime *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		err := options.Validate(conditions)
+		if err != nil {
+			return err
+		}
+		machinePoolID := args.machinePool
+		// Allow the machine pool to be given as a positional parameter
+		if len(argv) == 1 && !cmd.Flag("machinepool").Changed {
+			machinePoolID = argv[0]
+		}
+		if machinePoolID == "" {
+			return fmt.Errorf("You need to specify a machine pool with the '--machinepool' parameter")
+		}
+
+		clusterKey := runtime.GetClusterKey()
+		cluster, err := runtime.OCMClient.GetCluster(clusterKey, runtime.Creator)
+		if err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("machine pool '%s' of cluster '%s'", machinePoolID, clusterKey)
+		err = wait.Until(ctx, runtime.Reporter, options, description, func() (string, bool, error) {
+			if cluster.Hypershift().Enabled() {
+				return checkNodePool(runtime.OCMClient, cluster.ID(), machinePoolID, options.For)
+			}
+			return checkMachinePool(runtime.OCMClient, cluster.ID(), machinePoolID, options.For)
+		})
+		if err != nil {
+			return err
+		}
+		runtime.Reporter.Infof("Machine pool '%s' of cluster '%s' is %s", machinePoolID, clusterKey, options.For)
+		return nil
+	}
+}
+
+func checkNodePool(client *ocm.Client, clusterID string, nodePoolID string, condition string) (string, bool, error) {
+	nodePool, exists, err := client.GetNodePool(clusterID, nodePoolID)
+	if err != nil {
+		return "", false, fmt.Errorf("Failed to get machine pool '%s': %v", nodePoolID, err)
+	}
+	if !exists {
+		return notFound(condition)
+	}
+	if condition == conditionDeleted {
+		return "exists", false, nil
+	}
+
+	desired := nodePool.Replicas()
+	if nodePool.Autoscaling() != nil {
+		desired = nodePool.Autoscaling().MinReplica()
+	}
+	current := nodePool.Status().CurrentReplicas()
+	state := fmt.Sprintf("%d/%d nodes running", current, desired)
+	if message := nodePool.Status().Message(); message != "" {
+		state = fmt.Sprintf("%s (%s)", state, message)
+	}
+	return state, current >= desired, nil
+}
+
+func checkMachinePool(client *ocm.Client, clusterID string, machinePoolID string,
+	condition string) (string, bool, error) {
+	_, exists, err := client.GetMachinePool(clusterID, machinePoolID)
+	if err != nil {
+		return "", false, fmt.Errorf("Failed to get machine pool '%s': %v", machinePoolID, err)
+	}
+	if !exists {
+		return notFound(condition)
+	}
+	if condition == conditionDeleted {
+		return "exists", false, nil
+	}
+
+	machinePools, err := client.GetMachinePools(clusterID)
+	if err != nil {
+		return "", false, fmt.Errorf("Failed to get machine pools: %v", err)
+	}
+	desired := 0
+	for _, machinePool := range machinePools {
+		if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+			desired += autoscaling.MinReplicas()
+		} else {
+			desired += machinePool.Replicas()
+		}
+	}
+	status, err := client.GetClusterStatus(clusterID)
+	if err != nil {
+		return "", false, fmt.Errorf("Failed to get the nodes of the cluster: %v", err)
+	}
+	current := status.CurrentCompute()
+	return fmt.Sprintf("%d/%d compute nodes of the cluster running", current, desired), current >= desired, nil
+}
+
+// notFound waits for a machine pool that doesn't exist yet, as it may be created after the command
+// starts.
+func notFound(condition string) (string, bool, error) {
+	if condition == conditionDeleted {
+		return conditionDeleted, true, nil
+	}
+	return "not found", false, nil
+}
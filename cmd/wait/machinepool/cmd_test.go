@@ -0,0 +1,117 @@
+package machinepool
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	machinePoolsPath = "/api/clusters_mgmt/v1/clusters/cluster1/machine_pools"
+	nodePoolsPath    = "/api/clusters_mgmt/v1/clusters/cluster1/node_pools"
+	statusPath       = "/api/clusters_mgmt/v1/clusters/cluster1/status"
+	notFoundBody     = `{"kind": "Error", "id": "404", "reason": "Not found"}`
+)
+
+var _ = Describe("Wait machine pool", func() {
+	var testRuntime test.TestingRuntime
+
+	BeforeEach(func() {
+		testRuntime.InitRuntime()
+	})
+
+	Context("Classic clusters", func() {
+		var worker, infra *cmv1.MachinePool
+
+		BeforeEach(func() {
+			var err error
+			worker, err = cmv1.NewMachinePool().ID("worker").Replicas(2).Build()
+			Expect(err).ToNot(HaveOccurred())
+			infra, err = cmv1.NewMachinePool().ID("infra").
+				Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(3).MaxReplicas(6)).Build()
+			Expect(err).ToNot(HaveOccurred())
+			testRuntime.ApiServer.RouteToHandler(http.MethodGet, machinePoolsPath+"/infra",
+				RespondWithJSON(http.StatusOK, test.FormatResource(infra)))
+			testRuntime.ApiServer.RouteToHandler(http.MethodGet, machinePoolsPath,
+				RespondWithJSON(http.StatusOK, test.FormatMachinePoolList([]*cmv1.MachinePool{worker, infra})))
+		})
+
+		It("Keeps waiting while the cluster runs fewer nodes than its machine pools", func() {
+			testRuntime.ApiServer.RouteToHandler(http.MethodGet, statusPath,
+				RespondWithJSON(http.StatusOK, `{"kind": "ClusterStatus", "state": "ready", "current_compute": 2}`))
+			state, done, err := checkMachinePool(testRuntime.RosaRuntime.OCMClient, "cluster1", "infra", "ready")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal("2/5 compute nodes of the cluster running"))
+			Expect(done).To(BeFalse())
+		})
+
+		It("Is done when the cluster runs the nodes of all its machine pools", func() {
+			testRuntime.ApiServer.RouteToHandler(http.MethodGet, statusPath,
+				RespondWithJSON(http.StatusOK, `{"kind": "ClusterStatus", "state": "ready", "current_compute": 5}`))
+			state, done, err := checkMachinePool(testRuntime.RosaRuntime.OCMClient, "cluster1", "infra", "ready")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal("5/5 compute nodes of the cluster running"))
+			Expect(done).To(BeTrue())
+		})
+
+		It("Waits for a machine pool that doesn't exist yet", func() {
+			testRuntime.ApiServer.RouteToHandler(http.MethodGet, machinePoolsPath+"/gpu",
+				RespondWithJSON(http.StatusNotFound, notFoundBody))
+			state, done, err := checkMachinePool(testRuntime.RosaRuntime.OCMClient, "cluster1", "gpu", "ready")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal("not found"))
+			Expect(done).To(BeFalse())
+		})
+
+		It("Is done when waiting for a deleted machine pool", func() {
+			testRuntime.ApiServer.RouteToHandler(http.MethodGet, machinePoolsPath+"/gpu",
+				RespondWithJSON(http.StatusNotFound, notFoundBody))
+			state, done, err := checkMachinePool(testRuntime.RosaRuntime.OCMClient, "cluster1", "gpu", "deleted")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal("deleted"))
+			Expect(done).To(BeTrue())
+
+			_, done, err = checkMachinePool(testRuntime.RosaRuntime.OCMClient, "cluster1", "infra", "deleted")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(done).To(BeFalse())
+		})
+	})
+
+	Context("Hosted Control Plane clusters", func() {
+		It("Is done when all the nodes of the machine pool are running", func() {
+			nodePool := test.MockNodePool(func(n *cmv1.NodePoolBuilder) {
+				n.ID("workers").Replicas(3).Status(cmv1.NewNodePoolStatus().CurrentReplicas(1))
+			})
+			testRuntime.ApiServer.RouteToHandler(http.MethodGet, nodePoolsPath+"/workers",
+				RespondWithJSON(http.StatusOK, test.FormatResource(nodePool)))
+			state, done, err := checkNodePool(testRuntime.RosaRuntime.OCMClient, "cluster1", "workers", "ready")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal("1/3 nodes running"))
+			Expect(done).To(BeFalse())
+
+			nodePool = test.MockNodePool(func(n *cmv1.NodePoolBuilder) {
+				n.ID("workers").Replicas(3).Status(cmv1.NewNodePoolStatus().CurrentReplicas(3))
+			})
+			testRuntime.ApiServer.RouteToHandler(http.MethodGet, nodePoolsPath+"/workers",
+				RespondWithJSON(http.StatusOK, test.FormatResource(nodePool)))
+			state, done, err = checkNodePool(testRuntime.RosaRuntime.OCMClient, "cluster1", "workers", "ready")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal("3/3 nodes running"))
+			Expect(done).To(BeTrue())
+		})
+
+		It("Waits for a machine pool that doesn't exist yet", func() {
+			testRuntime.ApiServer.RouteToHandler(http.MethodGet, nodePoolsPath+"/workers",
+				RespondWithJSON(http.StatusNotFound, notFoundBody))
+			state, done, err := checkNodePool(testRuntime.RosaRuntime.OCMClient, "cluster1", "workers", "ready")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal("not found"))
+			Expect(done).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/wait"
+)
+
+const (
+	use   = "upgrade"
+	short = "Wait for a cluster upgrade to reach a condition"
+	long  = "Wait for the scheduled upgrade of a cluster to start or to complete. An upgrade is " +
+		"complete once it is no longer scheduled. Waiting fails when the upgrade fails or is cancelled."
+	example = `  # Wait for the upgrade of a cluster named "mycluster" to complete
+  rosa wait upgrade --cluster=mycluster --for=completed --timeout=3h`
+
+	conditionCompleted = "completed"
+	conditionStarted   = "started"
+)
+
+var conditions = []string{conditionCompleted, conditionStarted}
+
+func NewWaitUpgradeCommand() *cobra.Command {
+	options := &wait.Options{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), WaitUpgradeRunner(options)),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	wait.AddFlags(cmd, options, conditions)
+	return cmd
+}
+
+func WaitUpgradeRunner(options *wait.Options) rosa.CommandRunner {
+	return func(ctx context.Context, runtime *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		err := options.Validate(conditions)
+		if err != nil {
+			return err
+		}
+
+		clusterKey := runtime.GetClusterKey()
+		cluster, err := runtime.OCMClient.GetCluster(clusterKey, runtime.Creator)
+		if err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("upgrade of cluster '%s'", clusterKey)
+		err = wait.Until(ctx, runtime.Reporter, options, description, func() (string, bool, error) {
+			state, err := getUpgradeState(runtime.OCMClient, cluster)
+			if err != nil {
+				return "", false, fmt.Errorf("Failed to get upgrade of cluster '%s': %v", clusterKey, err)
+			}
+			return checkUpgrade(clusterKey, state, options.For)
+		})
+		if err != nil {
+			return err
+		}
+		runtime.Reporter.Infof("Upgrade of cluster '%s' is %s", clusterKey, options.For)
+		return nil
+	}
+}
+
+// getUpgradeState returns the state of the scheduled upgrade of the cluster, or an empty value when
+// no upgrade is scheduled anymore.
+func getUpgradeState(client *ocm.Client, cluster *cmv1.Cluster) (cmv1.UpgradePolicyStateValue, error) {
+	if cluster.Hypershift().Enabled() {
+		upgradePolicy, err := client.GetControlPlaneScheduledUpgrade(cluster.ID())
+		if err != nil || upgradePolicy == nil {
+			return "", err
+		}
+		return upgradePolicy.State().Value(), nil
+	}
+	upgradePolicy, state, err := client.GetScheduledUpgrade(cluster.ID())
+	if err != nil || upgradePolicy == nil {
+		return "", err
+	}
+	return state.Value(), nil
+}
+
+func checkUpgrade(clusterKey string, state cmv1.UpgradePolicyStateValue, condition string) (string, bool, error) {
+	switch state {
+	case "", cmv1.UpgradePolicyStateValueCompleted:
+		return conditionCompleted, true, nil
+	case cmv1.UpgradePolicyStateValueStarted:
+		return string(state), condition == conditionStarted, nil
+	case cmv1.UpgradePolicyStateValueFailed, cmv1.UpgradePolicyStateValueCancelled:
+		return "", false, fmt.Errorf("Upgrade of cluster '%s' is %s", clusterKey, state)
+	}
+	return string(state), false, nil
+}
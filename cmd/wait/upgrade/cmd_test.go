@@ -0,0 +1,44 @@
+package upgrade
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Wait upgrade", func() {
+	It("Keeps waiting while the upgrade is scheduled", func() {
+		state, done, err := checkUpgrade("mycluster", cmv1.UpgradePolicyStateValueScheduled, conditionStarted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal("scheduled"))
+		Expect(done).To(BeFalse())
+	})
+
+	It("Is done when the upgrade starts only when waiting for it to start", func() {
+		state, done, err := checkUpgrade("mycluster", cmv1.UpgradePolicyStateValueStarted, conditionStarted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal("started"))
+		Expect(done).To(BeTrue())
+
+		_, done, err = checkUpgrade("mycluster", cmv1.UpgradePolicyStateValueStarted, conditionCompleted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeFalse())
+	})
+
+	It("Is done when the upgrade is no longer scheduled", func() {
+		for _, condition := range conditions {
+			state, done, err := checkUpgrade("mycluster", "", condition)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state).To(Equal("completed"))
+			Expect(done).To(BeTrue())
+		}
+	})
+
+	It("Fails when the upgrade fails or is cancelled", func() {
+		_, _, err := checkUpgrade("mycluster", cmv1.UpgradePolicyStateValueFailed, conditionCompleted)
+		Expect(err).To(MatchError("Upgrade of cluster 'mycluster' is failed"))
+
+		_, _, err = checkUpgrade("mycluster", cmv1.UpgradePolicyStateValueCancelled, conditionStarted)
+		Expect(err).To(MatchError("Upgrade of cluster 'mycluster' is cancelled"))
+	})
+})
@@ -18,7 +18,6 @@ package whoami
 
 import (
 	"fmt"
-	"os"
 	"sort"
 
 	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
@@ -26,7 +25,9 @@ import (
 
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/config"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/object"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
@@ -56,30 +57,27 @@ func run(_ *cobra.Command, _ []string) {
 	// Get default AWS region:
 	awsRegion, err := aws.GetRegion("")
 	if err != nil {
-		r.Reporter.Errorf("Error getting AWS region: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Error getting AWS region")))
 	}
 
 	// Load the configuration file:
 	cfg, err := config.Load()
 	if err != nil {
-		r.Reporter.Errorf("Failed to load config file: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to load config file")))
 	}
 	if cfg == nil {
 		r.Reporter.Errorf("User is not logged in to OCM")
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Verify configuration file:
 	loggedIn, err := cfg.Armed()
 	if err != nil {
-		r.Reporter.Errorf("Failed to verify configuration: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to verify configuration")))
 	}
 	if !loggedIn {
 		r.Reporter.Errorf("User is not logged in to OCM")
-		os.Exit(0)
+		cmdcontext.Exit(0)
 	}
 
 	// Create a connection to OCM:
@@ -88,23 +86,20 @@ func run(_ *cobra.Command, _ []string) {
 		Logger(r.Logger).
 		Build()
 	if err != nil {
-		r.Reporter.Errorf("Failed to create OCM connection: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to create OCM connection")))
 	}
 	defer r.Cleanup()
 
 	// Get current OCM account:
 	account, err := r.OCMClient.GetCurrentAccount()
 	if err != nil {
-		r.Reporter.Errorf("Failed to get current account: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get current account")))
 	}
 
 	if account == nil {
 		account, err = getAccountDataFromToken(cfg)
 		if err != nil {
-			r.Reporter.Errorf("Failed to get account data from token: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get account data from token")))
 		}
 	}
 	outputObject := object.Object{
@@ -127,7 +122,7 @@ func run(_ *cobra.Command, _ []string) {
 		err = output.Print(outputObject)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		return
 	}
@@ -29,6 +29,8 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws/profile"
 	"github.com/openshift/rosa/pkg/aws/region"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/debug"
 )
 
@@ -294,6 +296,33 @@ func AddDebugFlag(fs *pflag.FlagSet) {
 	debug.AddFlag(fs)
 }
 
+// AddContextFlag adds the '--context' flag to the given set of command line flags.
+func AddContextFlag(fs *pflag.FlagSet) {
+	config.AddContextFlag(fs)
+}
+
+// ApplyContextDefaults makes the AWS profile and region of the selected configuration context the
+// ones used when neither the flags nor the environment select others. It must be called before the
+// AWS client is created. Errors loading the configuration are ignored here, they are reported when
+// the OCM client is created.
+func ApplyContextDefaults() {
+	cfg, err := config.Load()
+	if err != nil || cfg == nil {
+		return
+	}
+	if cfg.AWSProfile != "" {
+		profile.SetDefault(cfg.AWSProfile)
+	}
+	if cfg.AWSRegion != "" {
+		region.SetDefault(cfg.AWSRegion)
+	}
+}
+
+// AddTimeoutFlag adds the '--timeout' flag to the given set of command line flags.
+func AddTimeoutFlag(fs *pflag.FlagSet) {
+	cmdcontext.AddTimeoutFlag(fs)
+}
+
 // AddProfileFlag adds the '--profile' flag to the given set of command line flags.
 func AddProfileFlag(fs *pflag.FlagSet) {
 	profile.AddFlag(fs)
@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"strings"
 	"time"
 
@@ -55,11 +54,16 @@ import (
 	"github.com/openshift/rosa/pkg/aws/profile"
 	regionflag "github.com/openshift/rosa/pkg/aws/region"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/cassette"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/info"
 	"github.com/openshift/rosa/pkg/logging"
 	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/timings"
+	"github.com/openshift/rosa/pkg/tracing"
 )
 
 var (
@@ -228,6 +232,7 @@ type ClientBuilder struct {
 	region              *string
 	credentials         *AccessKey
 	useLocalCredentials bool
+	ctx                 context.Context
 }
 
 type awsClient struct {
@@ -244,6 +249,7 @@ type awsClient struct {
 	iamQuotaClient      client.ServiceQuotasApiClient
 	awsAccessKeys       *AccessKey
 	useLocalCredentials bool
+	ctx                 context.Context
 }
 
 func CreateNewClientOrExit(logger *logrus.Logger, reporter *reporter.Object) Client {
@@ -251,13 +257,23 @@ func CreateNewClientOrExit(logger *logrus.Logger, reporter *reporter.Object) Cli
 		Logger(logger).
 		Build()
 	if err != nil {
-		reporter.Errorf("Failed to create AWS client: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(reporter, newClientError(err)))
 	}
 
 	return awsClient
 }
 
+// newClientError returns the error reported when the client can't be created. Unless AWS reported
+// a more specific error, it is because of missing or invalid credentials.
+func newClientError(err error) error {
+	err = rosaerrors.Wrapf(err, "Failed to create AWS client")
+	switch rosaerrors.Classify(err).Category {
+	case rosaerrors.CategoryGeneral, rosaerrors.CategoryAWS:
+		return rosaerrors.Wrap(rosaerrors.CategoryAWSCredentials, err)
+	}
+	return err
+}
+
 // NewClient creates a builder that can then be used to configure and build a new AWS client.
 func NewClient() *ClientBuilder {
 	return &ClientBuilder{}
@@ -293,9 +309,18 @@ func New(
 		iamQuotaClient,
 		awsAccessKeys,
 		useLocalCredentials,
+		nil,
 	}
 }
 
+// context returns the context used for the requests sent to AWS.
+func (c *awsClient) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
 // Logger sets the logger that the AWS client will use to send messages to the log.
 func (b *ClientBuilder) Logger(value *logrus.Logger) *ClientBuilder {
 	b.logger = value
@@ -317,20 +342,34 @@ func (b *ClientBuilder) UseLocalCredentials(value bool) *ClientBuilder {
 	return b
 }
 
+// Context sets the context used for the requests sent to AWS. The context of the command is used
+// by default, so the requests are cancelled when the command is interrupted or times out.
+func (b *ClientBuilder) Context(value context.Context) *ClientBuilder {
+	b.ctx = value
+	return b
+}
+
 // Create AWS session with a specific set of credentials
 func (b *ClientBuilder) BuildSessionWithOptionsCredentials(value *AccessKey,
 	logLevel aws.ClientLogMode) (aws.Config, error) {
+	logger, err := logging.NewAWSLogger().Logger(b.logger).Build()
+	if err != nil {
+		return aws.Config{}, err
+	}
 	cfg, err := config.LoadDefaultConfig(context.TODO(),
 		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(value.AccessKeyID,
 			value.SecretAccessKey, "")),
 		config.WithRegion(*b.region),
 		config.WithHTTPClient(&http.Client{
-			Transport: http.DefaultTransport,
+			Transport: cassette.Wrap(http.DefaultTransport),
 		}),
 		config.WithClientLogMode(logLevel),
+		config.WithLogger(logger),
 		config.WithAPIOptions([]func(stack *middleware.Stack) error{
 			smithyhttp.AddHeaderValue("User-Agent",
 				strings.Join([]string{"ROSACLI", info.Version}, ";")),
+			tracing.AddMiddleware,
+			timings.AddMiddleware,
 		}),
 		config.WithRetryer(func() aws.Retryer {
 			retryer := retry.AddWithMaxAttempts(retry.NewStandard(), numMaxRetries)
@@ -351,14 +390,37 @@ func (b *ClientBuilder) BuildSessionWithOptionsCredentials(value *AccessKey,
 }
 
 func (b *ClientBuilder) BuildSessionWithOptions(logLevel aws.ClientLogMode) (aws.Config, error) {
-	cfg, err := config.LoadDefaultConfig(context.TODO(),
+	logger, err := logging.NewAWSLogger().Logger(b.logger).Build()
+	if err != nil {
+		return aws.Config{}, err
+	}
+	var httpClient config.HTTPClient = awshttp.NewBuildableClient().WithTransportOptions()
+	if cassette.Enabled() {
+		httpClient = &http.Client{
+			Transport: cassette.Wrap(http.DefaultTransport),
+		}
+	}
+	options := []func(*config.LoadOptions) error{
 		config.WithSharedConfigProfile(profile.Profile()),
+	}
+	if cassette.Replaying() {
+		// Replayed requests are answered from the cassette, so the credentials of the user
+		// aren't needed, and the recorded requests don't contain them anyhow:
+		options = []func(*config.LoadOptions) error{
+			config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+				"AKIAREPLAY", "replay", "")),
+		}
+	}
+	cfg, err := config.LoadDefaultConfig(context.TODO(), append(options,
 		config.WithRegion(*b.region),
-		config.WithHTTPClient(awshttp.NewBuildableClient().WithTransportOptions()),
+		config.WithHTTPClient(httpClient),
 		config.WithClientLogMode(logLevel),
+		config.WithLogger(logger),
 		config.WithAPIOptions([]func(stack *middleware.Stack) error{
 			smithyhttp.AddHeaderValue("User-Agent",
 				strings.Join([]string{"ROSACLI", info.Version}, ";")),
+			tracing.AddMiddleware,
+			timings.AddMiddleware,
 		}),
 		config.WithRetryer(func() aws.Retryer {
 			retryer := retry.AddWithMaxAttempts(retry.NewStandard(), numMaxRetries)
@@ -370,7 +432,7 @@ func (b *ClientBuilder) BuildSessionWithOptions(logLevel aws.ClientLogMode) (aws
 
 			return retryer
 		}),
-	)
+	)...)
 	if err != nil {
 		return aws.Config{}, err
 	}
@@ -403,7 +465,13 @@ func (b *ClientBuilder) Build() (Client, error) {
 	if b.logger == nil {
 		return nil, fmt.Errorf("logger is mandatory")
 	}
+	if b.ctx == nil {
+		b.ctx = cmdcontext.Current()
+	}
 
+	if (b.region == nil || *b.region == "") && cassette.Replaying() && regionflag.Region() == "" {
+		b.region = aws.String(cassette.AWSRegion())
+	}
 	if b.region == nil || *b.region == "" {
 		region, err := GetRegion(regionflag.Region())
 		if err != nil {
@@ -453,6 +521,7 @@ func (b *ClientBuilder) Build() (Client, error) {
 		serviceQuotasClient: servicequotas.NewFromConfig(cfg),
 		iamQuotaClient:      servicequotas.NewFromConfig(iamCfg),
 		useLocalCredentials: b.useLocalCredentials,
+		ctx:                 b.ctx,
 	}
 
 	_, root, err := getClientDetails(c)
@@ -468,7 +537,7 @@ func (b *ClientBuilder) Build() (Client, error) {
 }
 
 func (c *awsClient) GetIAMCredentials() (aws.Credentials, error) {
-	return c.cfg.Credentials.Retrieve(context.TODO())
+	return c.cfg.Credentials.Retrieve(c.context())
 }
 
 func (c *awsClient) GetRegion() string {
@@ -488,7 +557,7 @@ func (c *awsClient) FetchPublicSubnetMap(subnets []ec2types.Subnet) (map[string]
 		for _, subnet := range curChunk {
 			subnetIds = append(subnetIds, subnet.SubnetId)
 		}
-		routeTablesResp, err := c.ec2Client.DescribeRouteTables(context.Background(), &ec2.DescribeRouteTablesInput{
+		routeTablesResp, err := c.ec2Client.DescribeRouteTables(c.context(), &ec2.DescribeRouteTablesInput{
 			Filters: []ec2types.Filter{
 				{
 					Name:   aws.String("association.subnet-id"),
@@ -543,7 +612,7 @@ func (c *awsClient) ListSubnets(subnetIds ...string) ([]ec2types.Subnet, error)
 
 func (c *awsClient) GetSubnetAvailabilityZone(subnetID string) (string, error) {
 	res, err := c.ec2Client.DescribeSubnets(
-		context.Background(),
+		c.context(),
 		&ec2.DescribeSubnetsInput{SubnetIds: []string{subnetID}},
 	)
 	if err != nil {
@@ -609,7 +678,7 @@ func (c *awsClient) FilterVPCsPrivateSubnets(subnets []ec2types.Subnet) ([]ec2ty
 	// Fetch VPC route tables
 	vpcID := subnets[0].VpcId
 	describeRouteTablesOutput, err := c.ec2Client.DescribeRouteTables(
-		context.Background(),
+		c.context(),
 		&ec2.DescribeRouteTablesInput{
 			Filters: []ec2types.Filter{
 				{
@@ -689,7 +758,7 @@ func (c *awsClient) getSubnetRouteTable(subnetID *string,
 // getSubnetIDs will return the list of subnetsIDs supported for the region picked.
 // It is possible to pass non-empty `describeSubnetsInput` to filter results.
 func (c *awsClient) getSubnetIDs(describeSubnetsInput *ec2.DescribeSubnetsInput) ([]ec2types.Subnet, error) {
-	res, err := c.ec2Client.DescribeSubnets(context.Background(), describeSubnetsInput)
+	res, err := c.ec2Client.DescribeSubnets(c.context(), describeSubnetsInput)
 	if err != nil {
 		return nil, err
 	}
@@ -706,7 +775,7 @@ type Creator struct {
 }
 
 func (c *awsClient) GetCreator() (*Creator, error) {
-	getCallerIdentityOutput, err := c.stsClient.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
+	getCallerIdentityOutput, err := c.stsClient.GetCallerIdentity(c.context(), &sts.GetCallerIdentityInput{})
 	if err != nil {
 		return nil, err
 	}
@@ -754,7 +823,7 @@ func (c *awsClient) ValidateCredentials() (bool, error) {
 	// This will fail if the AWS access key and secret key are invalid. This
 	// will also work for STS credentials with access key, secret key and session
 	// token
-	_, err := c.stsClient.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
+	_, err := c.stsClient.GetCallerIdentity(c.context(), &sts.GetCallerIdentityInput{})
 	if err != nil {
 		if strings.Contains(fmt.Sprintf("%s", err), "InvalidClientTokenId") {
 			awsErr := fmt.Errorf("Invalid AWS Credentials: %s.\n For help configuring your credentials, see %s",
@@ -770,7 +839,7 @@ func (c *awsClient) ValidateCredentials() (bool, error) {
 }
 
 func (c *awsClient) CheckAdminUserNotExisting(userName string) (err error) {
-	userList, err := c.iamClient.ListUsers(context.Background(), &iam.ListUsersInput{})
+	userList, err := c.iamClient.ListUsers(c.context(), &iam.ListUsersInput{})
 	if err != nil {
 		return err
 	}
@@ -786,7 +855,7 @@ func (c *awsClient) CheckAdminUserNotExisting(userName string) (err error) {
 }
 
 func (c *awsClient) CheckAdminUserExists(userName string) (err error) {
-	_, err = c.iamClient.GetUser(context.Background(), &iam.GetUserInput{UserName: aws.String(userName)})
+	_, err = c.iamClient.GetUser(c.context(), &iam.GetUserInput{UserName: aws.String(userName)})
 	if err != nil {
 		return err
 	}
@@ -794,7 +863,7 @@ func (c *awsClient) CheckAdminUserExists(userName string) (err error) {
 }
 
 func (c *awsClient) GetClusterRegionTagForUser(username string) (string, error) {
-	user, err := c.iamClient.GetUser(context.Background(), &iam.GetUserInput{UserName: aws.String(username)})
+	user, err := c.iamClient.GetUser(c.context(), &iam.GetUserInput{UserName: aws.String(username)})
 	if err != nil {
 		return "", err
 	}
@@ -807,7 +876,7 @@ func (c *awsClient) GetClusterRegionTagForUser(username string) (string, error)
 }
 
 func (c *awsClient) TagUserRegion(username string, region string) error {
-	_, err := c.iamClient.TagUser(context.Background(), &iam.TagUserInput{
+	_, err := c.iamClient.TagUser(c.context(), &iam.TagUserInput{
 		UserName: aws.String(username),
 		Tags: []iamtypes.Tag{
 			{
@@ -857,7 +926,7 @@ func (c *awsClient) GetAWSAccessKeys() (*AccessKey, error) {
 }
 
 func (c *awsClient) GetLocalAWSAccessKeys() (*AccessKey, error) {
-	creds, err := c.cfg.Credentials.Retrieve(context.Background())
+	creds, err := c.cfg.Credentials.Retrieve(c.context())
 	if err != nil {
 		return nil, err
 	}
@@ -892,14 +961,18 @@ func (c *awsClient) ValidateAccessKeys(AccessKey *AccessKey) error {
 				wait := time.Duration((i * 200)) * time.Millisecond
 				waited := time.Since(start)
 				logger.Debug(fmt.Printf("InvalidClientTokenId, waited %.2f\n", waited.Seconds()))
-				time.Sleep(wait)
+				if err := cmdcontext.Sleep(c.context(), wait); err != nil {
+					return err
+				}
 			}
 
 			if awserr.IsAccessDeniedException(err) {
 				wait := time.Duration((i * 200)) * time.Millisecond
 				waited := time.Since(start)
 				logger.Debug(fmt.Printf("AccessDenied, waited %.2f\n", waited.Seconds()))
-				time.Sleep(wait)
+				if err := cmdcontext.Sleep(c.context(), wait); err != nil {
+					return err
+				}
 			}
 
 			if i == maxAttempts {
@@ -938,7 +1011,7 @@ func (c *awsClient) UpsertAccessKey(username string) (*AccessKey, error) {
 // CreateAccessKey creates an IAM access key for `username`
 func (c *awsClient) CreateAccessKey(username string) (*iam.CreateAccessKeyOutput, error) {
 	// Create access key for IAM user
-	createIAMUserAccessKeyOutput, err := c.iamClient.CreateAccessKey(context.Background(),
+	createIAMUserAccessKeyOutput, err := c.iamClient.CreateAccessKey(c.context(),
 		&iam.CreateAccessKeyInput{
 			UserName: aws.String(username),
 		},
@@ -955,7 +1028,7 @@ func (c *awsClient) CreateAccessKey(username string) (*iam.CreateAccessKeyOutput
 func (c *awsClient) DeleteAccessKeys(username string) error {
 	// List all access keys for user. Result wont be truncated since IAM users
 	// can only have 2 access keys
-	listAccessKeysOutput, err := c.iamClient.ListAccessKeys(context.Background(),
+	listAccessKeysOutput, err := c.iamClient.ListAccessKeys(c.context(),
 		&iam.ListAccessKeysInput{
 			UserName: aws.String(username),
 		},
@@ -967,7 +1040,7 @@ func (c *awsClient) DeleteAccessKeys(username string) error {
 	// Delete all access keys. Moactl owns this user since the CloudFormation stack
 	// at this point is complete and the user is tagged by use on creation
 	for _, key := range listAccessKeysOutput.AccessKeyMetadata {
-		_, err = c.iamClient.DeleteAccessKey(context.Background(),
+		_, err = c.iamClient.DeleteAccessKey(c.context(),
 			&iam.DeleteAccessKeyInput{
 				UserName:    aws.String(username),
 				AccessKeyId: key.AccessKeyId,
@@ -985,7 +1058,7 @@ func (c *awsClient) DeleteAccessKeys(username string) error {
 // CheckRoleExists checks to see if an IAM role with the same name
 // already exists
 func (c *awsClient) CheckRoleExists(roleName string) (bool, string, error) {
-	role, err := c.iamClient.GetRole(context.Background(),
+	role, err := c.iamClient.GetRole(c.context(),
 		&iam.GetRoleInput{
 			RoleName: aws.String(roleName),
 		})
@@ -1022,7 +1095,7 @@ func (c *awsClient) GetRoleByARN(roleARN string) (iamtypes.Role, error) {
 }
 
 func (c *awsClient) GetRoleByName(roleName string) (iamtypes.Role, error) {
-	roleOutput, err := c.iamClient.GetRole(context.Background(),
+	roleOutput, err := c.iamClient.GetRole(c.context(),
 		&iam.GetRoleInput{
 			RoleName: aws.String(roleName),
 		})
@@ -1034,7 +1107,7 @@ func (c *awsClient) GetRoleByName(roleName string) (iamtypes.Role, error) {
 
 // DescribeAvailabilityZones fetches the region's availability zones with type `availability-zone`
 func (c *awsClient) DescribeAvailabilityZones() ([]string, error) {
-	describeAvailabilityZonesOutput, err := c.ec2Client.DescribeAvailabilityZones(context.Background(),
+	describeAvailabilityZonesOutput, err := c.ec2Client.DescribeAvailabilityZones(c.context(),
 		&ec2.DescribeAvailabilityZonesInput{
 			Filters: []ec2types.Filter{
 				{
@@ -1056,7 +1129,7 @@ func (c *awsClient) DescribeAvailabilityZones() ([]string, error) {
 }
 
 func (c *awsClient) IsLocalAvailabilityZone(availabilityZoneName string) (bool, error) {
-	availabilityZones, err := c.ec2Client.DescribeAvailabilityZones(context.Background(),
+	availabilityZones, err := c.ec2Client.DescribeAvailabilityZones(c.context(),
 		&ec2.DescribeAvailabilityZonesInput{ZoneNames: []string{availabilityZoneName}})
 	if err != nil {
 		return false, err
@@ -1069,7 +1142,7 @@ func (c *awsClient) IsLocalAvailabilityZone(availabilityZoneName string) (bool,
 }
 
 func (c *awsClient) GetAvailabilityZoneType(availabilityZoneName string) (string, error) {
-	availabilityZones, err := c.ec2Client.DescribeAvailabilityZones(context.Background(),
+	availabilityZones, err := c.ec2Client.DescribeAvailabilityZones(c.context(),
 		&ec2.DescribeAvailabilityZonesInput{ZoneNames: []string{availabilityZoneName}})
 	if err != nil {
 		return "", err
@@ -1085,7 +1158,7 @@ func (c *awsClient) DetachRolePolicies(roleName string) error {
 	isTruncated := true
 	var marker *string
 	for isTruncated {
-		resp, err := c.iamClient.ListAttachedRolePolicies(context.Background(),
+		resp, err := c.iamClient.ListAttachedRolePolicies(c.context(),
 			&iam.ListAttachedRolePoliciesInput{
 				Marker:   marker,
 				RoleName: &roleName,
@@ -1107,7 +1180,7 @@ func (c *awsClient) DetachRolePolicies(roleName string) error {
 }
 
 func (c *awsClient) DetachRolePolicy(policyArn string, roleName string) error {
-	_, err := c.iamClient.DetachRolePolicy(context.Background(),
+	_, err := c.iamClient.DetachRolePolicy(c.context(),
 		&iam.DetachRolePolicyInput{PolicyArn: &policyArn, RoleName: &roleName})
 	if err != nil {
 		return err
@@ -1133,7 +1206,7 @@ const ReadOnlyAnonUserPolicyTemplate = `{
 }`
 
 func (c *awsClient) CreateS3Bucket(bucketName string, region string) error {
-	_, err := c.s3Client.HeadBucket(context.TODO(), &s3.HeadBucketInput{
+	_, err := c.s3Client.HeadBucket(c.context(), &s3.HeadBucketInput{
 		Bucket: aws.String(bucketName),
 	})
 	if err == nil {
@@ -1148,12 +1221,12 @@ func (c *awsClient) CreateS3Bucket(bucketName string, region string) error {
 			LocationConstraint: s3types.BucketLocationConstraint(region),
 		}
 	}
-	_, err = c.s3Client.CreateBucket(context.TODO(), bucketInput)
+	_, err = c.s3Client.CreateBucket(c.context(), bucketInput)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.s3Client.PutPublicAccessBlock(context.TODO(), &s3.PutPublicAccessBlockInput{
+	_, err = c.s3Client.PutPublicAccessBlock(c.context(), &s3.PutPublicAccessBlockInput{
 		Bucket: aws.String(bucketName),
 		PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{
 			BlockPublicAcls:       aws.Bool(true),
@@ -1166,7 +1239,7 @@ func (c *awsClient) CreateS3Bucket(bucketName string, region string) error {
 		return err
 	}
 
-	_, err = c.s3Client.PutBucketPolicy(context.TODO(), &s3.PutBucketPolicyInput{
+	_, err = c.s3Client.PutBucketPolicy(c.context(), &s3.PutBucketPolicyInput{
 		Bucket: aws.String(bucketName),
 		Policy: aws.String(fmt.Sprintf(ReadOnlyAnonUserPolicyTemplate, bucketName)),
 	})
@@ -1174,7 +1247,7 @@ func (c *awsClient) CreateS3Bucket(bucketName string, region string) error {
 		return err
 	}
 
-	_, err = c.s3Client.PutBucketTagging(context.TODO(), &s3.PutBucketTaggingInput{
+	_, err = c.s3Client.PutBucketTagging(c.context(), &s3.PutBucketTaggingInput{
 		Bucket: aws.String(bucketName),
 		Tagging: &s3types.Tagging{
 			TagSet: []s3types.Tag{
@@ -1193,7 +1266,7 @@ func (c *awsClient) CreateS3Bucket(bucketName string, region string) error {
 }
 
 func (c *awsClient) DeleteS3Bucket(bucketName string) error {
-	_, err := c.s3Client.HeadBucket(context.Background(),
+	_, err := c.s3Client.HeadBucket(c.context(),
 		&s3.HeadBucketInput{
 			Bucket: aws.String(bucketName),
 		})
@@ -1208,7 +1281,7 @@ func (c *awsClient) DeleteS3Bucket(bucketName string) error {
 	if err != nil {
 		return err
 	}
-	_, err = c.s3Client.DeleteBucket(context.Background(),
+	_, err = c.s3Client.DeleteBucket(c.context(),
 		&s3.DeleteBucketInput{
 			Bucket: aws.String(bucketName),
 		})
@@ -1219,7 +1292,7 @@ func (c *awsClient) DeleteS3Bucket(bucketName string) error {
 }
 
 func (c *awsClient) emptyS3Bucket(bucketName string) error {
-	objects, err := c.s3Client.ListObjects(context.Background(),
+	objects, err := c.s3Client.ListObjects(c.context(),
 		&s3.ListObjectsInput{
 			Bucket: aws.String(bucketName),
 		})
@@ -1227,7 +1300,7 @@ func (c *awsClient) emptyS3Bucket(bucketName string) error {
 		return err
 	}
 	for _, object := range (*objects).Contents {
-		_, err = c.s3Client.DeleteObject(context.Background(),
+		_, err = c.s3Client.DeleteObject(c.context(),
 			&s3.DeleteObjectInput{
 				Bucket: aws.String(bucketName),
 				Key:    object.Key,
@@ -1240,7 +1313,7 @@ func (c *awsClient) emptyS3Bucket(bucketName string) error {
 }
 
 func (c *awsClient) PutPublicReadObjectInS3Bucket(bucketName string, body io.ReadSeeker, key string) error {
-	_, err := c.s3Client.PutObject(context.Background(),
+	_, err := c.s3Client.PutObject(c.context(),
 		&s3.PutObjectInput{
 			Body:    body,
 			Bucket:  aws.String(bucketName),
@@ -1254,7 +1327,7 @@ func (c *awsClient) PutPublicReadObjectInS3Bucket(bucketName string, body io.Rea
 }
 
 func (c *awsClient) CreateSecretInSecretsManager(name string, secret string) (string, error) {
-	createSecretResponse, err := c.smClient.CreateSecret(context.Background(),
+	createSecretResponse, err := c.smClient.CreateSecret(c.context(),
 		&secretsmanager.CreateSecretInput{
 			Description:  aws.String(fmt.Sprintf("Secret for %s", name)),
 			Name:         aws.String(name),
@@ -1271,7 +1344,7 @@ func (c *awsClient) CreateSecretInSecretsManager(name string, secret string) (st
 }
 
 func (c *awsClient) DeleteSecretInSecretsManager(secretArn string) error {
-	_, err := c.smClient.DescribeSecret(context.Background(),
+	_, err := c.smClient.DescribeSecret(c.context(),
 		&secretsmanager.DescribeSecretInput{
 			SecretId: aws.String(secretArn),
 		})
@@ -1281,7 +1354,7 @@ func (c *awsClient) DeleteSecretInSecretsManager(secretArn string) error {
 			return nil
 		}
 	}
-	_, err = c.smClient.DeleteSecret(context.Background(),
+	_, err = c.smClient.DeleteSecret(c.context(),
 		&secretsmanager.DeleteSecretInput{
 			ForceDeleteWithoutRecovery: aws.Bool(true),
 			SecretId:                   aws.String(secretArn),
@@ -1301,7 +1374,7 @@ func (c *awsClient) GetSecurityGroupIds(vpcId string) ([]ec2types.SecurityGroup,
 			},
 		},
 	}
-	resp, err := c.ec2Client.DescribeSecurityGroups(context.Background(), describeSecurityGroupsInput)
+	resp, err := c.ec2Client.DescribeSecurityGroups(c.context(), describeSecurityGroupsInput)
 	if err != nil {
 		return []ec2types.SecurityGroup{}, err
 	}
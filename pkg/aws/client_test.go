@@ -3,6 +3,8 @@ package aws
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	gomock "go.uber.org/mock/gomock"
 
@@ -23,8 +25,12 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/openshift/rosa/assets"
+	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws/mocks"
+	"github.com/openshift/rosa/pkg/aws/profile"
+	regionflag "github.com/openshift/rosa/pkg/aws/region"
 	rosaTags "github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/test/matchers"
 )
 
@@ -752,3 +758,31 @@ var _ = Describe("Client", func() {
 		)
 	})
 })
+
+var _ = Describe("Configuration context", func() {
+	BeforeEach(func() {
+		dir := GinkgoT().TempDir()
+		GinkgoT().Setenv("OCM_CONFIG", filepath.Join(dir, "ocm.json"))
+		GinkgoT().Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "aws-config"))
+		GinkgoT().Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "aws-credentials"))
+		GinkgoT().Setenv("AWS_PROFILE", "")
+		GinkgoT().Setenv("AWS_REGION", "")
+		Expect(os.WriteFile(filepath.Join(dir, "aws-config"), []byte("[profile other]\n"), 0600)).To(Succeed())
+		DeferCleanup(func() {
+			profile.SetDefault("")
+			regionflag.SetDefault("")
+		})
+	})
+
+	It("Applies the AWS profile and region of the context before the OCM client is created", func() {
+		Expect(config.Save(&config.Config{AWSProfile: "stage", AWSRegion: "us-west-2"})).To(Succeed())
+		arguments.ApplyContextDefaults()
+
+		_, err := NewClient().
+			Logger(logrus.New()).
+			Region(regionflag.Region()).
+			BuildSession()
+		Expect(err).To(MatchError(ContainSubstring("stage")))
+		Expect(regionflag.Region()).To(Equal("us-west-2"))
+	})
+})
@@ -17,7 +17,6 @@ limitations under the License.
 package aws
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -115,12 +114,12 @@ func (c *awsClient) EnsureOsdCcsAdminUser(stackName string, adminUserName string
 
 func (c *awsClient) CreateStack(cfTemplateBody, stackName string) (bool, error) {
 	// Create cloudformation stack
-	_, err := c.cfClient.CreateStack(context.Background(), buildCreateStackInput(cfTemplateBody, stackName))
+	_, err := c.cfClient.CreateStack(c.context(), buildCreateStackInput(cfTemplateBody, stackName))
 	if err != nil {
 		return false, err
 	}
 
-	err = waitForStackCreateComplete(context.Background(), c.cfClient, stackName)
+	err = waitForStackCreateComplete(c.context(), c.cfClient, stackName)
 	if err != nil {
 		return false, err
 	}
@@ -129,7 +128,7 @@ func (c *awsClient) CreateStack(cfTemplateBody, stackName string) (bool, error)
 }
 
 func (c *awsClient) UpdateStack(cfTemplateBody, stackName string) error {
-	_, err := c.cfClient.UpdateStack(context.TODO(), buildUpdateStackInput(cfTemplateBody, stackName))
+	_, err := c.cfClient.UpdateStack(c.context(), buildUpdateStackInput(cfTemplateBody, stackName))
 	if err != nil {
 		var apiErr smithy.APIError
 		if errors.As(err, &apiErr) {
@@ -143,7 +142,7 @@ func (c *awsClient) UpdateStack(cfTemplateBody, stackName string) error {
 	}
 
 	// Wait for CloudFormation update to complete
-	err = waitForStackUpdateComplete(context.TODO(), c.cfClient, stackName)
+	err = waitForStackUpdateComplete(c.context(), c.cfClient, stackName)
 	if err != nil {
 		return err
 	}
@@ -152,7 +151,7 @@ func (c *awsClient) UpdateStack(cfTemplateBody, stackName string) error {
 }
 
 func (c *awsClient) CheckStackReadyOrNotExisting(stackName string) (stackReady bool, status *string, err error) {
-	stackList, err := c.cfClient.ListStacks(context.TODO(), &cloudformation.ListStacksInput{})
+	stackList, err := c.cfClient.ListStacks(c.context(), &cloudformation.ListStacksInput{})
 	if err != nil {
 		return false, nil, err
 	}
@@ -183,7 +182,7 @@ func (c *awsClient) DeleteOsdCcsAdminUser(stackName string) error {
 	}
 
 	// Delete cloudformation stack
-	_, err := c.cfClient.DeleteStack(context.Background(), deleteStackInput)
+	_, err := c.cfClient.DeleteStack(c.context(), deleteStackInput)
 	if err != nil {
 		var tokenExistsErr *cloudformationtypes.TokenAlreadyExistsException
 		if errors.As(err, &tokenExistsErr) {
@@ -193,7 +192,7 @@ func (c *awsClient) DeleteOsdCcsAdminUser(stackName string) error {
 	}
 
 	// Wait until cloudformation stack deletes
-	err = waitForStackDeleteComplete(context.Background(), c.cfClient, stackName)
+	err = waitForStackDeleteComplete(c.context(), c.cfClient, stackName)
 	if err != nil {
 		return err
 	}
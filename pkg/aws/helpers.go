@@ -3,7 +3,6 @@ package aws
 import (
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"reflect"
 	"regexp"
@@ -27,7 +26,9 @@ import (
 	client "github.com/openshift/rosa/pkg/aws/api_interface"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/constants"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
 	rprtr "github.com/openshift/rosa/pkg/reporter"
@@ -159,7 +160,7 @@ func getClientDetails(awsClient *awsClient) (*sts.GetCallerIdentityOutput, bool,
 		return nil, rootUser, err
 	}
 
-	user, err := awsClient.stsClient.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
+	user, err := awsClient.stsClient.GetCallerIdentity(awsClient.context(), &sts.GetCallerIdentityInput{})
 	if err != nil {
 		return nil, rootUser, err
 	}
@@ -183,17 +184,16 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 	// Get AWS region from env
 	awsRegionInUserConfig, err := GetRegion(arguments.GetRegion())
 	if err != nil {
-		reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err, "Error getting region")))
 	}
 	if awsRegionInUserConfig == "" {
 		reporter.Errorf("AWS Region not set")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if !helper.Contains(supportedRegions, awsRegionInUserConfig) {
 		reporter.Errorf("Unsupported region '%s', available regions: %s",
 			awsRegionInUserConfig, helper.SliceToSortedString(supportedRegions))
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 
 	// Create the AWS client:
@@ -203,8 +203,7 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 		UseLocalCredentials(useLocalCreds).
 		Build()
 	if err != nil {
-		reporter.Errorf("Error creating aws client for stack validation: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err, "Error creating aws client for stack validation")))
 	}
 	regionUsedForInit, err := client.GetClusterRegionTagForUser(AdminUserName)
 	if err != nil || regionUsedForInit == "" {
@@ -215,7 +214,7 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 		if !helper.Contains(supportedRegions, regionUsedForInit) {
 			reporter.Errorf("Unsupported region '%s', available regions: %s",
 				regionUsedForInit, helper.SliceToSortedString(supportedRegions))
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		// Create the AWS client with the region used in the init
 		//So we can check for the stack in that region
@@ -225,8 +224,7 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 			UseLocalCredentials(useLocalCreds).
 			Build()
 		if err != nil {
-			reporter.Errorf("Error creating aws client for stack validation: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err, "Error creating aws client for stack validation")))
 		}
 		return awsClient
 	}
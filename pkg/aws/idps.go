@@ -17,7 +17,6 @@ limitations under the License.
 package aws
 
 import (
-	"context"
 	"fmt"
 	"net/url"
 
@@ -48,7 +47,7 @@ func (c *awsClient) CreateOpenIDConnectProvider(providerURL string, thumbprint s
 			Value: aws.String(clusterID),
 		})
 	}
-	output, err := c.iamClient.CreateOpenIDConnectProvider(context.TODO(), &iam.CreateOpenIDConnectProviderInput{
+	output, err := c.iamClient.CreateOpenIDConnectProvider(c.context(), &iam.CreateOpenIDConnectProviderInput{
 		ClientIDList: []string{
 			OIDCClientIDOpenShift,
 			OIDCClientIDSTSAWS,
@@ -72,7 +71,7 @@ func (c *awsClient) HasOpenIDConnectProvider(issuerURL string, partition string,
 	providerURL := fmt.Sprintf("%s%s", parsedIssuerURL.Host, parsedIssuerURL.Path)
 
 	oidcProviderARN := GetOIDCProviderARN(partition, accountID, providerURL)
-	output, err := c.iamClient.GetOpenIDConnectProvider(context.TODO(), &iam.GetOpenIDConnectProviderInput{
+	output, err := c.iamClient.GetOpenIDConnectProvider(c.context(), &iam.GetOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(oidcProviderARN),
 	})
 	if err != nil {
@@ -88,7 +87,7 @@ func (c *awsClient) HasOpenIDConnectProvider(issuerURL string, partition string,
 }
 
 func (c *awsClient) DeleteOpenIDConnectProvider(oidcProviderARN string) error {
-	_, err := c.iamClient.DeleteOpenIDConnectProvider(context.TODO(), &iam.DeleteOpenIDConnectProviderInput{
+	_, err := c.iamClient.DeleteOpenIDConnectProvider(c.context(), &iam.DeleteOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(oidcProviderARN),
 	})
 	if err != nil {
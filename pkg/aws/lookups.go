@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	awserr "github.com/openshift-online/ocm-common/pkg/aws/errors"
+)
+
+const (
+	// maxConcurrentLookups is the maximum number of IAM lookups that are sent at the same time.
+	// IAM has a low account wide rate limit, so this is kept small.
+	maxConcurrentLookups = 8
+
+	// maxLookupThrottleRetries is the number of times that a lookup that is still throttled after
+	// the retries of the AWS client is retried by the pool.
+	maxLookupThrottleRetries = 3
+)
+
+// lookupThrottleDelay is the time that the pool waits before retrying a throttled lookup. It is a
+// variable so that tests can reduce it.
+var lookupThrottleDelay = minThrottleDelay
+
+// lookupAll calls the lookup function for each of the items using a bounded pool of workers, and
+// returns the results in the same order as the items. Lookups that fail because of throttling even
+// after the retries of the AWS client make the pool halve the number of concurrent lookups, and are
+// retried after a delay. When lookups fail the error of the first failed item, in the order of the
+// items, is returned, and the items that haven't been started yet are skipped.
+func lookupAll[T, R any](ctx context.Context, items []T, lookup func(item T) (R, error)) ([]R, error) {
+	results := make([]R, len(items))
+	errs := make([]error, len(items))
+	if len(items) == 0 {
+		return results, nil
+	}
+	workers := min(len(items), maxConcurrentLookups)
+	pool := newLookupPool(workers)
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				for attempt := 0; ; attempt++ {
+					pool.acquire()
+					results[index], errs[index] = lookup(items[index])
+					throttled := awserr.IsThrottle(errs[index])
+					pool.release(throttled)
+					if !throttled || attempt == maxLookupThrottleRetries || !sleep(ctx, lookupThrottleDelay) {
+						break
+					}
+				}
+				if errs[index] != nil {
+					pool.fail()
+				}
+			}
+		}()
+	}
+	// Items are started in order, so when an item fails all the previous ones have been started
+	// and the returned error doesn't depend on how the workers are scheduled:
+	for index := range items {
+		if pool.failed() {
+			break
+		}
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// sleep waits for the given time, and returns false if the context is done before.
+func sleep(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// lookupPool limits the number of lookups that run at the same time.
+type lookupPool struct {
+	lock    sync.Mutex
+	cond    *sync.Cond
+	limit   int
+	running int
+	err     bool
+}
+
+func newLookupPool(limit int) *lookupPool {
+	pool := &lookupPool{limit: limit}
+	pool.cond = sync.NewCond(&pool.lock)
+	return pool
+}
+
+// acquire waits till the number of running lookups is below the limit.
+func (p *lookupPool) acquire() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for p.running >= p.limit {
+		p.cond.Wait()
+	}
+	p.running++
+}
+
+// release marks a lookup as finished. When it was throttled the limit is halved.
+func (p *lookupPool) release(throttled bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.running--
+	if throttled && p.limit > 1 {
+		p.limit /= 2
+	}
+	p.cond.Broadcast()
+}
+
+func (p *lookupPool) fail() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.err = true
+}
+
+func (p *lookupPool) failed() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.err
+}
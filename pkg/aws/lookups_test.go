@@ -0,0 +1,286 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws/mocks"
+	"github.com/openshift/rosa/pkg/aws/tags"
+)
+
+var throttlingError = &smithy.GenericAPIError{
+	Code:    "Throttling",
+	Message: "Rate exceeded",
+}
+
+// concurrency tracks the number of lookups that run at the same time.
+type concurrency struct {
+	lock    sync.Mutex
+	running int
+	max     int
+}
+
+func (c *concurrency) start() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.running++
+	if c.running > c.max {
+		c.max = c.running
+	}
+}
+
+func (c *concurrency) stop() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.running--
+}
+
+func items(count int) []int {
+	result := make([]int, count)
+	for i := range result {
+		result[i] = i
+	}
+	return result
+}
+
+var _ = Describe("lookupAll", func() {
+	BeforeEach(func() {
+		previous := lookupThrottleDelay
+		lookupThrottleDelay = time.Millisecond
+		DeferCleanup(func() {
+			lookupThrottleDelay = previous
+		})
+	})
+
+	It("Returns the results in the order of the items with bounded concurrency", func() {
+		tracker := &concurrency{}
+		results, err := lookupAll(context.Background(), items(50), func(item int) (string, error) {
+			tracker.start()
+			defer tracker.stop()
+			time.Sleep(time.Duration(rand.Intn(3)) * time.Millisecond)
+			return fmt.Sprintf("item-%d", item), nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(50))
+		for i, result := range results {
+			Expect(result).To(Equal(fmt.Sprintf("item-%d", i)))
+		}
+		Expect(tracker.max).To(BeNumerically("<=", maxConcurrentLookups))
+		Expect(tracker.max).To(BeNumerically(">", 1))
+	})
+
+	It("Returns nothing for no items", func() {
+		results, err := lookupAll(context.Background(), []int{}, func(item int) (int, error) {
+			return item, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(BeEmpty())
+	})
+
+	It("Returns the error of the first failed item", func() {
+		for i := 0; i < 20; i++ {
+			_, err := lookupAll(context.Background(), items(50), func(item int) (int, error) {
+				if item == 30 {
+					return 0, fmt.Errorf("Failed item %d", item)
+				}
+				time.Sleep(time.Duration(rand.Intn(2)) * time.Millisecond)
+				if item == 10 {
+					return 0, fmt.Errorf("Failed item %d", item)
+				}
+				return item, nil
+			})
+			Expect(err).To(MatchError("Failed item 10"))
+		}
+	})
+
+	It("Retries throttled lookups with less concurrency", func() {
+		tracker := &concurrency{}
+		var lock sync.Mutex
+		throttled := false
+		maxAfterThrottle := 0
+		results, err := lookupAll(context.Background(), items(40), func(item int) (int, error) {
+			tracker.start()
+			defer tracker.stop()
+			lock.Lock()
+			if throttled {
+				// Lookups started after the throttling wait for the previous ones:
+				tracker.lock.Lock()
+				maxAfterThrottle = max(maxAfterThrottle, tracker.running)
+				tracker.lock.Unlock()
+			}
+			lock.Unlock()
+			time.Sleep(time.Millisecond)
+			lock.Lock()
+			defer lock.Unlock()
+			if item == 5 && !throttled {
+				throttled = true
+				return 0, throttlingError
+			}
+			return item * 2, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results[5]).To(Equal(10))
+		Expect(maxAfterThrottle).To(BeNumerically(">", 0))
+		Expect(maxAfterThrottle).To(BeNumerically("<=", maxConcurrentLookups/2))
+	})
+
+	It("Fails when lookups are still throttled after the retries", func() {
+		attempts := 0
+		_, err := lookupAll(context.Background(), items(1), func(item int) (int, error) {
+			attempts++
+			return 0, throttlingError
+		})
+		var apiErr smithy.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.ErrorCode()).To(Equal("Throttling"))
+		Expect(attempts).To(Equal(maxLookupThrottleRetries + 1))
+	})
+})
+
+var _ = Describe("Concurrent IAM lookups", func() {
+	var (
+		client     awsClient
+		mockIamAPI *mocks.MockIamApiClient
+		mockCtrl   *gomock.Controller
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockIamAPI = mocks.NewMockIamApiClient(mockCtrl)
+		client = awsClient{
+			iamClient: mockIamAPI,
+		}
+		previous := lookupThrottleDelay
+		lookupThrottleDelay = time.Millisecond
+		DeferCleanup(func() {
+			lookupThrottleDelay = previous
+		})
+	})
+
+	roleTags := func(_ context.Context, input *iam.ListRoleTagsInput,
+		_ ...func(*iam.Options)) (*iam.ListRoleTagsOutput, error) {
+		time.Sleep(time.Duration(rand.Intn(2)) * time.Millisecond)
+		return &iam.ListRoleTagsOutput{
+			Tags: []iamtypes.Tag{
+				{
+					Key:   aws.String(tags.OperatorName),
+					Value: input.RoleName,
+				},
+			},
+		}, nil
+	}
+
+	It("Lists operator roles in the order returned by IAM", func() {
+		var roles []iamtypes.Role
+		for i := 0; i < 30; i++ {
+			prefix := "first"
+			if i%2 == 1 {
+				prefix = "second"
+			}
+			roles = append(roles, iamtypes.Role{
+				RoleName: aws.String(fmt.Sprintf("%s-openshift-role-%02d", prefix, i)),
+				Arn:      aws.String(fmt.Sprintf("arn:aws:iam::123456789012:role/%s-openshift-role-%02d", prefix, i)),
+			})
+		}
+		mockIamAPI.EXPECT().ListRoles(gomock.Any(), gomock.Any()).Return(&iam.ListRolesOutput{
+			Roles: roles,
+		}, nil)
+		mockIamAPI.EXPECT().ListRoleTags(gomock.Any(), gomock.Any()).DoAndReturn(roleTags).Times(30)
+		mockIamAPI.EXPECT().ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(
+			&iam.ListAttachedRolePoliciesOutput{}, nil).Times(30)
+
+		operatorRoles, err := client.ListOperatorRoles("", "", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operatorRoles).To(HaveLen(2))
+		for prefix, offset := range map[string]int{"first": 0, "second": 1} {
+			Expect(operatorRoles[prefix]).To(HaveLen(15))
+			for i, role := range operatorRoles[prefix] {
+				name := fmt.Sprintf("%s-openshift-role-%02d", prefix, 2*i+offset)
+				Expect(role.RoleName).To(Equal(name))
+				Expect(role.OperatorName).To(Equal(name))
+			}
+		}
+	})
+
+	It("Gets the policies of each operator role, retrying throttled lookups", func() {
+		var roles []string
+		for i := 0; i < 20; i++ {
+			roles = append(roles, fmt.Sprintf("prefix-openshift-role-%02d", i))
+		}
+		var lock sync.Mutex
+		throttled := false
+		// The throttled lookup is retried from the start:
+		mockIamAPI.EXPECT().ListRoleTags(gomock.Any(), gomock.Any()).DoAndReturn(roleTags).Times(21)
+		mockIamAPI.EXPECT().ListAttachedRolePolicies(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, input *iam.ListAttachedRolePoliciesInput,
+				_ ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				if aws.ToString(input.RoleName) == roles[7] && !throttled {
+					throttled = true
+					return nil, throttlingError
+				}
+				return &iam.ListAttachedRolePoliciesOutput{
+					AttachedPolicies: []iamtypes.AttachedPolicy{
+						{
+							PolicyArn: aws.String("arn:aws:iam::123456789012:policy/" + *input.RoleName),
+						},
+					},
+				}, nil
+			}).Times(21)
+		mockIamAPI.EXPECT().ListPolicyTags(gomock.Any(), gomock.Any()).Return(
+			&iam.ListPolicyTagsOutput{}, nil).Times(20)
+
+		policies, excludedPolicies, err := client.GetOperatorRolePolicies(roles)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policies).To(HaveLen(20))
+		for _, role := range roles {
+			Expect(policies[role]).To(BeEmpty())
+			Expect(excludedPolicies[role]).To(ConsistOf("arn:aws:iam::123456789012:policy/" + role))
+		}
+	})
+
+	It("Gets the policies of each account role, ignoring missing roles", func() {
+		roles := []string{"prefix-Installer-Role", "prefix-Support-Role", "prefix-Worker-Role"}
+		mockIamAPI.EXPECT().ListAttachedRolePolicies(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, input *iam.ListAttachedRolePoliciesInput,
+				_ ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error) {
+				if aws.ToString(input.RoleName) == roles[1] {
+					return nil, &iamtypes.NoSuchEntityException{Message: aws.String("Not found")}
+				}
+				return &iam.ListAttachedRolePoliciesOutput{}, nil
+			}).Times(3)
+		mockIamAPI.EXPECT().ListRolePolicies(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, input *iam.ListRolePoliciesInput,
+				_ ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error) {
+				return &iam.ListRolePoliciesOutput{
+					PolicyNames: []string{aws.ToString(input.RoleName) + "-inline"},
+				}, nil
+			}).Times(2)
+
+		policies, _, err := client.GetAccountRolePolicies(roles, "prefix")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policies).To(HaveLen(3))
+		Expect(policies[roles[0]]).To(ConsistOf(PolicyDetail{
+			PolicyName: roles[0] + "-inline",
+			PolicyType: Inline,
+		}))
+		Expect(policies[roles[1]]).To(BeEmpty())
+		Expect(policies[roles[2]]).To(ConsistOf(PolicyDetail{
+			PolicyName: roles[2] + "-inline",
+			PolicyType: Inline,
+		}))
+	})
+})
@@ -1,7 +1,6 @@
 package aws
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -57,7 +56,7 @@ func (c *awsClient) ValidateSCP(target *string, policies map[string]*cmv1.AWSSTS
 			}
 		}
 	} else {
-		targetIAMOutput, err := c.iamClient.GetUser(context.Background(), &iam.GetUserInput{UserName: target})
+		targetIAMOutput, err := c.iamClient.GetUser(c.context(), &iam.GetUserInput{UserName: target})
 		if err != nil {
 			return false, fmt.Errorf("iamClient.GetUser: %v\n"+
 				"To reset the '%s' account, run 'rosa init --delete-stack' and try again", *target, err)
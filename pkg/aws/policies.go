@@ -157,7 +157,7 @@ var roleTypeMap = map[string]string{
 
 func (c *awsClient) EnsureRole(name string, policy string, permissionsBoundary string,
 	version string, tagList map[string]string, path string, managedPolicies bool) (string, error) {
-	output, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	output, err := c.iamClient.GetRole(c.context(), &iam.GetRoleInput{
 		RoleName: aws.String(name),
 	})
 	if err != nil {
@@ -181,12 +181,12 @@ func (c *awsClient) EnsureRole(name string, policy string, permissionsBoundary s
 	}
 
 	if permissionsBoundary != "" {
-		_, err = c.iamClient.PutRolePermissionsBoundary(context.Background(), &iam.PutRolePermissionsBoundaryInput{
+		_, err = c.iamClient.PutRolePermissionsBoundary(c.context(), &iam.PutRolePermissionsBoundaryInput{
 			RoleName:            aws.String(name),
 			PermissionsBoundary: aws.String(permissionsBoundary),
 		})
 	} else if output.Role.PermissionsBoundary != nil {
-		_, err = c.iamClient.DeleteRolePermissionsBoundary(context.Background(),
+		_, err = c.iamClient.DeleteRolePermissionsBoundary(c.context(),
 			&iam.DeleteRolePermissionsBoundaryInput{
 				RoleName: aws.String(name),
 			})
@@ -209,7 +209,7 @@ func (c *awsClient) EnsureRole(name string, policy string, permissionsBoundary s
 	}
 
 	if needsUpdate || !isCompatible {
-		_, err = c.iamClient.UpdateAssumeRolePolicy(context.Background(), &iam.UpdateAssumeRolePolicyInput{
+		_, err = c.iamClient.UpdateAssumeRolePolicy(c.context(), &iam.UpdateAssumeRolePolicyInput{
 			RoleName:       aws.String(name),
 			PolicyDocument: aws.String(policy),
 		})
@@ -217,7 +217,7 @@ func (c *awsClient) EnsureRole(name string, policy string, permissionsBoundary s
 			return roleArn, err
 		}
 
-		_, err = c.iamClient.TagRole(context.Background(), &iam.TagRoleInput{
+		_, err = c.iamClient.TagRole(c.context(), &iam.TagRoleInput{
 			RoleName: aws.String(name),
 			Tags:     getTags(tagList),
 		})
@@ -230,7 +230,7 @@ func (c *awsClient) EnsureRole(name string, policy string, permissionsBoundary s
 }
 
 func (c *awsClient) ValidateRoleNameAvailable(name string) (err error) {
-	_, err = c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	_, err = c.iamClient.GetRole(c.context(), &iam.GetRoleInput{
 		RoleName: aws.String(name),
 	})
 	if err == nil {
@@ -264,7 +264,7 @@ func (c *awsClient) createRole(name string, policy string, permissionsBoundary s
 	if permissionsBoundary != "" {
 		createRoleInput.PermissionsBoundary = aws.String(permissionsBoundary)
 	}
-	output, err := c.iamClient.CreateRole(context.Background(), createRoleInput)
+	output, err := c.iamClient.CreateRole(c.context(), createRoleInput)
 	if err != nil {
 		if awserr.IsEntityAlreadyExistsException(err) {
 			return "", nil
@@ -279,7 +279,7 @@ func (c *awsClient) isRoleCompatible(name string, version string) (bool, error)
 	if version == "" {
 		return true, nil
 	}
-	output, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+	output, err := c.iamClient.ListRoleTags(c.context(), &iam.ListRoleTagsInput{
 		RoleName: aws.String(name),
 	})
 	if err != nil {
@@ -290,7 +290,7 @@ func (c *awsClient) isRoleCompatible(name string, version string) (bool, error)
 }
 
 func (c *awsClient) PutRolePolicy(roleName string, policyName string, policy string) error {
-	_, err := c.iamClient.PutRolePolicy(context.Background(), &iam.PutRolePolicyInput{
+	_, err := c.iamClient.PutRolePolicy(c.context(), &iam.PutRolePolicyInput{
 		RoleName:       aws.String(roleName),
 		PolicyName:     aws.String(policyName),
 		PolicyDocument: aws.String(policy),
@@ -347,7 +347,7 @@ func (c *awsClient) ensurePolicyHelper(policyArn string, document string,
 			return policyArn, err
 		}
 
-		_, err = c.iamClient.CreatePolicyVersion(context.Background(), &iam.CreatePolicyVersionInput{
+		_, err = c.iamClient.CreatePolicyVersion(c.context(), &iam.CreatePolicyVersionInput{
 			PolicyArn:      aws.String(policyArn),
 			PolicyDocument: aws.String(document),
 			SetAsDefault:   true,
@@ -356,7 +356,7 @@ func (c *awsClient) ensurePolicyHelper(policyArn string, document string,
 			return policyArn, err
 		}
 
-		_, err = c.iamClient.TagPolicy(context.Background(), &iam.TagPolicyInput{
+		_, err = c.iamClient.TagPolicy(c.context(), &iam.TagPolicyInput{
 			PolicyArn: aws.String(policyArn),
 			Tags:      getTags(tagList),
 		})
@@ -369,7 +369,7 @@ func (c *awsClient) ensurePolicyHelper(policyArn string, document string,
 }
 
 func (c *awsClient) IsPolicyExists(policyArn string) (*iam.GetPolicyOutput, error) {
-	output, err := c.iamClient.GetPolicy(context.Background(),
+	output, err := c.iamClient.GetPolicy(c.context(),
 		&iam.GetPolicyInput{
 			PolicyArn: aws.String(policyArn),
 		})
@@ -377,7 +377,7 @@ func (c *awsClient) IsPolicyExists(policyArn string) (*iam.GetPolicyOutput, erro
 }
 
 func (c *awsClient) IsRolePolicyExists(roleName string, policyName string) (*iam.GetRolePolicyOutput, error) {
-	output, err := c.iamClient.GetRolePolicy(context.Background(), &iam.GetRolePolicyInput{
+	output, err := c.iamClient.GetRolePolicy(c.context(), &iam.GetRolePolicyInput{
 		PolicyName: aws.String(policyName),
 		RoleName:   aws.String(roleName),
 	})
@@ -399,7 +399,7 @@ func (c *awsClient) createPolicy(policyArn string, document string, tagList map[
 		createPolicyInput.Path = aws.String(path)
 	}
 
-	output, err := c.iamClient.CreatePolicy(context.Background(), createPolicyInput)
+	output, err := c.iamClient.CreatePolicy(c.context(), createPolicyInput)
 
 	if err != nil {
 		return "", err
@@ -412,7 +412,7 @@ func (c *awsClient) IsPolicyCompatible(policyArn string, version string) (bool,
 	if version == "" {
 		return true, nil
 	}
-	output, err := c.iamClient.ListPolicyTags(context.Background(), &iam.ListPolicyTagsInput{
+	output, err := c.iamClient.ListPolicyTags(c.context(), &iam.ListPolicyTagsInput{
 		PolicyArn: aws.String(policyArn),
 	})
 	if err != nil {
@@ -455,7 +455,7 @@ func (c *awsClient) hasCompatibleMajorMinorVersionTags(iamTags []iamtypes.Tag, v
 }
 
 func (c *awsClient) AttachRolePolicy(reporter *reporter.Object, roleName string, policyARN string) error {
-	_, err := c.iamClient.AttachRolePolicy(context.Background(), &iam.AttachRolePolicyInput{
+	_, err := c.iamClient.AttachRolePolicy(c.context(), &iam.AttachRolePolicyInput{
 		RoleName:  aws.String(roleName),
 		PolicyArn: aws.String(policyARN),
 	})
@@ -503,7 +503,7 @@ func (c *awsClient) FindRoleARNsClassic(roleType string, version string) ([]stri
 		if !strings.Contains(aws.ToString(role.RoleName), AccountRoles[roleType].Name) {
 			continue
 		}
-		listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+		listRoleTagsOutput, err := c.iamClient.ListRoleTags(c.context(), &iam.ListRoleTagsInput{
 			RoleName: role.RoleName,
 		})
 		if err != nil {
@@ -532,7 +532,7 @@ func (c *awsClient) FindRoleARNsHostedCp(roleType string, version string) ([]str
 		if !strings.Contains(aws.ToString(role.RoleName), AccountRoles[roleType].Name) {
 			continue
 		}
-		listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+		listRoleTagsOutput, err := c.iamClient.ListRoleTags(c.context(), &iam.ListRoleTagsInput{
 			RoleName: role.RoleName,
 		})
 		if err != nil {
@@ -554,7 +554,7 @@ func (c *awsClient) FindRoleARNsHostedCp(roleType string, version string) ([]str
 // FIXME: refactor similar calls to use this instead
 func (c *awsClient) ValidateAccountRoleVersionCompatibility(
 	roleName string, roleType string, minVersion string) (bool, error) {
-	listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+	listRoleTagsOutput, err := c.iamClient.ListRoleTags(c.context(), &iam.ListRoleTagsInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
@@ -648,7 +648,7 @@ func (c *awsClient) ListRoles() ([]iamtypes.Role, error) {
 	roles := []iamtypes.Role{}
 	paginator := iam.NewListRolesPaginator(c.iamClient, &iam.ListRolesInput{})
 	for paginator.HasMorePages() {
-		output, err := paginator.NextPage(context.TODO())
+		output, err := paginator.NextPage(c.context())
 		if err != nil {
 			return nil, err
 		}
@@ -665,12 +665,12 @@ func (c *awsClient) FindPolicyARN(operator Operator, version string) (string, er
 		Scope: iamtypes.PolicyScopeTypeLocal,
 	})
 	for paginator.HasMorePages() {
-		output, err := paginator.NextPage(context.TODO())
+		output, err := paginator.NextPage(c.context())
 		if err != nil {
 			return "", err
 		}
 		for _, policy := range output.Policies {
-			listPolicyTagsOutput, err := c.iamClient.ListPolicyTags(context.TODO(), &iam.ListPolicyTagsInput{
+			listPolicyTagsOutput, err := c.iamClient.ListPolicyTags(c.context(), &iam.ListPolicyTagsInput{
 				PolicyArn: policy.Arn,
 			})
 			if err != nil {
@@ -727,7 +727,7 @@ func IsOCMRole(roleName *string) bool {
 // IsUserRole checks the role tags in addition to the role name, because the word 'user' is common
 func (c *awsClient) IsUserRole(roleName *string) (bool, error) {
 	if strings.Contains(aws.ToString(roleName), OCMUserRole) {
-		roleTags, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+		roleTags, err := c.iamClient.ListRoleTags(c.context(), &iam.ListRoleTagsInput{
 			RoleName: roleName,
 		})
 		if err != nil {
@@ -778,7 +778,7 @@ func (c *awsClient) ListOCMRoles() ([]Role, error) {
 			ocmRole.RoleName = aws.ToString(role.RoleName)
 			ocmRole.RoleARN = aws.ToString(role.Arn)
 
-			roleTags, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+			roleTags, err := c.iamClient.ListRoleTags(c.context(), &iam.ListRoleTagsInput{
 				RoleName: role.RoleName,
 			})
 			if err != nil {
@@ -823,7 +823,7 @@ func (c *awsClient) mapToAccountRole(version string, role iamtypes.Role) (Role,
 
 	accountRole := Role{}
 
-	listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+	listRoleTagsOutput, err := c.iamClient.ListRoleTags(c.context(), &iam.ListRoleTagsInput{
 		RoleName: role.RoleName,
 	})
 	if err != nil {
@@ -854,16 +854,22 @@ func (c *awsClient) mapToAccountRole(version string, role iamtypes.Role) (Role,
 }
 
 func (c *awsClient) mapToAccountRoles(version string, roles []iamtypes.Role) ([]Role, error) {
-	emptyRole := Role{}
-	var accountRoles []Role
+	var candidates []iamtypes.Role
 	for _, role := range roles {
-		if !checkIfAccountRole(role.RoleName) {
-			continue
-		}
-		accountRole, err := c.mapToAccountRole(version, role)
-		if err != nil {
-			return accountRoles, err
+		if checkIfAccountRole(role.RoleName) {
+			candidates = append(candidates, role)
 		}
+	}
+	mapped, err := lookupAll(c.context(), candidates, func(role iamtypes.Role) (Role, error) {
+		return c.mapToAccountRole(version, role)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	emptyRole := Role{}
+	var accountRoles []Role
+	for _, accountRole := range mapped {
 		if accountRole == emptyRole {
 			continue
 		}
@@ -894,100 +900,29 @@ func (c *awsClient) ListOperatorRoles(targetVersion string,
 		return operatorMap, err
 	}
 	prefixOperatorRoleRE := regexp.MustCompile(`(?i)(?P<Prefix>[\w+=,.@-]+)-(openshift|kube-system)`)
+	prefixIndex := prefixOperatorRoleRE.SubexpIndex("Prefix")
+	var operatorRoles []iamtypes.Role
+	var prefixes []string
 	for _, role := range roles {
-		operatorRole := OperatorRoleDetail{}
 		matches := prefixOperatorRoleRE.FindStringSubmatch(*role.RoleName)
 		if len(matches) == 0 {
 			continue
 		}
-		prefixIndex := prefixOperatorRoleRE.SubexpIndex("Prefix")
-		foundPrefix := strings.ToLower(matches[prefixIndex])
-		if _, mapOk := operatorMap[foundPrefix]; !mapOk {
-			operatorMap[foundPrefix] = []OperatorRoleDetail{}
-		}
-		listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(),
-			&iam.ListRoleTagsInput{
-				RoleName: role.RoleName,
-			})
-		if err != nil {
-			return operatorMap, err
-		}
-		skip := false
-		for _, tag := range listRoleTagsOutput.Tags {
-			switch aws.ToString(tag.Key) {
-			case common.ManagedPolicies:
-				if aws.ToString(tag.Value) == tags.True {
-					operatorRole.ManagedPolicy = true
-				}
-			case tags.ClusterID:
-				tagValue := aws.ToString(tag.Value)
-				if targetClusterId != "" && tagValue != targetClusterId {
-					skip = true
-				}
-				operatorRole.ClusterID = tagValue
-			case tags.OperatorName:
-				operatorRole.OperatorName = *tag.Value
-
-			case tags.OperatorNamespace:
-				operatorRole.OperatorNamespace = *tag.Value
-			}
-		}
-
-		attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(context.Background(),
-			&iam.ListAttachedRolePoliciesInput{
-				RoleName: role.RoleName,
-			})
-		if err != nil {
-			return operatorMap, err
-		}
-
-		attachedPolicies := []string{}
-
-		for _, policy := range attachedPoliciesOutput.AttachedPolicies {
-			attachedPolicies = append(attachedPolicies, (aws.ToString(policy.PolicyName)))
-		}
-		operatorRole.AttachedPolicies = attachedPolicies
-
-		if skip {
-			continue
-		}
-
-		if operatorRole.ManagedPolicy || len(attachedPoliciesOutput.AttachedPolicies) == 0 {
-			operatorRole.RoleName = aws.ToString(role.RoleName)
-			operatorRole.RoleARN = aws.ToString(role.Arn)
-			operatorMap[foundPrefix] = append(operatorMap[foundPrefix], operatorRole)
-			continue
-		}
+		operatorRoles = append(operatorRoles, role)
+		prefixes = append(prefixes, strings.ToLower(matches[prefixIndex]))
+	}
 
-		for _, policy := range attachedPoliciesOutput.AttachedPolicies {
-			listPolicyTagsOutput, err := c.iamClient.ListPolicyTags(context.Background(),
-				&iam.ListPolicyTagsInput{
-					PolicyArn: policy.PolicyArn,
-				})
-			if err != nil {
-				return operatorMap, err
-			}
-			isTagged := false
-			skip := false
-			for _, tag := range listPolicyTagsOutput.Tags {
-				switch aws.ToString(tag.Key) {
-				case common.OpenShiftVersion:
-					tagValue := aws.ToString(tag.Value)
-					if targetVersion != "" && tagValue != targetVersion {
-						skip = true
-						break
-					}
-					isTagged = true
-					operatorRole.Version = tagValue
-				}
-			}
-			if isTagged && !skip {
-				operatorRole.RoleName = aws.ToString(role.RoleName)
-				operatorRole.RoleARN = aws.ToString(role.Arn)
-				operatorMap[foundPrefix] = append(operatorMap[foundPrefix], operatorRole)
-			}
-		}
+	details, err := lookupAll(c.context(), operatorRoles,
+		func(role iamtypes.Role) ([]OperatorRoleDetail, error) {
+			return c.operatorRoleDetails(role, targetVersion, targetClusterId)
+		})
+	if err != nil {
+		return operatorMap, err
 	}
+	for i, prefix := range prefixes {
+		operatorMap[prefix] = append(operatorMap[prefix], details[i]...)
+	}
+
 	emptyListKeys := []string{}
 	for key, list := range operatorMap {
 		if len(list) == 0 {
@@ -1009,6 +944,97 @@ func (c *awsClient) ListOperatorRoles(targetVersion string,
 	return operatorMap, nil
 }
 
+// operatorRoleDetails returns the details of the given operator role for each of its policies
+// that matches the target version. It returns an empty list when the role belongs to a cluster
+// other than the target one.
+func (c *awsClient) operatorRoleDetails(role iamtypes.Role, targetVersion string,
+	targetClusterId string) ([]OperatorRoleDetail, error) {
+	operatorRole := OperatorRoleDetail{}
+	result := []OperatorRoleDetail{}
+	listRoleTagsOutput, err := c.iamClient.ListRoleTags(c.context(),
+		&iam.ListRoleTagsInput{
+			RoleName: role.RoleName,
+		})
+	if err != nil {
+		return result, err
+	}
+	skip := false
+	for _, tag := range listRoleTagsOutput.Tags {
+		switch aws.ToString(tag.Key) {
+		case common.ManagedPolicies:
+			if aws.ToString(tag.Value) == tags.True {
+				operatorRole.ManagedPolicy = true
+			}
+		case tags.ClusterID:
+			tagValue := aws.ToString(tag.Value)
+			if targetClusterId != "" && tagValue != targetClusterId {
+				skip = true
+			}
+			operatorRole.ClusterID = tagValue
+		case tags.OperatorName:
+			operatorRole.OperatorName = *tag.Value
+
+		case tags.OperatorNamespace:
+			operatorRole.OperatorNamespace = *tag.Value
+		}
+	}
+
+	attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(c.context(),
+		&iam.ListAttachedRolePoliciesInput{
+			RoleName: role.RoleName,
+		})
+	if err != nil {
+		return result, err
+	}
+
+	attachedPolicies := []string{}
+
+	for _, policy := range attachedPoliciesOutput.AttachedPolicies {
+		attachedPolicies = append(attachedPolicies, (aws.ToString(policy.PolicyName)))
+	}
+	operatorRole.AttachedPolicies = attachedPolicies
+
+	if skip {
+		return result, nil
+	}
+
+	if operatorRole.ManagedPolicy || len(attachedPoliciesOutput.AttachedPolicies) == 0 {
+		operatorRole.RoleName = aws.ToString(role.RoleName)
+		operatorRole.RoleARN = aws.ToString(role.Arn)
+		return append(result, operatorRole), nil
+	}
+
+	for _, policy := range attachedPoliciesOutput.AttachedPolicies {
+		listPolicyTagsOutput, err := c.iamClient.ListPolicyTags(c.context(),
+			&iam.ListPolicyTagsInput{
+				PolicyArn: policy.PolicyArn,
+			})
+		if err != nil {
+			return result, err
+		}
+		isTagged := false
+		skip := false
+		for _, tag := range listPolicyTagsOutput.Tags {
+			switch aws.ToString(tag.Key) {
+			case common.OpenShiftVersion:
+				tagValue := aws.ToString(tag.Value)
+				if targetVersion != "" && tagValue != targetVersion {
+					skip = true
+					break
+				}
+				isTagged = true
+				operatorRole.Version = tagValue
+			}
+		}
+		if isTagged && !skip {
+			operatorRole.RoleName = aws.ToString(role.RoleName)
+			operatorRole.RoleARN = aws.ToString(role.Arn)
+			result = append(result, operatorRole)
+		}
+	}
+	return result, nil
+}
+
 // Check if it is one of the ROSA account roles
 func checkIfAccountRole(roleName *string) bool {
 	for _, prefix := range AccountRoles {
@@ -1031,11 +1057,11 @@ func checkIfROSAOperatorRole(roleName *string, credRequest map[string]*cmv1.STSO
 
 func (c *awsClient) DeleteOperatorRole(roleName string, managedPolicies bool) error {
 	role := aws.String(roleName)
-	tagFilter, err := getOperatorRolePolicyTags(c.iamClient, roleName)
+	tagFilter, err := getOperatorRolePolicyTags(c.context(), c.iamClient, roleName)
 	if err != nil {
 		return err
 	}
-	policies, _, err := getAttachedPolicies(c.iamClient, roleName, tagFilter)
+	policies, _, err := getAttachedPolicies(c.context(), c.iamClient, roleName, tagFilter)
 	if err != nil {
 		return err
 	}
@@ -1064,7 +1090,7 @@ func (c *awsClient) DeleteOperatorRole(roleName string, managedPolicies bool) er
 }
 
 func (c *awsClient) DeleteRole(role string) error {
-	_, err := c.iamClient.DeleteRole(context.Background(),
+	_, err := c.iamClient.DeleteRole(c.context(),
 		&iam.DeleteRoleInput{RoleName: aws.String(role)})
 	if err != nil {
 		if err != nil {
@@ -1080,7 +1106,7 @@ func (c *awsClient) DeleteRole(role string) error {
 
 func (c *awsClient) GetInstanceProfilesForRole(r string) ([]string, error) {
 	instanceProfiles := []string{}
-	profiles, err := c.iamClient.ListInstanceProfilesForRole(context.Background(),
+	profiles, err := c.iamClient.ListInstanceProfilesForRole(c.context(),
 		&iam.ListInstanceProfilesForRoleInput{
 			RoleName: aws.String(r),
 		})
@@ -1102,7 +1128,7 @@ func (c *awsClient) DeleteAccountRole(roleName string, prefix string, managedPol
 	if err != nil {
 		return err
 	}
-	policyMap, _, err := getAttachedPolicies(c.iamClient, roleName, getAcctRolePolicyTags(prefix))
+	policyMap, _, err := getAttachedPolicies(c.context(), c.iamClient, roleName, getAcctRolePolicyTags(prefix))
 	if err != nil {
 		return err
 	}
@@ -1134,7 +1160,7 @@ func (c *awsClient) DeleteAccountRole(roleName string, prefix string, managedPol
 }
 
 func (c *awsClient) detachAttachedRolePolicies(role *string) error {
-	attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(context.Background(),
+	attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(c.context(),
 		&iam.ListAttachedRolePoliciesInput{
 			RoleName: role,
 		})
@@ -1142,7 +1168,7 @@ func (c *awsClient) detachAttachedRolePolicies(role *string) error {
 		return err
 	}
 	for _, policy := range attachedPoliciesOutput.AttachedPolicies {
-		_, err = c.iamClient.DetachRolePolicy(context.Background(),
+		_, err = c.iamClient.DetachRolePolicy(c.context(),
 			&iam.DetachRolePolicyInput{
 				PolicyArn: policy.PolicyArn,
 				RoleName:  role,
@@ -1159,13 +1185,13 @@ func (c *awsClient) detachAttachedRolePolicies(role *string) error {
 }
 
 func (c *awsClient) DeleteInlineRolePolicies(role string) error {
-	listRolePolicyOutput, err := c.iamClient.ListRolePolicies(context.Background(),
+	listRolePolicyOutput, err := c.iamClient.ListRolePolicies(c.context(),
 		&iam.ListRolePoliciesInput{RoleName: aws.String(role)})
 	if err != nil {
 		return err
 	}
 	for _, policyName := range listRolePolicyOutput.PolicyNames {
-		_, err = c.iamClient.DeleteRolePolicy(context.Background(),
+		_, err = c.iamClient.DeleteRolePolicy(c.context(),
 			&iam.DeleteRolePolicyInput{
 				PolicyName: aws.String(policyName),
 				RoleName:   aws.String(role),
@@ -1182,7 +1208,7 @@ func (c *awsClient) DeleteInlineRolePolicies(role string) error {
 }
 
 func (c *awsClient) isPolicyAttachedToEntity(policyArn string) (bool, error) {
-	policyOutput, err := c.iamClient.GetPolicy(context.Background(),
+	policyOutput, err := c.iamClient.GetPolicy(c.context(),
 		&iam.GetPolicyInput{PolicyArn: aws.String(policyArn)})
 	if err != nil {
 		return false, err
@@ -1212,7 +1238,7 @@ func (c *awsClient) deletePolicies(policies []string) (*iam.DeletePolicyOutput,
 			return output, err
 		}
 
-		output, err = c.iamClient.DeletePolicy(context.Background(),
+		output, err = c.iamClient.DeletePolicy(c.context(),
 			&iam.DeletePolicyInput{PolicyArn: &policies[i]})
 		if err != nil {
 			return output, err
@@ -1228,7 +1254,7 @@ func (c *awsClient) GetDefaultPolicyDocument(policyArn string) (string, error) {
 		return "", err
 	}
 
-	policyVersionOutput, err := c.iamClient.GetPolicyVersion(context.Background(),
+	policyVersionOutput, err := c.iamClient.GetPolicyVersion(c.context(),
 		&iam.GetPolicyVersionInput{
 			VersionId: aws.String(versionId),
 			PolicyArn: aws.String(policyArn),
@@ -1241,7 +1267,7 @@ func (c *awsClient) GetDefaultPolicyDocument(policyArn string) (string, error) {
 }
 
 func (c *awsClient) getDefaultPolicyVersionId(policyArn string) (string, error) {
-	policyVersionsOutput, err := c.iamClient.ListPolicyVersions(context.Background(),
+	policyVersionsOutput, err := c.iamClient.ListPolicyVersions(c.context(),
 		&iam.ListPolicyVersionsInput{
 			PolicyArn: aws.String(policyArn),
 		})
@@ -1260,7 +1286,7 @@ func (c *awsClient) getDefaultPolicyVersionId(policyArn string) (string, error)
 }
 
 func (c *awsClient) deletePolicyVersions(policyArn string) error {
-	policyVersionsOutput, err := c.iamClient.ListPolicyVersions(context.Background(),
+	policyVersionsOutput, err := c.iamClient.ListPolicyVersions(c.context(),
 		&iam.ListPolicyVersionsInput{
 			PolicyArn: aws.String(policyArn),
 		})
@@ -1272,7 +1298,7 @@ func (c *awsClient) deletePolicyVersions(policyArn string) error {
 		if version.IsDefaultVersion {
 			continue
 		}
-		_, err := c.iamClient.DeletePolicyVersion(context.Background(),
+		_, err := c.iamClient.DeletePolicyVersion(c.context(),
 			&iam.DeletePolicyVersionInput{
 				PolicyArn: aws.String(policyArn),
 				VersionId: version.VersionId,
@@ -1290,15 +1316,19 @@ func (c *awsClient) GetAttachedPolicyWithTags(role *string,
 	policies := []PolicyDetail{}
 	excludedPolicies := []PolicyDetail{}
 	attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(
-		context.Background(),
+		c.context(),
 		&iam.ListAttachedRolePoliciesInput{RoleName: role},
 	)
-	if err != nil && !awserr.IsNoSuchEntityException(err) {
+	if err != nil {
+		// The role doesn't exist, so it has no policies:
+		if awserr.IsNoSuchEntityException(err) {
+			return policies, excludedPolicies, nil
+		}
 		return policies, excludedPolicies, err
 	}
 
 	for _, policy := range attachedPoliciesOutput.AttachedPolicies {
-		hasTags, err := isPolicyHasTags(c.iamClient, policy.PolicyArn, tagFilter)
+		hasTags, err := isPolicyHasTags(c.context(), c.iamClient, policy.PolicyArn, tagFilter)
 		if err != nil {
 			return policies, excludedPolicies, err
 		}
@@ -1314,9 +1344,12 @@ func (c *awsClient) GetAttachedPolicyWithTags(role *string,
 		}
 	}
 
-	rolePolicyOutput, err := c.iamClient.ListRolePolicies(context.Background(),
+	rolePolicyOutput, err := c.iamClient.ListRolePolicies(c.context(),
 		&iam.ListRolePoliciesInput{RoleName: role})
-	if err != nil && !awserr.IsNoSuchEntityException(err) {
+	if err != nil {
+		if awserr.IsNoSuchEntityException(err) {
+			return policies, excludedPolicies, nil
+		}
 		return policies, excludedPolicies, err
 	}
 	for _, policy := range rolePolicyOutput.PolicyNames {
@@ -1337,7 +1370,7 @@ func (c *awsClient) GetAttachedPolicy(role *string) ([]PolicyDetail, error) {
 
 func (c *awsClient) detachOperatorRolePolicies(role *string) error {
 	// get attached role policies as operator roles have managed policies
-	policiesOutput, err := c.iamClient.ListAttachedRolePolicies(context.Background(),
+	policiesOutput, err := c.iamClient.ListAttachedRolePolicies(c.context(),
 		&iam.ListAttachedRolePoliciesInput{
 			RoleName: role,
 		})
@@ -1345,7 +1378,7 @@ func (c *awsClient) detachOperatorRolePolicies(role *string) error {
 		return err
 	}
 	for _, policy := range policiesOutput.AttachedPolicies {
-		_, err := c.iamClient.DetachRolePolicy(context.Background(),
+		_, err := c.iamClient.DetachRolePolicy(c.context(),
 			&iam.DetachRolePolicyInput{PolicyArn: policy.PolicyArn, RoleName: role})
 		if err != nil {
 			return err
@@ -1365,7 +1398,7 @@ func (c *awsClient) GetOperatorRolesFromAccountByClusterID(clusterID string,
 		if !checkIfROSAOperatorRole(role.RoleName, credRequest) {
 			continue
 		}
-		listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(),
+		listRoleTagsOutput, err := c.iamClient.ListRoleTags(c.context(),
 			&iam.ListRoleTagsInput{
 				RoleName: role.RoleName,
 			})
@@ -1452,7 +1485,7 @@ func (c *awsClient) GetAccountRolesForCurrentEnv(env string, accountID string) (
 func (c *awsClient) GetAccountRoleForCurrentEnv(env string, roleName string) (Role, error) {
 	role := Role{}
 	// This is done to ensure user did not provide invalid role before we check for installer role
-	accountRoleResponse, err := c.iamClient.GetRole(context.Background(),
+	accountRoleResponse, err := c.iamClient.GetRole(c.context(),
 		&iam.GetRoleInput{RoleName: aws.String(roleName)})
 	if err != nil {
 		if awserr.IsNoSuchEntityException(err) {
@@ -1508,7 +1541,7 @@ func (c *awsClient) checkInstallerRoleExists(roleName string) (*iamtypes.Role, e
 		}
 	}
 	installerRole := fmt.Sprintf("%s%s-Role", rolePrefix, "Installer")
-	installerRoleResponse, err := c.iamClient.GetRole(context.Background(),
+	installerRoleResponse, err := c.iamClient.GetRole(c.context(),
 		&iam.GetRoleInput{RoleName: aws.String(installerRole)})
 	//We try our best to determine the environment based on the trust policy in the installer
 	//If the installer role is deleted we can assume that there is no cluster using the role
@@ -1549,7 +1582,7 @@ func (c *awsClient) buildRoles(roleName string, accountID string) ([]Role, error
 		roleARN := GetRoleARN(accountID, roleName, "", creator.Partition)
 
 		if prefix.Name != "Installer" {
-			_, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{RoleName: aws.String(roleName)})
+			_, err := c.iamClient.GetRole(c.context(), &iam.GetRoleInput{RoleName: aws.String(roleName)})
 			if err != nil && !awserr.IsNoSuchEntityException(err) {
 				return roles, err
 			}
@@ -1568,13 +1601,23 @@ func (c *awsClient) GetAccountRolePolicies(roles []string, prefix string) (map[s
 	map[string][]PolicyDetail, error) {
 	rolePolicies := make(map[string][]PolicyDetail)
 	roleExcludedPolicies := make(map[string][]PolicyDetail)
-	for _, role := range roles {
+	type result struct {
+		policies         []PolicyDetail
+		excludedPolicies []PolicyDetail
+	}
+	results, err := lookupAll(c.context(), roles, func(role string) (result, error) {
 		policies, excludedPolicies, err := c.GetAttachedPolicyWithTags(aws.String(role), getAcctRolePolicyTags(prefix))
 		if err != nil && !awserr.IsNoSuchEntityException(err) {
-			return rolePolicies, roleExcludedPolicies, err
+			return result{}, err
 		}
-		rolePolicies[role] = policies
-		roleExcludedPolicies[role] = excludedPolicies
+		return result{policies, excludedPolicies}, nil
+	})
+	if err != nil {
+		return rolePolicies, roleExcludedPolicies, err
+	}
+	for i, role := range roles {
+		rolePolicies[role] = results[i].policies
+		roleExcludedPolicies[role] = results[i].excludedPolicies
 	}
 	return rolePolicies, roleExcludedPolicies, nil
 }
@@ -1582,30 +1625,40 @@ func (c *awsClient) GetAccountRolePolicies(roles []string, prefix string) (map[s
 func (c *awsClient) GetOperatorRolePolicies(roles []string) (map[string][]string, map[string][]string, error) {
 	rolePolicies := map[string][]string{}
 	roleExcludedPolicies := map[string][]string{}
-	for _, role := range roles {
-		tagFilter, err := getOperatorRolePolicyTags(c.iamClient, role)
+	type result struct {
+		policies         []string
+		excludedPolicies []string
+	}
+	results, err := lookupAll(c.context(), roles, func(role string) (result, error) {
+		tagFilter, err := getOperatorRolePolicyTags(c.context(), c.iamClient, role)
 		if err != nil {
-			return rolePolicies, roleExcludedPolicies, err
+			return result{}, err
 		}
-		policies, excludedPolicies, err := getAttachedPolicies(c.iamClient, role, tagFilter)
+		policies, excludedPolicies, err := getAttachedPolicies(c.context(), c.iamClient, role, tagFilter)
 		if err != nil {
-			return rolePolicies, roleExcludedPolicies, err
+			return result{}, err
 		}
-		rolePolicies[role] = policies
-		roleExcludedPolicies[role] = excludedPolicies
+		return result{policies, excludedPolicies}, nil
+	})
+	if err != nil {
+		return rolePolicies, roleExcludedPolicies, err
+	}
+	for i, role := range roles {
+		rolePolicies[role] = results[i].policies
+		roleExcludedPolicies[role] = results[i].excludedPolicies
 	}
 	return rolePolicies, roleExcludedPolicies, nil
 }
 
 func (c *awsClient) GetOpenIDConnectProviderByClusterIdTag(clusterID string) (string, error) {
-	providers, err := c.iamClient.ListOpenIDConnectProviders(context.Background(),
+	providers, err := c.iamClient.ListOpenIDConnectProviders(c.context(),
 		&iam.ListOpenIDConnectProvidersInput{})
 	if err != nil {
 		return "", err
 	}
 	for _, provider := range providers.OpenIDConnectProviderList {
 		providerValue := aws.ToString(provider.Arn)
-		connectProvider, err := c.iamClient.GetOpenIDConnectProvider(context.Background(),
+		connectProvider, err := c.iamClient.GetOpenIDConnectProvider(c.context(),
 			&iam.GetOpenIDConnectProviderInput{
 				OpenIDConnectProviderArn: provider.Arn,
 			})
@@ -1633,7 +1686,7 @@ func (c *awsClient) GetOpenIDConnectProviderByClusterIdTag(clusterID string) (st
 }
 
 func (c *awsClient) GetOpenIDConnectProviderByOidcEndpointUrl(oidcEndpointUrl string) (string, error) {
-	providers, err := c.iamClient.ListOpenIDConnectProviders(context.Background(),
+	providers, err := c.iamClient.ListOpenIDConnectProviders(c.context(),
 		&iam.ListOpenIDConnectProvidersInput{})
 	if err != nil {
 		return "", err
@@ -1659,7 +1712,7 @@ func (c *awsClient) GetOpenIDConnectProviderByOidcEndpointUrl(oidcEndpointUrl st
 func (c *awsClient) GetRoleARNPath(prefix string) (string, error) {
 	for _, accountRole := range AccountRoles {
 		roleName := fmt.Sprintf("%s-%s-Role", prefix, accountRole.Name)
-		role, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+		role, err := c.iamClient.GetRole(c.context(), &iam.GetRoleInput{
 			RoleName: aws.String(roleName),
 		})
 		if awserr.IsNoSuchEntityException(err) {
@@ -1673,7 +1726,7 @@ func (c *awsClient) GetRoleARNPath(prefix string) (string, error) {
 func (c *awsClient) IsUpgradedNeededForAccountRolePolicies(prefix string, version string) (bool, error) {
 	for _, accountRole := range AccountRoles {
 		roleName := fmt.Sprintf("%s-%s-Role", prefix, accountRole.Name)
-		role, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+		role, err := c.iamClient.GetRole(c.context(), &iam.GetRoleInput{
 			RoleName: aws.String(roleName),
 		})
 		if err != nil {
@@ -1748,13 +1801,13 @@ func (c *awsClient) UpdateTag(roleName string, defaultPolicyVersion string) erro
 }
 
 func (c *awsClient) AddRoleTag(roleName string, key string, value string) error {
-	role, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	role, err := c.iamClient.GetRole(c.context(), &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
 		return err
 	}
-	_, err = c.iamClient.TagRole(context.Background(), &iam.TagRoleInput{
+	_, err = c.iamClient.TagRole(c.context(), &iam.TagRoleInput{
 		RoleName: role.Role.RoleName,
 		Tags: []iamtypes.Tag{
 			{
@@ -1801,7 +1854,7 @@ func (c *awsClient) IsUpgradedNeededForOperatorRolePoliciesUsingCluster(
 		if err != nil {
 			return true, err
 		}
-		_, err = c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+		_, err = c.iamClient.GetRole(c.context(), &iam.GetRoleInput{
 			RoleName: aws.String(roleName),
 		})
 		if err != nil {
@@ -1877,7 +1930,7 @@ func (c *awsClient) isRolePolicyUpToDate(policyARN string, policyVersion string)
 }
 
 func (c *awsClient) isRolePoliciesCompatibleForUpgrade(policyARN string, version string) (bool, error) {
-	policyTagOutput, err := c.iamClient.ListPolicyTags(context.Background(), &iam.ListPolicyTagsInput{
+	policyTagOutput, err := c.iamClient.ListPolicyTags(c.context(), &iam.ListPolicyTagsInput{
 		PolicyArn: aws.String(policyARN),
 	})
 	if err != nil {
@@ -1887,7 +1940,7 @@ func (c *awsClient) isRolePoliciesCompatibleForUpgrade(policyARN string, version
 }
 
 func (c *awsClient) GetAccountRoleVersion(roleName string) (string, error) {
-	role, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	role, err := c.iamClient.GetRole(c.context(), &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
@@ -1898,7 +1951,7 @@ func (c *awsClient) GetAccountRoleVersion(roleName string) (string, error) {
 }
 
 func (c *awsClient) IsAdminRole(roleName string) (bool, error) {
-	role, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	role, err := c.iamClient.GetRole(c.context(), &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
@@ -1915,7 +1968,7 @@ func (c *awsClient) IsAdminRole(roleName string) (bool, error) {
 }
 
 func (c *awsClient) GetAccountRoleARN(prefix string, roleType string) (string, error) {
-	output, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	output, err := c.iamClient.GetRole(c.context(), &iam.GetRoleInput{
 		RoleName: aws.String(common.GetRoleName(prefix, roleType)),
 	})
 	if err != nil {
@@ -1983,7 +2036,7 @@ func (c *awsClient) ValidateHCPAccountRolesManagedPolicies(prefix string,
 
 func (c *awsClient) ListAttachedRolePolicies(roleName string) ([]string, error) {
 	policies := []string{}
-	listPolicies, err := c.iamClient.ListAttachedRolePolicies(context.Background(), &iam.ListAttachedRolePoliciesInput{
+	listPolicies, err := c.iamClient.ListAttachedRolePolicies(c.context(), &iam.ListAttachedRolePoliciesInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
@@ -1996,7 +2049,7 @@ func (c *awsClient) ListAttachedRolePolicies(roleName string) ([]string, error)
 }
 
 func (c *awsClient) GetAccountRoleDefaultPolicy(roleName string, prefix string) (string, error) {
-	policies, _, err := getAttachedPolicies(c.iamClient, roleName, getAcctRolePolicyTags(prefix))
+	policies, _, err := getAttachedPolicies(c.context(), c.iamClient, roleName, getAcctRolePolicyTags(prefix))
 	if err != nil {
 		return "", err
 	}
@@ -2011,11 +2064,11 @@ func (c *awsClient) GetAccountRoleDefaultPolicy(roleName string, prefix string)
 }
 
 func (c *awsClient) GetOperatorRoleDefaultPolicy(roleName string) (string, error) {
-	tagfilter, err := getOperatorRolePolicyTags(c.iamClient, roleName)
+	tagfilter, err := getOperatorRolePolicyTags(c.context(), c.iamClient, roleName)
 	if err != nil {
 		return "", nil
 	}
-	policies, _, err := getAttachedPolicies(c.iamClient, roleName, tagfilter)
+	policies, _, err := getAttachedPolicies(c.context(), c.iamClient, roleName, tagfilter)
 	if err != nil {
 		return "", err
 	}
@@ -2064,7 +2117,7 @@ func (c *awsClient) isManagedPolicyAttached(roleName string, managedPolicyARN st
 
 func (c *awsClient) listRoleAttachedPolicies(roleName string) ([]iamtypes.AttachedPolicy, error) {
 	attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(
-		context.Background(),
+		c.context(),
 		&iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)},
 	)
 	if err != nil {
@@ -2079,9 +2132,9 @@ func (c *awsClient) listRoleAttachedPolicies(roleName string) ([]iamtypes.Attach
 }
 
 // check whether the policy contains specified tags
-func isPolicyHasTags(c client.IamApiClient, poilcyArn *string, tagFilter map[string]string) (bool, error) {
+func isPolicyHasTags(ctx context.Context, c client.IamApiClient, poilcyArn *string, tagFilter map[string]string) (bool, error) {
 	if len(tagFilter) != 0 {
-		tags, err := c.ListPolicyTags(context.Background(),
+		tags, err := c.ListPolicyTags(ctx,
 			&iam.ListPolicyTagsInput{
 				PolicyArn: poilcyArn,
 			},
@@ -2104,11 +2157,11 @@ func isPolicyHasTags(c client.IamApiClient, poilcyArn *string, tagFilter map[str
 	return true, nil
 }
 
-func getAttachedPolicies(c client.IamApiClient, role string,
+func getAttachedPolicies(ctx context.Context, c client.IamApiClient, role string,
 	tagFilter map[string]string) ([]string, []string, error) {
 	policyArr := []string{}
 	excludedPolicyArr := []string{}
-	policiesOutput, err := c.ListAttachedRolePolicies(context.Background(),
+	policiesOutput, err := c.ListAttachedRolePolicies(ctx,
 		&iam.ListAttachedRolePoliciesInput{
 			RoleName: aws.String(role),
 		})
@@ -2116,7 +2169,7 @@ func getAttachedPolicies(c client.IamApiClient, role string,
 		return policyArr, excludedPolicyArr, err
 	}
 	for _, policy := range policiesOutput.AttachedPolicies {
-		hasTags, err := isPolicyHasTags(c, policy.PolicyArn, tagFilter)
+		hasTags, err := isPolicyHasTags(ctx, c, policy.PolicyArn, tagFilter)
 		if err != nil {
 			return policyArr, excludedPolicyArr, err
 		}
@@ -2136,10 +2189,10 @@ func getAcctRolePolicyTags(prefix string) map[string]string {
 	return tagmap
 }
 
-func getOperatorRolePolicyTags(c client.IamApiClient, roleName string) (map[string]string, error) {
+func getOperatorRolePolicyTags(ctx context.Context, c client.IamApiClient, roleName string) (map[string]string, error) {
 	tagmap := map[string]string{}
 	tagmap[tags.RedHatManaged] = TrueString
-	roleTags, err := c.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+	roleTags, err := c.ListRoleTags(ctx, &iam.ListRoleTagsInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -517,14 +518,14 @@ var _ = Describe("Cluster Roles/Policies", func() {
 			RoleName: aws.String(operatorName),
 		}).Return(operatorRoleTags, nil)
 
-		policies, _, err := getAttachedPolicies(mockIamAPI, accountRole, getAcctRolePolicyTags(rolePrefix))
+		policies, _, err := getAttachedPolicies(context.Background(), mockIamAPI, accountRole, getAcctRolePolicyTags(rolePrefix))
 		Expect(err).NotTo(HaveOccurred())
 		Expect(policies).To(HaveLen(1))
 		Expect(policies[0]).To(Equal(accountRolePolicyArn))
 
-		tagFilter, err := getOperatorRolePolicyTags(mockIamAPI, operatorName)
+		tagFilter, err := getOperatorRolePolicyTags(context.Background(), mockIamAPI, operatorName)
 		Expect(err).NotTo(HaveOccurred())
-		policies, _, err = getAttachedPolicies(mockIamAPI, operatorRole, tagFilter)
+		policies, _, err = getAttachedPolicies(context.Background(), mockIamAPI, operatorRole, tagFilter)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(policies).To(HaveLen(1))
 		Expect(policies[0]).To(Equal(operatorRolePolicyArn))
@@ -1,7 +1,6 @@
 package aws
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -170,7 +169,7 @@ func (p *PolicyDocument) checkPermissionsUsingQueryClient(queryClient *awsClient
 	var failedActions []string
 	paginator := iam.NewSimulatePrincipalPolicyPaginator(client, input)
 	for paginator.HasMorePages() {
-		output, err := paginator.NextPage(context.TODO())
+		output, err := paginator.NextPage(queryClient.context())
 		if err != nil {
 			return false, fmt.Errorf("Error simulating policy: %v", err)
 		}
@@ -0,0 +1,364 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type PolicyDriftType string
+
+const (
+	// PolicyDriftMissing is an expected statement that none of the live policies contains
+	PolicyDriftMissing PolicyDriftType = "missing"
+	// PolicyDriftExtra is a live statement that none of the expected policies contains
+	PolicyDriftExtra PolicyDriftType = "extra"
+	// PolicyDriftModified is an expected statement whose live version grants different permissions
+	PolicyDriftModified PolicyDriftType = "modified"
+)
+
+// PolicyDrift is a single statement that differs between the policy documents that a role should
+// have and the ones that it has.
+type PolicyDrift struct {
+	Type           PolicyDriftType        `json:"type"`
+	Sid            string                 `json:"sid,omitempty"`
+	Expected       map[string]interface{} `json:"expected,omitempty"`
+	Live           map[string]interface{} `json:"live,omitempty"`
+	AddedActions   []string               `json:"addedActions,omitempty"`
+	RemovedActions []string               `json:"removedActions,omitempty"`
+	ChangedFields  []string               `json:"changedFields,omitempty"`
+}
+
+func (d PolicyDrift) String() string {
+	switch d.Type {
+	case PolicyDriftMissing:
+		return fmt.Sprintf("+ missing statement %s", describeStatement(d.Sid, d.Expected))
+	case PolicyDriftExtra:
+		return fmt.Sprintf("- extra statement %s", describeStatement(d.Sid, d.Live))
+	default:
+		changes := []string{}
+		if len(d.AddedActions) > 0 {
+			changes = append(changes, fmt.Sprintf("added %s", strings.Join(d.AddedActions, ", ")))
+		}
+		if len(d.RemovedActions) > 0 {
+			changes = append(changes, fmt.Sprintf("removed %s", strings.Join(d.RemovedActions, ", ")))
+		}
+		if len(d.ChangedFields) > 0 {
+			changes = append(changes, fmt.Sprintf("changed %s", strings.Join(d.ChangedFields, ", ")))
+		}
+		return fmt.Sprintf("~ modified statement %s: %s", describeStatement(d.Sid, d.Expected),
+			strings.Join(changes, "; "))
+	}
+}
+
+// describeStatement returns the identifier of the statement if it has one, or a summary of its
+// effect, actions and resources.
+func describeStatement(sid string, statement map[string]interface{}) string {
+	if sid != "" {
+		return fmt.Sprintf("'%s'", sid)
+	}
+	description := fmt.Sprintf("%v", statement["Effect"])
+	for _, field := range []string{"Action", "NotAction"} {
+		if actions, ok := statement[field].([]string); ok {
+			if field == "NotAction" {
+				description += " all but"
+			}
+			description += " " + strings.Join(actions, ", ")
+		}
+	}
+	if resources, ok := statement["Resource"].([]string); ok {
+		description += " on " + strings.Join(resources, ", ")
+	}
+	return fmt.Sprintf("'%s'", description)
+}
+
+// policyStatement is a statement with its values in a canonical form, so that statements that
+// grant the same permissions compare equal even if the order of their values differs.
+type policyStatement struct {
+	sid  string
+	body map[string]interface{}
+	// canonical is the body with the names of the actions in lower case, as IAM compares them
+	// case-insensitively
+	canonical map[string]interface{}
+	// key identifies the permissions granted by the statement
+	key string
+	// scope identifies the statement without its actions, to find the live version of an
+	// expected statement whose actions were changed
+	scope string
+	// actions identifies the effect and actions of the statement, to find the live version of an
+	// expected statement whose resources, principals or conditions were changed
+	actions string
+}
+
+// Fields whose value can be either a single string or a list of strings
+var listFields = map[string]bool{
+	"Action":      true,
+	"NotAction":   true,
+	"Resource":    true,
+	"NotResource": true,
+}
+
+// Fields whose values are names of actions
+var actionFields = []string{"Action", "NotAction"}
+
+// ComparePolicyDocuments compares, statement by statement, the policy documents that a role should
+// have with the ones that it has. The identifiers of the statements, the order of the statements
+// and of their values, and the case of the names of the actions are ignored. An expected statement
+// that has no identical live statement is paired with a live statement that has the same
+// identifier, or else the same effect, resources, principals and conditions, or else the same
+// effect and actions, and reported as modified. The remaining statements are reported as missing
+// or extra.
+func ComparePolicyDocuments(expected []string, live []string) ([]PolicyDrift, error) {
+	expectedStatements, err := parsePolicyStatements(expected)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse expected policy: %v", err)
+	}
+	liveStatements, err := parsePolicyStatements(live)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse live policy: %v", err)
+	}
+
+	matched := make([]bool, len(liveStatements))
+	find := func(match func(statement policyStatement) bool) int {
+		for i, statement := range liveStatements {
+			if !matched[i] && match(statement) {
+				matched[i] = true
+				return i
+			}
+		}
+		return -1
+	}
+
+	unmatched := []policyStatement{}
+	for _, statement := range expectedStatements {
+		if find(func(l policyStatement) bool { return l.key == statement.key }) < 0 {
+			unmatched = append(unmatched, statement)
+		}
+	}
+
+	drifts := []PolicyDrift{}
+	for _, statement := range unmatched {
+		i := -1
+		if statement.sid != "" {
+			i = find(func(l policyStatement) bool { return l.sid == statement.sid })
+		}
+		if i < 0 {
+			i = find(func(l policyStatement) bool { return l.scope == statement.scope })
+		}
+		if i < 0 {
+			i = find(func(l policyStatement) bool { return l.actions == statement.actions })
+		}
+		if i < 0 {
+			drifts = append(drifts, PolicyDrift{
+				Type:     PolicyDriftMissing,
+				Sid:      statement.sid,
+				Expected: statement.body,
+			})
+			continue
+		}
+		drifts = append(drifts, compareStatements(statement, liveStatements[i]))
+	}
+	for i, statement := range liveStatements {
+		if !matched[i] {
+			drifts = append(drifts, PolicyDrift{
+				Type: PolicyDriftExtra,
+				Sid:  statement.sid,
+				Live: statement.body,
+			})
+		}
+	}
+	return drifts, nil
+}
+
+func compareStatements(expected policyStatement, live policyStatement) PolicyDrift {
+	drift := PolicyDrift{
+		Type:     PolicyDriftModified,
+		Sid:      expected.sid,
+		Expected: expected.body,
+		Live:     live.body,
+	}
+	expectedActions, _ := expected.body["Action"].([]string)
+	liveActions, _ := live.body["Action"].([]string)
+	drift.AddedActions = subtract(liveActions, expectedActions)
+	drift.RemovedActions = subtract(expectedActions, liveActions)
+
+	fields := map[string]bool{}
+	for field := range expected.body {
+		fields[field] = true
+	}
+	for field := range live.body {
+		fields[field] = true
+	}
+	for field := range fields {
+		if field == "Action" {
+			continue
+		}
+		if canonicalJSON(expected.canonical[field]) != canonicalJSON(live.canonical[field]) {
+			drift.ChangedFields = append(drift.ChangedFields, field)
+		}
+	}
+	sort.Strings(drift.ChangedFields)
+	return drift
+}
+
+func parsePolicyStatements(documents []string) ([]policyStatement, error) {
+	statements := []policyStatement{}
+	for _, document := range documents {
+		var policy struct {
+			Statement json.RawMessage
+		}
+		err := json.Unmarshal([]byte(document), &policy)
+		if err != nil {
+			return nil, err
+		}
+		var bodies []map[string]interface{}
+		if len(policy.Statement) > 0 && policy.Statement[0] == '{' {
+			var body map[string]interface{}
+			err = json.Unmarshal(policy.Statement, &body)
+			bodies = append(bodies, body)
+		} else if len(policy.Statement) > 0 {
+			err = json.Unmarshal(policy.Statement, &bodies)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, body := range bodies {
+			statements = append(statements, newPolicyStatement(body))
+		}
+	}
+	return statements, nil
+}
+
+func newPolicyStatement(raw map[string]interface{}) policyStatement {
+	statement := policyStatement{
+		body: map[string]interface{}{},
+	}
+	for field, value := range raw {
+		switch {
+		case field == "Sid":
+			statement.sid = fmt.Sprintf("%v", value)
+		case listFields[field]:
+			statement.body[field] = stringList(value)
+		case field == "Principal" || field == "NotPrincipal" || field == "Condition":
+			statement.body[field] = normalizeMap(value)
+		default:
+			statement.body[field] = value
+		}
+	}
+	statement.canonical = map[string]interface{}{}
+	for field, value := range statement.body {
+		statement.canonical[field] = value
+	}
+	for _, field := range actionFields {
+		if actions, ok := statement.body[field].([]string); ok {
+			statement.canonical[field] = lowerList(actions)
+		}
+	}
+	statement.key = canonicalJSON(statement.canonical)
+	statement.scope = canonicalJSON(without(statement.canonical, "Action"))
+	statement.actions = canonicalJSON([]interface{}{
+		statement.canonical["Effect"], statement.canonical["Action"], statement.canonical["NotAction"],
+	})
+	return statement
+}
+
+// normalizeMap replaces the values of principals and conditions with sorted lists of strings. The
+// '*' principal is kept as is.
+func normalizeMap(value interface{}) interface{} {
+	values, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	result := map[string]interface{}{}
+	for key, item := range values {
+		if nested, ok := item.(map[string]interface{}); ok {
+			result[key] = normalizeMap(nested)
+		} else {
+			result[key] = stringList(item)
+		}
+	}
+	return result
+}
+
+// stringList returns the single value or the list of values as a sorted list without duplicates.
+func stringList(value interface{}) []string {
+	var items []interface{}
+	switch typed := value.(type) {
+	case []interface{}:
+		items = typed
+	default:
+		items = []interface{}{typed}
+	}
+	seen := map[string]bool{}
+	result := []string{}
+	for _, item := range items {
+		text := fmt.Sprintf("%v", item)
+		if !seen[text] {
+			seen[text] = true
+			result = append(result, text)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// lowerList returns the values in lower case, sorted and without duplicates.
+func lowerList(values []string) []string {
+	lower := make([]interface{}, len(values))
+	for i, value := range values {
+		lower[i] = strings.ToLower(value)
+	}
+	return stringList(lower)
+}
+
+func without(body map[string]interface{}, field string) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, value := range body {
+		if key != field {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// canonicalJSON returns the JSON representation of the value, which has the keys of maps sorted.
+func canonicalJSON(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// subtract returns the values that aren't in the removed ones, ignoring case, as the values are
+// names of actions.
+func subtract(values []string, removed []string) []string {
+	excluded := map[string]bool{}
+	for _, value := range removed {
+		excluded[strings.ToLower(value)] = true
+	}
+	var result []string
+	for _, value := range values {
+		if !excluded[strings.ToLower(value)] {
+			result = append(result, value)
+		}
+	}
+	return result
+}
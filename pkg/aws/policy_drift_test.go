@@ -0,0 +1,194 @@
+package aws
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ComparePolicyDocuments", func() {
+	const expected = `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Sid": "ReadOnly",
+				"Effect": "Allow",
+				"Action": ["ec2:DescribeInstances", "ec2:DescribeSubnets"],
+				"Resource": "*"
+			},
+			{
+				"Effect": "Allow",
+				"Action": "iam:PassRole",
+				"Resource": "*",
+				"Condition": {"StringEquals": {"iam:PassedToService": "ec2.amazonaws.com"}}
+			}
+		]
+	}`
+
+	It("Ignores the order of statements and values, and the statement identifiers", func() {
+		live := `{
+			"Version": "2012-10-17",
+			"Statement": [
+				{
+					"Effect": "Allow",
+					"Action": ["iam:PassRole"],
+					"Resource": ["*"],
+					"Condition": {"StringEquals": {"iam:PassedToService": ["ec2.amazonaws.com"]}}
+				},
+				{
+					"Sid": "Renamed",
+					"Effect": "Allow",
+					"Action": ["ec2:DescribeSubnets", "ec2:DescribeInstances"],
+					"Resource": "*"
+				}
+			]
+		}`
+		drifts, err := ComparePolicyDocuments([]string{expected}, []string{live})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(BeEmpty())
+	})
+
+	It("Ignores the case of the names of the actions", func() {
+		live := `{
+			"Statement": [
+				{
+					"Sid": "ReadOnly",
+					"Effect": "Allow",
+					"Action": ["EC2:describeInstances", "ec2:describesubnets"],
+					"Resource": "*"
+				},
+				{
+					"Effect": "Allow",
+					"Action": "IAM:PassRole",
+					"Resource": "*",
+					"Condition": {"StringEquals": {"iam:PassedToService": "ec2.amazonaws.com"}}
+				}
+			]
+		}`
+		drifts, err := ComparePolicyDocuments([]string{expected}, []string{live})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(BeEmpty())
+	})
+
+	It("Compares the statements of several policies", func() {
+		first := `{"Statement": [{"Effect": "Allow", "Action": "ec2:DescribeInstances", "Resource": "*"}]}`
+		second := `{"Statement": {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}}`
+		drifts, err := ComparePolicyDocuments([]string{first, second}, []string{second, first})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(BeEmpty())
+	})
+
+	It("Reports the actions added to and removed from a statement", func() {
+		live := `{
+			"Statement": [
+				{
+					"Sid": "ReadOnly",
+					"Effect": "Allow",
+					"Action": ["ec2:DescribeInstances", "ec2:RunInstances"],
+					"Resource": "*"
+				},
+				{
+					"Effect": "Allow",
+					"Action": "iam:PassRole",
+					"Resource": "*",
+					"Condition": {"StringEquals": {"iam:PassedToService": "ec2.amazonaws.com"}}
+				}
+			]
+		}`
+		drifts, err := ComparePolicyDocuments([]string{expected}, []string{live})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(HaveLen(1))
+		Expect(drifts[0].Type).To(Equal(PolicyDriftModified))
+		Expect(drifts[0].AddedActions).To(Equal([]string{"ec2:RunInstances"}))
+		Expect(drifts[0].RemovedActions).To(Equal([]string{"ec2:DescribeSubnets"}))
+		Expect(drifts[0].ChangedFields).To(BeEmpty())
+		Expect(drifts[0].String()).To(Equal(
+			"~ modified statement 'ReadOnly': added ec2:RunInstances; removed ec2:DescribeSubnets"))
+	})
+
+	It("Reports the fields changed in a statement with the same identifier", func() {
+		live := `{
+			"Statement": [
+				{
+					"Sid": "ReadOnly",
+					"Effect": "Allow",
+					"Action": ["ec2:DescribeInstances", "ec2:DescribeSubnets"],
+					"Resource": "arn:aws:ec2:*:*:instance/*"
+				},
+				{
+					"Effect": "Allow",
+					"Action": "iam:PassRole",
+					"Resource": "*",
+					"Condition": {"StringEquals": {"iam:PassedToService": "ec2.amazonaws.com"}}
+				}
+			]
+		}`
+		drifts, err := ComparePolicyDocuments([]string{expected}, []string{live})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(HaveLen(1))
+		Expect(drifts[0].Type).To(Equal(PolicyDriftModified))
+		Expect(drifts[0].ChangedFields).To(Equal([]string{"Resource"}))
+		Expect(drifts[0].String()).To(Equal("~ modified statement 'ReadOnly': changed Resource"))
+	})
+
+	It("Reports missing and extra statements", func() {
+		live := `{
+			"Statement": [
+				{
+					"Sid": "ReadOnly",
+					"Effect": "Allow",
+					"Action": ["ec2:DescribeInstances", "ec2:DescribeSubnets"],
+					"Resource": "*"
+				},
+				{
+					"Effect": "Allow",
+					"Action": "s3:*",
+					"Resource": "arn:aws:s3:::bucket"
+				}
+			]
+		}`
+		drifts, err := ComparePolicyDocuments([]string{expected}, []string{live})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(HaveLen(2))
+		Expect(drifts[0].Type).To(Equal(PolicyDriftMissing))
+		Expect(drifts[0].String()).To(Equal("+ missing statement 'Allow iam:PassRole on *'"))
+		Expect(drifts[1].Type).To(Equal(PolicyDriftExtra))
+		Expect(drifts[1].String()).To(Equal("- extra statement 'Allow s3:* on arn:aws:s3:::bucket'"))
+	})
+
+	It("Reports every statement of an unexpected policy as extra", func() {
+		inline := `{"Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*"}]}`
+		drifts, err := ComparePolicyDocuments([]string{expected}, []string{expected, inline})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(HaveLen(1))
+		Expect(drifts[0].Type).To(Equal(PolicyDriftExtra))
+		Expect(drifts[0].Live).To(HaveKeyWithValue("Action", []string{"*"}))
+	})
+
+	It("Compares the principals and conditions of trust policies", func() {
+		trust := `{
+			"Statement": [{
+				"Effect": "Allow",
+				"Principal": {"AWS": ["arn:aws:iam::710019948333:role/RH-Managed-OpenShift-Installer"]},
+				"Action": "sts:AssumeRole"
+			}]
+		}`
+		live := `{
+			"Statement": [{
+				"Effect": "Allow",
+				"Principal": {"AWS": ["arn:aws:iam::710019948333:role/RH-Managed-OpenShift-Installer",
+					"arn:aws:iam::123456789012:root"]},
+				"Action": "sts:AssumeRole"
+			}]
+		}`
+		drifts, err := ComparePolicyDocuments([]string{trust}, []string{live})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(HaveLen(1))
+		Expect(drifts[0].Type).To(Equal(PolicyDriftModified))
+		Expect(drifts[0].ChangedFields).To(Equal([]string{"Principal"}))
+	})
+
+	It("Fails if a document isn't valid JSON", func() {
+		_, err := ComparePolicyDocuments([]string{expected}, []string{"{"})
+		Expect(err).To(MatchError(ContainSubstring("Failed to parse live policy")))
+	})
+})
@@ -43,8 +43,16 @@ func Profile() string {
 	if awsProfile != "" {
 		return awsProfile
 	}
-	return ""
+	return defaultProfile
+}
+
+// SetDefault sets the profile used when neither the flag nor the environment select one.
+func SetDefault(value string) {
+	defaultProfile = value
 }
 
 // profile is a string flag that indicates which AWS profile is being used.
 var profile string
+
+// defaultProfile is the profile of the current configuration context.
+var defaultProfile string
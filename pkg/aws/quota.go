@@ -1,7 +1,6 @@
 package aws
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -134,7 +133,7 @@ func ListServiceQuotas(client *awsClient, serviceCode string) ([]servicequotasty
 		})
 
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(client.context())
 		if err != nil {
 			return nil, err
 		}
@@ -157,7 +156,7 @@ func GetServiceQuota(serviceQuotas []servicequotastypes.ServiceQuota,
 
 func (c *awsClient) GetIAMServiceQuota(quotaCode string) (
 	*servicequotas.GetServiceQuotaOutput, error) {
-	return c.iamQuotaClient.GetServiceQuota(context.Background(), &servicequotas.GetServiceQuotaInput{
+	return c.iamQuotaClient.GetServiceQuota(c.context(), &servicequotas.GetServiceQuotaInput{
 		ServiceCode: aws.String(IAMServiceCode),
 		QuotaCode:   aws.String(quotaCode),
 	})
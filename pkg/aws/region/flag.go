@@ -45,8 +45,16 @@ func Region() string {
 	if helper.HandleEscapedEmptyString(awsRegion) != "" {
 		return awsRegion
 	}
-	return ""
+	return defaultRegion
+}
+
+// SetDefault sets the region used when neither the flag nor the environment select one.
+func SetDefault(value string) {
+	defaultRegion = value
 }
 
 // region is a string flag that indicates which AWS region is being used.
 var region string
+
+// defaultRegion is the region of the current configuration context.
+var defaultRegion string
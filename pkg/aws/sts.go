@@ -17,7 +17,6 @@ limitations under the License.
 package aws
 
 import (
-	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -81,7 +80,7 @@ func (c *awsClient) ValidateRoleARNAccountIDMatchCallerAccountID(roleARN string)
 }
 
 func (c *awsClient) HasPermissionsBoundary(roleName string) (bool, error) {
-	output, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	output, err := c.iamClient.GetRole(c.context(), &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
@@ -92,7 +91,7 @@ func (c *awsClient) HasPermissionsBoundary(roleName string) (bool, error) {
 }
 
 func (c *awsClient) deletePermissionsBoundary(roleName string) error {
-	output, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	output, err := c.iamClient.GetRole(c.context(), &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
@@ -100,7 +99,7 @@ func (c *awsClient) deletePermissionsBoundary(roleName string) error {
 	}
 
 	if output.Role.PermissionsBoundary != nil {
-		_, err := c.iamClient.DeleteRolePermissionsBoundary(context.Background(), &iam.DeleteRolePermissionsBoundaryInput{
+		_, err := c.iamClient.DeleteRolePermissionsBoundary(c.context(), &iam.DeleteRolePermissionsBoundaryInput{
 			RoleName: aws.String(roleName),
 		})
 		if err != nil {
@@ -112,7 +111,7 @@ func (c *awsClient) deletePermissionsBoundary(roleName string) error {
 }
 
 func (c *awsClient) deleteOCMRolePolicies(roleName string, managedPolicies bool) error {
-	policiesOutput, err := c.iamClient.ListAttachedRolePolicies(context.Background(), &iam.ListAttachedRolePoliciesInput{
+	policiesOutput, err := c.iamClient.ListAttachedRolePolicies(c.context(), &iam.ListAttachedRolePoliciesInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
@@ -120,7 +119,7 @@ func (c *awsClient) deleteOCMRolePolicies(roleName string, managedPolicies bool)
 	}
 
 	for _, policy := range policiesOutput.AttachedPolicies {
-		_, err := c.iamClient.DetachRolePolicy(context.Background(), &iam.DetachRolePolicyInput{
+		_, err := c.iamClient.DetachRolePolicy(c.context(), &iam.DetachRolePolicyInput{
 			PolicyArn: policy.PolicyArn,
 			RoleName:  aws.String(roleName),
 		})
@@ -129,7 +128,7 @@ func (c *awsClient) deleteOCMRolePolicies(roleName string, managedPolicies bool)
 		}
 
 		if !managedPolicies {
-			_, err = c.iamClient.DeletePolicy(context.Background(), &iam.DeletePolicyInput{PolicyArn: policy.PolicyArn})
+			_, err = c.iamClient.DeletePolicy(c.context(), &iam.DeletePolicyInput{PolicyArn: policy.PolicyArn})
 			if err != nil {
 				if awserr.IsDeleteConfictException(err) {
 					continue
@@ -219,7 +218,7 @@ type OidcProviderOutput struct {
 
 func (c *awsClient) ListOidcProviders(targetClusterId string, config *cmv1.OidcConfig) ([]OidcProviderOutput, error) {
 	providers := []OidcProviderOutput{}
-	output, err := c.iamClient.ListOpenIDConnectProviders(context.Background(), &iam.ListOpenIDConnectProvidersInput{})
+	output, err := c.iamClient.ListOpenIDConnectProviders(c.context(), &iam.ListOpenIDConnectProvidersInput{})
 	if err != nil {
 		return providers, err
 	}
@@ -230,7 +229,7 @@ func (c *awsClient) ListOidcProviders(targetClusterId string, config *cmv1.OidcC
 		isTruncated := true
 		var marker *string
 		for isTruncated {
-			resp, err := c.iamClient.ListOpenIDConnectProviderTags(context.Background(), &iam.ListOpenIDConnectProviderTagsInput{
+			resp, err := c.iamClient.ListOpenIDConnectProviderTags(c.context(), &iam.ListOpenIDConnectProviderTagsInput{
 				OpenIDConnectProviderArn: provider.Arn,
 				Marker:                   marker,
 			})
@@ -12,16 +12,18 @@ import (
 	"github.com/openshift/rosa/pkg/constants"
 )
 
-var DefaultCacheExpiration = time.Now().Add(30 * time.Minute)
-
 const (
 	GobName = "ocm-cache.gob"
+
+	// DefaultTTL is how long values are kept when no expiration time is given.
+	DefaultTTL = 30 * time.Minute
 )
 
 //go:generate mockgen -source=cache.go -package=cache -destination=./cache_mock.go
 type RosaCache interface {
 	Set(k string, x interface{}, d time.Time)
 	Get(k string) (interface{}, bool)
+	Delete(k string)
 	Items() map[string]Item
 	Dir() (string, error)
 }
@@ -29,30 +31,31 @@ type RosaCache interface {
 var _ RosaCache = &rosaCache{}
 
 type RosaCacheSpec struct {
-	DefaultExpiration time.Time
+	DefaultTTL time.Duration
 }
 
 type rosaCache struct {
-	defaultExpiration time.Time
-	items             map[string]Item
-	mu                sync.RWMutex
+	defaultTTL time.Duration
+	items      map[string]Item
+	mu         sync.RWMutex
 }
 
 func NewRosaCache(spec RosaCacheSpec) RosaCache {
 	cache := &rosaCache{
-		items: make(map[string]Item),
+		items:      make(map[string]Item),
+		defaultTTL: spec.DefaultTTL,
 	}
-	if spec.DefaultExpiration.IsZero() {
-		cache.defaultExpiration = DefaultCacheExpiration
-		return cache
+	if cache.defaultTTL == 0 {
+		cache.defaultTTL = DefaultTTL
 	}
-	cache.defaultExpiration = spec.DefaultExpiration
 	return cache
 }
 
+// Set stores a value that expires at the given time. When the time is zero it expires after the
+// default TTL, counted from now.
 func (c *rosaCache) Set(k string, x interface{}, d time.Time) {
 	if d.IsZero() {
-		d = c.defaultExpiration
+		d = time.Now().Add(c.defaultTTL)
 	}
 	c.mu.Lock()
 	c.items[k] = Item{
@@ -79,6 +82,12 @@ func (c *rosaCache) Get(k string) (interface{}, bool) {
 	return item.Object, true
 }
 
+func (c *rosaCache) Delete(k string) {
+	c.mu.Lock()
+	delete(c.items, k)
+	c.mu.Unlock()
+}
+
 func (c *rosaCache) Items() map[string]Item {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -39,6 +39,18 @@ func (m *MockRosaCache) EXPECT() *MockRosaCacheMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockRosaCache) Delete(k string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", k)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRosaCacheMockRecorder) Delete(k any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRosaCache)(nil).Delete), k)
+}
+
 // Dir mocks base method.
 func (m *MockRosaCache) Dir() (string, error) {
 	m.ctrl.T.Helper()
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"os"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -8,6 +9,13 @@ import (
 )
 
 func TestCache(t *testing.T) {
+	// The concurrency tests run copies of the test binary that only store values in the cache:
+	if worker := os.Getenv(workerEnvVar); worker != "" {
+		if err := runWorker(worker); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Cache Suite")
 }
@@ -51,7 +51,7 @@ var _ = Describe("rosaCache", func() {
 			item, ok := cache.(*rosaCache).items[key]
 			Expect(ok).To(BeTrue())
 			Expect(item.Object).To(Equal(testData))
-			Expect(item.Expiration).To(BeTemporally("~", DefaultCacheExpiration, time.Second))
+			Expect(item.Expiration).To(BeTemporally("~", time.Now().Add(DefaultTTL), time.Second))
 		})
 	})
 
@@ -103,6 +103,19 @@ var _ = Describe("rosaCache", func() {
 		})
 	})
 
+	Context("Delete", func() {
+		It("should remove the value from the cache", func() {
+			key := "testKey"
+			cache.Set(key, testData, time.Now().Add(1*time.Hour))
+
+			cache.Delete(key)
+
+			value, ok := cache.Get(key)
+			Expect(ok).To(BeFalse())
+			Expect(value).To(BeNil())
+		})
+	})
+
 	Context("Dir", func() {
 		When("RosaConfigDir is set", func() {
 			It("should return the custom config directory path", func() {
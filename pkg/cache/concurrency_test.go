@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	workerEnvVar = "ROSA_CACHE_TEST_WORKER"
+
+	// writesPerWorker is the number of values that each goroutine or process stores.
+	writesPerWorker = 20
+)
+
+// runWorker stores values in the cache and reads them back, as a process of a CI job that runs many
+// commands in parallel would do.
+func runWorker(name string) error {
+	for i := 0; i < writesPerWorker; i++ {
+		service, err := NewRosaCacheService()
+		if err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s-%d", name, i)
+		err = service.SetWithTTL(key, []byte(key), time.Hour)
+		if err != nil {
+			return err
+		}
+		value, ok := service.Get(key)
+		if !ok {
+			return fmt.Errorf("Value '%s' wasn't found after storing it", key)
+		}
+		if string(value.([]byte)) != key {
+			return fmt.Errorf("Expected value '%s' but got '%s'", key, value)
+		}
+	}
+	return nil
+}
+
+var _ = Describe("Concurrent access", func() {
+	var filePath string
+
+	BeforeEach(func() {
+		filePath = useTempCacheDir()
+	})
+
+	expectAllValues := func(workers int) {
+		service, err := NewRosaCacheService()
+		Expect(err).NotTo(HaveOccurred())
+		items := service.Items()
+		Expect(items).To(HaveLen(workers * writesPerWorker))
+		for worker := 0; worker < workers; worker++ {
+			for i := 0; i < writesPerWorker; i++ {
+				key := fmt.Sprintf("worker%d-%d", worker, i)
+				Expect(items).To(HaveKey(key))
+				Expect(items[key].Object).To(Equal([]byte(key)))
+			}
+		}
+		Expect(readCacheFile(filePath).Data).To(HaveLen(workers * writesPerWorker))
+	}
+
+	It("should keep all the values stored from many goroutines", func() {
+		const workers = 16
+		var wg sync.WaitGroup
+		errs := make([]error, workers)
+		for worker := 0; worker < workers; worker++ {
+			wg.Add(1)
+			go func(worker int) {
+				defer wg.Done()
+				errs[worker] = runWorker(fmt.Sprintf("worker%d", worker))
+			}(worker)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			Expect(err).NotTo(HaveOccurred())
+		}
+		expectAllValues(workers)
+	})
+
+	It("should keep all the values stored from many processes", func() {
+		const workers = 8
+		executable, err := os.Executable()
+		Expect(err).NotTo(HaveOccurred())
+		commands := make([]*exec.Cmd, workers)
+		outputs := make([]*bytes.Buffer, workers)
+		for worker := 0; worker < workers; worker++ {
+			outputs[worker] = &bytes.Buffer{}
+			// The cache directory is passed in the environment, set by 'useTempCacheDir':
+			command := exec.Command(executable, "-test.run=^TestCache$")
+			command.Env = append(os.Environ(), fmt.Sprintf("%s=worker%d", workerEnvVar, worker))
+			command.Stdout = outputs[worker]
+			command.Stderr = outputs[worker]
+			Expect(command.Start()).To(Succeed())
+			commands[worker] = command
+		}
+		for worker, command := range commands {
+			Expect(command.Wait()).To(Succeed(), "Worker %d failed: %s", worker, outputs[worker].String())
+		}
+		expectAllValues(workers)
+	})
+
+	It("should not let readers see partially written files", func() {
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				data, err := os.ReadFile(filePath)
+				if os.IsNotExist(err) {
+					continue
+				}
+				Expect(err).NotTo(HaveOccurred())
+				Expect(data).NotTo(BeEmpty())
+			}
+		}()
+		err := runWorker("worker0")
+		close(done)
+		wg.Wait()
+		Expect(err).NotTo(HaveOccurred())
+		expectAllValues(1)
+	})
+})
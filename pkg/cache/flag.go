@@ -0,0 +1,28 @@
+package cache
+
+import (
+	"github.com/spf13/pflag"
+)
+
+var disabled bool
+
+// AddFlag adds the flag that disables reading from the cache to the given set of command line flags.
+func AddFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&disabled,
+		"no-cache",
+		false,
+		"Don't use cached OCM data like versions, regions or machine types. The values are fetched "+
+			"again and the cache is refreshed with them.",
+	)
+}
+
+// Disabled returns true if values should be fetched instead of being read from the cache.
+func Disabled() bool {
+	return disabled
+}
+
+// SetDisabled sets the value of the flag that disables reading from the cache.
+func SetDisabled(value bool) {
+	disabled = value
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	// lockTimeout is how long a process waits for another one to release the cache file.
+	lockTimeout = 10 * time.Second
+
+	lockRetryInterval = 10 * time.Millisecond
+)
+
+// fileLock is a lock shared by all the processes that use a cache file. It is taken on a separate
+// file, named after the cache file with a '.lock' suffix, because the cache file itself is replaced
+// on every write.
+type fileLock struct {
+	file *os.File
+}
+
+// lockFile waits until the lock of the given cache file is acquired. Many processes can hold a
+// shared lock at the same time, while an exclusive lock excludes all the others.
+func lockFile(path string, exclusive bool) (*fileLock, error) {
+	file, err := os.OpenFile(path+".lock", os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening cache lock file: %v", err)
+	}
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		locked, err := tryLock(file, exclusive)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("error locking cache file: %v", err)
+		}
+		if locked {
+			return &fileLock{file: file}, nil
+		}
+		if time.Now().After(deadline) {
+			file.Close()
+			return nil, fmt.Errorf("timed out after %s waiting for the lock of cache file '%s'", lockTimeout, path)
+		}
+		time.Sleep(lockRetryInterval)
+	}
+}
+
+// Unlock releases the lock. Closing the file releases it too, so the error of the unlock call
+// itself isn't relevant.
+func (l *fileLock) Unlock() error {
+	_ = unlock(l.file)
+	return l.file.Close()
+}
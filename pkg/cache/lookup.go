@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"strings"
+	"time"
+)
+
+// Lookup describes a kind of value kept in the cache: the name used to build its keys, how long it is
+// kept and how it is converted to and from the bytes stored in the cache file.
+type Lookup[T any] struct {
+	Name   string
+	TTL    time.Duration
+	Encode func(T) ([]byte, error)
+	Decode func([]byte) (T, error)
+}
+
+// Key returns the cache key for the value identified by the given parts, scoped to the given
+// account and server, for example 'versions/-/stable@1a2b3c@https://api.openshift.com'. Empty parts
+// are written as '-'.
+func (l Lookup[T]) Key(scope string, parts ...string) string {
+	elements := []string{l.Name}
+	for _, part := range parts {
+		if part == "" {
+			part = "-"
+		}
+		elements = append(elements, part)
+	}
+	key := strings.Join(elements, "/")
+	if scope == "" {
+		return key
+	}
+	return key + "@" + scope
+}
+
+// Fetch returns the value stored under the given key if it hasn't expired and the cache isn't
+// disabled. Otherwise it calls the fetch function and stores the result. Failures to decode or save
+// the cached value are ignored, as the cache is only an optimization.
+func Fetch[T any](service RosaCacheService, lookup Lookup[T], key string, fetch func() (T, error)) (T, error) {
+	if service == nil {
+		return fetch()
+	}
+	if !Disabled() {
+		if value, ok := service.Get(key); ok {
+			if data, ok := value.([]byte); ok {
+				result, err := lookup.Decode(data)
+				if err == nil {
+					return result, nil
+				}
+			}
+		}
+	}
+	result, err := fetch()
+	if err != nil {
+		return result, err
+	}
+	data, err := lookup.Encode(result)
+	if err == nil {
+		_ = service.SetWithTTL(key, data, lookup.TTL)
+	}
+	return result, nil
+}
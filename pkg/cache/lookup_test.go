@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lookup", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockService *MockRosaCacheService
+		lookup      Lookup[[]string]
+		fetched     int
+	)
+
+	fetch := func() ([]string, error) {
+		fetched++
+		return []string{"fresh"}, nil
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockService = NewMockRosaCacheService(ctrl)
+		lookup = Lookup[[]string]{
+			Name: "values",
+			TTL:  time.Hour,
+			Encode: func(values []string) ([]byte, error) {
+				return []byte(strings.Join(values, ",")), nil
+			},
+			Decode: func(data []byte) ([]string, error) {
+				if len(data) == 0 {
+					return nil, fmt.Errorf("No data")
+				}
+				return strings.Split(string(data), ","), nil
+			},
+		}
+		fetched = 0
+		SetDisabled(false)
+	})
+
+	AfterEach(func() {
+		SetDisabled(false)
+		ctrl.Finish()
+	})
+
+	Context("Key", func() {
+		It("should join the name and the parts and add the server", func() {
+			Expect(lookup.Key("https://api.openshift.com", "a", "", "b")).To(
+				Equal("values/a/-/b@https://api.openshift.com"))
+		})
+
+		It("should omit the server if it is empty", func() {
+			Expect(lookup.Key("")).To(Equal("values"))
+		})
+	})
+
+	Context("Fetch", func() {
+		It("should return the cached value without fetching", func() {
+			mockService.EXPECT().Get("key").Return([]byte("a,b"), true)
+
+			values, err := Fetch(mockService, lookup, "key", fetch)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal([]string{"a", "b"}))
+			Expect(fetched).To(Equal(0))
+		})
+
+		It("should fetch and store the value if it isn't cached", func() {
+			mockService.EXPECT().Get("key").Return(nil, false)
+			mockService.EXPECT().SetWithTTL("key", []byte("fresh"), time.Hour).Return(nil)
+
+			values, err := Fetch(mockService, lookup, "key", fetch)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal([]string{"fresh"}))
+			Expect(fetched).To(Equal(1))
+		})
+
+		It("should fetch the value if the cached one can't be decoded", func() {
+			mockService.EXPECT().Get("key").Return([]byte{}, true)
+			mockService.EXPECT().SetWithTTL("key", []byte("fresh"), time.Hour).Return(nil)
+
+			values, err := Fetch(mockService, lookup, "key", fetch)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal([]string{"fresh"}))
+		})
+
+		It("should fetch and store the value if the cache is disabled", func() {
+			SetDisabled(true)
+			mockService.EXPECT().SetWithTTL("key", []byte("fresh"), time.Hour).Return(nil)
+
+			values, err := Fetch(mockService, lookup, "key", fetch)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal([]string{"fresh"}))
+			Expect(fetched).To(Equal(1))
+		})
+
+		It("should ignore failures to save the value", func() {
+			mockService.EXPECT().Get("key").Return(nil, false)
+			mockService.EXPECT().SetWithTTL("key", []byte("fresh"), time.Hour).Return(fmt.Errorf("Disk full"))
+
+			values, err := Fetch(mockService, lookup, "key", fetch)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal([]string{"fresh"}))
+		})
+
+		It("should not store the value if fetching fails", func() {
+			mockService.EXPECT().Get("key").Return(nil, false)
+
+			_, err := Fetch(mockService, lookup, "key", func() ([]string, error) {
+				return nil, fmt.Errorf("Service unavailable")
+			})
+
+			Expect(err).To(MatchError("Service unavailable"))
+		})
+
+		It("should always fetch without a cache", func() {
+			values, err := Fetch(nil, lookup, "key", fetch)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal([]string{"fresh"}))
+			Expect(fetched).To(Equal(1))
+		})
+	})
+})
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"time"
 )
 
 const (
@@ -20,6 +22,11 @@ type RosaCacheService interface {
 	LoadCache() (RosaCache, error)
 	Get(key string) (interface{}, bool)
 	Set(key string, value []string) error
+	SetWithTTL(key string, value interface{}, ttl time.Duration) error
+	SetAllWithTTL(values map[string]interface{}, ttl time.Duration) error
+	Items() map[string]Item
+	Delete(keys ...string) error
+	Clear() error
 }
 
 var _ RosaCacheService = &rosaCacheService{}
@@ -39,25 +46,107 @@ func NewRosaCacheService() (RosaCacheService, error) {
 	return cache, nil
 }
 
+// LoadCache reads the values stored in the cache file while holding a shared lock on it.
 func (r rosaCacheService) LoadCache() (RosaCache, error) {
 	filePath, err := r.Cache.Dir()
 	if err != nil {
 		return r.Cache, err
 	}
-	file, err := os.OpenFile(filePath, os.O_RDONLY|os.O_CREATE, 0600)
+	lock, err := lockFile(filePath, false)
 	if err != nil {
-		return r.Cache, fmt.Errorf("error opening cache file: %v", err)
+		return r.Cache, err
 	}
-	defer file.Close()
+	defer lock.Unlock()
+	return r.Cache, r.readFile(filePath)
+}
+
+func (r rosaCacheService) Get(key string) (interface{}, bool) {
+	return r.Cache.Get(key)
+}
+
+func (r rosaCacheService) Set(key string, value []string) error {
+	return r.SetWithTTL(key, value, DefaultTTL)
+}
+
+// SetWithTTL stores the value under the given key for the given duration and saves the cache file.
+// The value must be of a type that gob can encode inside an interface, like a string, a slice of
+// strings or a slice of bytes.
+func (r rosaCacheService) SetWithTTL(key string, value interface{}, ttl time.Duration) error {
+	return r.SetAllWithTTL(map[string]interface{}{key: value}, ttl)
+}
+
+// SetAllWithTTL stores the values under their keys for the given duration and saves the cache file
+// once, see SetWithTTL.
+func (r rosaCacheService) SetAllWithTTL(values map[string]interface{}, ttl time.Duration) error {
+	return r.update(func() {
+		expiration := time.Now().Add(ttl)
+		for key, value := range values {
+			r.Cache.Set(key, value, expiration)
+		}
+	})
+}
 
-	stat, err := file.Stat()
+func (r rosaCacheService) Items() map[string]Item {
+	return r.Cache.Items()
+}
+
+// Delete removes the given keys and saves the cache file.
+func (r rosaCacheService) Delete(keys ...string) error {
+	return r.update(func() {
+		for _, key := range keys {
+			r.Cache.Delete(key)
+		}
+	})
+}
+
+// Clear removes all the keys and saves the cache file.
+func (r rosaCacheService) Clear() error {
+	return r.update(func() {
+		for key := range r.Cache.Items() {
+			r.Cache.Delete(key)
+		}
+	})
+}
+
+// update applies a change to the cache and saves it while holding an exclusive lock on the cache
+// file. The values are read again from the file before the change, so that the values stored by
+// other processes since the cache was loaded aren't lost.
+func (r rosaCacheService) update(change func()) error {
+	filePath, err := r.Cache.Dir()
 	if err != nil {
-		return r.Cache, fmt.Errorf("error getting file info: %v", err)
+		return err
 	}
-	if stat.Size() == 0 {
-		return r.Cache, nil
+	lock, err := lockFile(filePath, true)
+	if err != nil {
+		return err
 	}
+	defer lock.Unlock()
 
+	for key := range r.Cache.Items() {
+		r.Cache.Delete(key)
+	}
+	err = r.readFile(filePath)
+	if err != nil {
+		return err
+	}
+	change()
+	return r.saveCache(filePath)
+}
+
+// readFile adds the values stored in the cache file to the cache. A file that can't be decoded,
+// for example because it was truncated by a version that didn't write it atomically, is treated as
+// empty, and it is replaced the next time that the cache is saved.
+func (r rosaCacheService) readFile(filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("error opening cache file: %v", err)
+	}
+	defer file.Close()
+
+	items := map[string]Item{}
 	decoder := gob.NewDecoder(file)
 	for {
 		var cacheData RosaCacheData
@@ -65,44 +154,38 @@ func (r rosaCacheService) LoadCache() (RosaCache, error) {
 			if err == io.EOF {
 				break
 			}
-			return r.Cache, fmt.Errorf("error decoding cache file: %v", err)
+			return nil
 		}
 		for key, item := range cacheData.Data {
-			r.Cache.Set(key, item.Object, item.Expiration)
+			items[key] = item
 		}
 	}
-	return r.Cache, nil
-}
-
-func (r rosaCacheService) Get(key string) (interface{}, bool) {
-	return r.Cache.Get(key)
-}
-
-func (r rosaCacheService) Set(key string, value []string) error {
-	r.Cache.Set(key, value, DefaultCacheExpiration)
-	return r.saveCache()
-}
-
-func (r rosaCacheService) saveCache() error {
-	filePath, err := r.Cache.Dir()
-	if err != nil {
-		return err
+	for key, item := range items {
+		r.Cache.Set(key, item.Object, item.Expiration)
 	}
+	return nil
+}
 
-	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+// saveCache writes the values that haven't expired to a temporary file and then renames it to the
+// cache file, so that readers never see a partially written file.
+func (r rosaCacheService) saveCache(filePath string) error {
+	file, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".*.tmp")
 	if err != nil {
-		return fmt.Errorf("error opening cache file for writing: %v", err)
-	}
-	defer file.Close()
-
-	cacheData := RosaCacheData{Data: make(map[string]Item)}
-	for key, item := range r.Cache.Items() {
-		cacheData.Data[key] = item
+		return fmt.Errorf("error creating temporary cache file: %v", err)
 	}
+	defer os.Remove(file.Name())
 
+	cacheData := RosaCacheData{Data: r.Cache.Items()}
 	encoder := gob.NewEncoder(file)
 	if err := encoder.Encode(cacheData); err != nil {
+		file.Close()
 		return fmt.Errorf("error encoding cache: %v", err)
 	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error writing cache file: %v", err)
+	}
+	if err := os.Rename(file.Name(), filePath); err != nil {
+		return fmt.Errorf("error replacing cache file: %v", err)
+	}
 	return nil
 }
@@ -11,6 +11,7 @@ package cache
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -38,6 +39,38 @@ func (m *MockRosaCacheService) EXPECT() *MockRosaCacheServiceMockRecorder {
 	return m.recorder
 }
 
+// Clear mocks base method.
+func (m *MockRosaCacheService) Clear() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockRosaCacheServiceMockRecorder) Clear() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockRosaCacheService)(nil).Clear))
+}
+
+// Delete mocks base method.
+func (m *MockRosaCacheService) Delete(keys ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRosaCacheServiceMockRecorder) Delete(keys ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRosaCacheService)(nil).Delete), keys...)
+}
+
 // Get mocks base method.
 func (m *MockRosaCacheService) Get(key string) (any, bool) {
 	m.ctrl.T.Helper()
@@ -53,6 +86,20 @@ func (mr *MockRosaCacheServiceMockRecorder) Get(key any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRosaCacheService)(nil).Get), key)
 }
 
+// Items mocks base method.
+func (m *MockRosaCacheService) Items() map[string]Item {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Items")
+	ret0, _ := ret[0].(map[string]Item)
+	return ret0
+}
+
+// Items indicates an expected call of Items.
+func (mr *MockRosaCacheServiceMockRecorder) Items() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Items", reflect.TypeOf((*MockRosaCacheService)(nil).Items))
+}
+
 // LoadCache mocks base method.
 func (m *MockRosaCacheService) LoadCache() (RosaCache, error) {
 	m.ctrl.T.Helper()
@@ -81,3 +128,31 @@ func (mr *MockRosaCacheServiceMockRecorder) Set(key, value any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRosaCacheService)(nil).Set), key, value)
 }
+
+// SetAllWithTTL mocks base method.
+func (m *MockRosaCacheService) SetAllWithTTL(values map[string]any, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAllWithTTL", values, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAllWithTTL indicates an expected call of SetAllWithTTL.
+func (mr *MockRosaCacheServiceMockRecorder) SetAllWithTTL(values, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllWithTTL", reflect.TypeOf((*MockRosaCacheService)(nil).SetAllWithTTL), values, ttl)
+}
+
+// SetWithTTL mocks base method.
+func (m *MockRosaCacheService) SetWithTTL(key string, value any, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWithTTL", key, value, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWithTTL indicates an expected call of SetWithTTL.
+func (mr *MockRosaCacheServiceMockRecorder) SetWithTTL(key, value, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWithTTL", reflect.TypeOf((*MockRosaCacheService)(nil).SetWithTTL), key, value, ttl)
+}
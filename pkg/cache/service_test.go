@@ -3,11 +3,15 @@ package cache
 import (
 	"encoding/gob"
 	"os"
+	"path/filepath"
+	"time"
 
 	"go.uber.org/mock/gomock"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/constants"
 )
 
 var _ = Describe("RosaCacheService", func() {
@@ -35,33 +39,65 @@ var _ = Describe("RosaCacheService", func() {
 
 	Context("saveCache", func() {
 		It("should save cache data to file", func() {
-			mockCacheStore.EXPECT().Dir().Return("/tmp/test_cache.gob", nil)
+			filePath := filepath.Join(GinkgoT().TempDir(), GobName)
 			mockCacheStore.EXPECT().Items().Return(cacheData.Data)
 
 			cacheService := rosaCacheService{Cache: mockCacheStore}
 
-			err := cacheService.saveCache()
+			err := cacheService.saveCache(filePath)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(readCacheFile(filePath)).To(Equal(cacheData))
+		})
+
+		It("should not leave temporary files behind", func() {
+			dir := GinkgoT().TempDir()
+			filePath := filepath.Join(dir, GobName)
+			mockCacheStore.EXPECT().Items().Return(cacheData.Data).Times(2)
+
+			cacheService := rosaCacheService{Cache: mockCacheStore}
+
+			Expect(cacheService.saveCache(filePath)).To(Succeed())
+			Expect(cacheService.saveCache(filePath)).To(Succeed())
+			entries, err := os.ReadDir(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Name()).To(Equal(GobName))
 		})
 	})
 
 	Context("LoadCache", func() {
 		It("should load cache data from file", func() {
-			mockCacheStore.EXPECT().Dir().Return("/tmp/test_cache.gob", nil)
+			filePath := filepath.Join(GinkgoT().TempDir(), GobName)
+			mockCacheStore.EXPECT().Dir().Return(filePath, nil)
 
 			cacheService := rosaCacheService{Cache: mockCacheStore}
 
-			tmpFile, err := os.CreateTemp("", "test_cache.gob")
+			writeCacheFile(filePath, cacheData)
+
+			mockCacheStore.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+
+			_, err := cacheService.LoadCache()
 			Expect(err).NotTo(HaveOccurred())
-			defer tmpFile.Close()
+		})
+
+		It("should load nothing if the file doesn't exist", func() {
+			filePath := filepath.Join(GinkgoT().TempDir(), GobName)
+			mockCacheStore.EXPECT().Dir().Return(filePath, nil)
+
+			cacheService := rosaCacheService{Cache: mockCacheStore}
 
-			encoder := gob.NewEncoder(tmpFile)
-			err = encoder.Encode(cacheData)
+			_, err := cacheService.LoadCache()
 			Expect(err).NotTo(HaveOccurred())
+		})
 
-			mockCacheStore.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+		It("should load nothing if the file is corrupted", func() {
+			filePath := filepath.Join(GinkgoT().TempDir(), GobName)
+			mockCacheStore.EXPECT().Dir().Return(filePath, nil)
+			Expect(os.WriteFile(filePath, []byte("not a gob stream"), 0600)).To(Succeed())
+
+			cacheService := rosaCacheService{Cache: mockCacheStore}
 
-			_, err = cacheService.LoadCache()
+			_, err := cacheService.LoadCache()
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
@@ -81,23 +117,153 @@ var _ = Describe("RosaCacheService", func() {
 	})
 
 	Context("Set", func() {
-		It("should call Set method of RosaCache and return nil", func() {
-			mockCacheStore.EXPECT().Dir().Return("/tmp/test_cache.gob", nil)
-			mockCacheStore.EXPECT().Items().Return(cacheData.Data)
-			mockCacheStore.EXPECT().Set("testKey", []string{"testValue"}, gomock.Any())
+		It("should store the value in the cache file", func() {
+			filePath := useTempCacheDir()
 
-			cacheService := rosaCacheService{Cache: mockCacheStore}
-			err := cacheService.Set("testKey", []string{"testValue"})
+			cacheService, err := NewRosaCacheService()
+			Expect(err).NotTo(HaveOccurred())
+			err = cacheService.Set("testKey", []string{"testValue"})
 
 			Expect(err).ToNot(HaveOccurred())
+			data := readCacheFile(filePath)
+			Expect(data.Data).To(HaveKey("testKey"))
+			Expect(data.Data["testKey"].Object).To(Equal([]string{"testValue"}))
+			Expect(data.Data["testKey"].Expiration).To(BeTemporally("~", time.Now().Add(DefaultTTL), time.Second))
+		})
+	})
+
+	Context("SetWithTTL", func() {
+		It("should set the value with an expiration time after the TTL", func() {
+			filePath := useTempCacheDir()
+
+			cacheService, err := NewRosaCacheService()
+			Expect(err).NotTo(HaveOccurred())
+			err = cacheService.SetWithTTL("testKey", []byte("testValue"), time.Hour)
+
+			Expect(err).ToNot(HaveOccurred())
+			data := readCacheFile(filePath)
+			Expect(data.Data["testKey"].Object).To(Equal([]byte("testValue")))
+			Expect(data.Data["testKey"].Expiration).To(BeTemporally("~", time.Now().Add(time.Hour), time.Second))
+		})
+
+		It("should keep the values stored by others since the cache was loaded", func() {
+			filePath := useTempCacheDir()
+
+			first, err := NewRosaCacheService()
+			Expect(err).NotTo(HaveOccurred())
+			second, err := NewRosaCacheService()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(first.SetWithTTL("first", "1", time.Hour)).To(Succeed())
+			Expect(second.SetWithTTL("second", "2", time.Hour)).To(Succeed())
+
+			Expect(readCacheFile(filePath).Data).To(SatisfyAll(HaveKey("first"), HaveKey("second")))
+			Expect(second.Items()).To(HaveKey("first"))
+		})
+
+		It("should replace a corrupted file", func() {
+			filePath := useTempCacheDir()
+			Expect(os.WriteFile(filePath, []byte("not a gob stream"), 0600)).To(Succeed())
+
+			cacheService, err := NewRosaCacheService()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cacheService.Items()).To(BeEmpty())
+			Expect(cacheService.SetWithTTL("testKey", "testValue", time.Hour)).To(Succeed())
+
+			Expect(readCacheFile(filePath).Data).To(HaveKey("testKey"))
+		})
+	})
+
+	Context("SetAllWithTTL", func() {
+		It("should set all the values with an expiration time after the TTL", func() {
+			filePath := useTempCacheDir()
+
+			cacheService, err := NewRosaCacheService()
+			Expect(err).NotTo(HaveOccurred())
+			err = cacheService.SetAllWithTTL(map[string]interface{}{
+				"first":  []byte("1"),
+				"second": []byte("2"),
+			}, time.Hour)
+
+			Expect(err).ToNot(HaveOccurred())
+			data := readCacheFile(filePath)
+			Expect(data.Data["first"].Object).To(Equal([]byte("1")))
+			Expect(data.Data["second"].Object).To(Equal([]byte("2")))
+			Expect(data.Data["second"].Expiration).To(BeTemporally("~", time.Now().Add(time.Hour), time.Second))
+		})
+	})
+
+	Context("Delete", func() {
+		It("should delete the given keys and save the cache", func() {
+			filePath := useTempCacheDir()
+			writeCacheFile(filePath, cacheData)
+
+			cacheService, err := NewRosaCacheService()
+			Expect(err).NotTo(HaveOccurred())
+			err = cacheService.Delete("key1")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readCacheFile(filePath).Data).To(SatisfyAll(Not(HaveKey("key1")), HaveKey("key2")))
+		})
+
+		It("should not bring back keys deleted by others", func() {
+			filePath := useTempCacheDir()
+			writeCacheFile(filePath, cacheData)
+
+			first, err := NewRosaCacheService()
+			Expect(err).NotTo(HaveOccurred())
+			second, err := NewRosaCacheService()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(first.Delete("key1")).To(Succeed())
+			Expect(second.Delete("key2")).To(Succeed())
+
+			Expect(readCacheFile(filePath).Data).To(BeEmpty())
+		})
+	})
+
+	Context("Clear", func() {
+		It("should delete all the keys and save the cache", func() {
+			filePath := useTempCacheDir()
+			writeCacheFile(filePath, cacheData)
+
+			cacheService, err := NewRosaCacheService()
+			Expect(err).NotTo(HaveOccurred())
+			err = cacheService.Clear()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cacheService.Items()).To(BeEmpty())
+			Expect(readCacheFile(filePath).Data).To(BeEmpty())
 		})
 	})
 
 	Context("NewRosaCacheService", func() {
 		It("should return a new RosaCacheService without error", func() {
+			useTempCacheDir()
 			rosaCacheService, err := NewRosaCacheService()
 			Expect(err).To(BeNil())
 			Expect(rosaCacheService).NotTo(BeNil())
 		})
 	})
 })
+
+// useTempCacheDir makes the cache use a temporary directory and returns the path of the cache file.
+func useTempCacheDir() string {
+	dir := GinkgoT().TempDir()
+	GinkgoT().Setenv(constants.OcmConfig, dir)
+	return filepath.Join(dir, GobName)
+}
+
+func writeCacheFile(filePath string, data RosaCacheData) {
+	file, err := os.Create(filePath)
+	Expect(err).NotTo(HaveOccurred())
+	defer file.Close()
+	Expect(gob.NewEncoder(file).Encode(data)).To(Succeed())
+}
+
+func readCacheFile(filePath string) RosaCacheData {
+	file, err := os.Open(filePath)
+	Expect(err).NotTo(HaveOccurred())
+	defer file.Close()
+	var data RosaCacheData
+	Expect(gob.NewDecoder(file).Decode(&data)).To(Succeed())
+	return data
+}
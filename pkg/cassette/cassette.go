@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cassette records the OCM and AWS requests of a command to a directory, and replays them
+// from it, using the cassette round tripper of the logging package. All the clients of the process
+// share one cassette.
+package cassette
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/openshift/rosa/pkg/logging"
+)
+
+var (
+	lock    sync.Mutex
+	current *logging.Cassette
+	failure error
+)
+
+// Current returns the cassette selected by the flags, or nil if requests are neither recorded nor
+// replayed. The cassette is created the first time it is needed.
+func Current() (*logging.Cassette, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	if !Enabled() {
+		return nil, nil
+	}
+	if current != nil || failure != nil {
+		return current, failure
+	}
+	builder := logging.NewCassette().Logger(logging.NewLogger())
+	if Replaying() {
+		builder.Replay(replayDir)
+	} else {
+		builder.Record(recordDir)
+	}
+	current, failure = builder.Build()
+	return current, failure
+}
+
+// Wrap returns a round tripper that records or replays the requests of the given one, or the given
+// one if requests are neither recorded nor replayed. It has the signature of the transport
+// wrappers of the OCM SDK.
+func Wrap(next http.RoundTripper) http.RoundTripper {
+	cassette, err := Current()
+	if err != nil {
+		return failingRoundTripper{err: err}
+	}
+	if cassette == nil {
+		return next
+	}
+	return cassette.Wrap(next)
+}
+
+// OCMURL returns the URL of the OCM API that the replayed requests were sent to.
+func OCMURL() string {
+	cassette, err := Current()
+	if err != nil || cassette == nil {
+		return ""
+	}
+	for _, exchange := range cassette.Exchanges() {
+		parsed, err := url.Parse(exchange.Request.URL)
+		if err == nil && strings.HasPrefix(parsed.Path, "/api/") {
+			return parsed.Scheme + "://" + parsed.Host
+		}
+	}
+	return ""
+}
+
+// AWSRegion returns the AWS region that the replayed requests were sent to. Requests to global
+// services, like IAM, don't contain the region.
+func AWSRegion() string {
+	cassette, err := Current()
+	if err != nil || cassette == nil {
+		return ""
+	}
+	for _, exchange := range cassette.Exchanges() {
+		parsed, err := url.Parse(exchange.Request.URL)
+		if err != nil {
+			continue
+		}
+		// Regional endpoints look like 'ec2.us-east-1.amazonaws.com':
+		parts := strings.Split(parsed.Hostname(), ".")
+		if len(parts) == 4 && strings.HasSuffix(parsed.Hostname(), ".amazonaws.com") {
+			return parts[1]
+		}
+	}
+	return ""
+}
+
+// Token returns an access token that the OCM SDK accepts while replaying. The replayed responses
+// don't depend on it, the real token isn't recorded.
+func Token() (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"typ":                "Bearer",
+		"iat":                now.Unix(),
+		"exp":                now.Add(time.Hour).Unix(),
+		"preferred_username": "replay",
+		"username":           "replay",
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("replay"))
+}
+
+func reset() {
+	lock.Lock()
+	defer lock.Unlock()
+	current = nil
+	failure = nil
+}
+
+// failingRoundTripper is used when the cassette can't be created, so that the requests fail with
+// the reason instead of being sent without being recorded.
+type failingRoundTripper struct {
+	err error
+}
+
+func (t failingRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
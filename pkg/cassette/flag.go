@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to implement the '--record' and '--replay' command line
+// options.
+
+package cassette
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/redact"
+)
+
+var (
+	recordDir string
+	replayDir string
+)
+
+// AddFlags adds the record and replay flags to the given set of command line flags.
+func AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&recordDir,
+		"record",
+		"",
+		"Write every OCM and AWS request and response, with credentials redacted, to files in "+
+			"this directory. Additional values to redact can be given in the 'rosa/redaction.yaml' "+
+			"file of the user configuration directory, or in the file given in the '"+
+			redact.RulesEnvVar+"' environment variable.",
+	)
+	flags.StringVar(
+		&replayDir,
+		"replay",
+		"",
+		"Answer OCM and AWS requests with the responses recorded with '--record' in this directory, "+
+			"without sending them. No credentials are needed, and requests that weren't recorded fail.",
+	)
+}
+
+// Validate checks that the record and replay flags aren't used together.
+func Validate() error {
+	if recordDir != "" && replayDir != "" {
+		return fmt.Errorf("Flags '--record' and '--replay' can't be used together")
+	}
+	return nil
+}
+
+// Enabled returns true if requests are recorded or replayed.
+func Enabled() bool {
+	return recordDir != "" || replayDir != ""
+}
+
+// Replaying returns true if requests are replayed instead of sent.
+func Replaying() bool {
+	return replayDir != ""
+}
+
+// SetRecordDir sets the directory where requests are recorded.
+func SetRecordDir(value string) {
+	recordDir = value
+	reset()
+}
+
+// SetReplayDir sets the directory where requests are replayed from.
+func SetReplayDir(value string) {
+	replayDir = value
+	reset()
+}
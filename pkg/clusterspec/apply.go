@@ -0,0 +1,588 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file computes and applies the changes that reconcile the day-2 resources of a cluster
+// with the resources listed in a spec document.
+
+package clusterspec
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+type Action string
+
+const (
+	ActionCreate  Action = "create"
+	ActionUpdate  Action = "update"
+	ActionReplace Action = "replace"
+	ActionDelete  Action = "delete"
+)
+
+const (
+	kindMachinePools      = "machinePools"
+	kindIdentityProviders = "identityProviders"
+	kindIngresses         = "ingresses"
+	kindKubeletConfigs    = "kubeletConfigs"
+	kindTuningConfigs     = "tuningConfigs"
+	kindAutoscaler        = "autoscaler"
+)
+
+// Resources are created and updated in this order, and deleted in the reverse order, so that
+// machine pools never reference configs that don't exist.
+var kindOrder = []string{
+	kindKubeletConfigs,
+	kindTuningConfigs,
+	kindMachinePools,
+	kindIdentityProviders,
+	kindIngresses,
+	kindAutoscaler,
+}
+
+// Fields that can be changed without recreating the resource
+var updatableFields = map[string][]string{
+	"machine pool": {"replicas", "autoscaling", "labels", "taints"},
+	"node pool": {"replicas", "autoscaling", "labels", "taints", "autoRepair", "kubeletConfigs",
+		"tuningConfigs"},
+	"ingress": {"private", "loadBalancerType", "routeSelectors", "excludedNamespaces", "wildcardPolicy",
+		"namespaceOwnershipPolicy"},
+	"kubelet config": {"podPidsLimit"},
+	"tuning config":  {"spec"},
+}
+
+var resourcePathRE = regexp.MustCompile(`^resources\.(\w+)(?:\[([^\]]*)\])?(?:\.(\w+))?`)
+
+// Change is a single operation on a day-2 resource of the cluster.
+type Change struct {
+	Action Action   `json:"action"`
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	Fields []string `json:"fields,omitempty"`
+
+	apply func(ctx context.Context, client *ocm.Client, clusterID string) error
+}
+
+func (c *Change) String() string {
+	var prefix string
+	switch c.Action {
+	case ActionCreate:
+		prefix = "+"
+	case ActionUpdate:
+		prefix = "~"
+	case ActionReplace:
+		prefix = "-/+"
+	case ActionDelete:
+		prefix = "-"
+	}
+	result := fmt.Sprintf("%s %s %s '%s'", prefix, c.Action, c.Kind, c.Name)
+	if len(c.Fields) > 0 {
+		result += fmt.Sprintf(": %s", strings.Join(c.Fields, ", "))
+	}
+	return result
+}
+
+// Apply sends the change to OCM.
+func (c *Change) Apply(ctx context.Context, client *ocm.Client, clusterID string) error {
+	err := c.apply(ctx, client, clusterID)
+	if err != nil {
+		return fmt.Errorf("Failed to %s %s '%s': %v", c.Action, c.Kind, c.Name, err)
+	}
+	return nil
+}
+
+// Plan computes the changes that make the day-2 resources of the cluster match the resources of
+// the desired document. Only the resource kinds listed in the document are reconciled, and live
+// resources missing from the document are only deleted when pruning. The cluster settings of the
+// document are ignored.
+func Plan(cluster *cmv1.Cluster, desired *Document, live *LiveResources, prune bool) ([]*Change, error) {
+	if desired.Resources == nil {
+		return nil, nil
+	}
+	differences, err := diffSections(desired, LiveDocument(cluster, live, desired), "resources")
+	if err != nil {
+		return nil, err
+	}
+
+	p := &planner{
+		cluster: cluster,
+		desired: desired.Resources,
+		live:    live,
+		prune:   prune,
+		changes: map[string][]*Change{},
+	}
+	// Group the fields that changed by resource
+	type resource struct {
+		kind   string
+		name   string
+		action Action
+		fields []string
+	}
+	var resources []*resource
+	index := map[string]*resource{}
+	for _, difference := range differences {
+		match := resourcePathRE.FindStringSubmatch(difference.Path)
+		if match == nil {
+			continue
+		}
+		key := match[1] + "/" + match[2]
+		r, ok := index[key]
+		if !ok {
+			r = &resource{kind: match[1], name: match[2]}
+			index[key] = r
+			resources = append(resources, r)
+		}
+		switch difference.Type {
+		case DifferenceMissing:
+			r.action = ActionCreate
+		case DifferenceExtra:
+			r.action = ActionDelete
+		default:
+			r.action = ActionUpdate
+			if match[3] != "" && !helper.Contains(r.fields, match[3]) {
+				r.fields = append(r.fields, match[3])
+			}
+		}
+	}
+
+	// The autoscaler isn't a list, so a missing one is reported as changed fields
+	if desired.Resources.Autoscaler != nil && live.Autoscaler == nil {
+		r, ok := index[kindAutoscaler+"/"]
+		if !ok {
+			r = &resource{kind: kindAutoscaler}
+			resources = append(resources, r)
+		}
+		r.action = ActionCreate
+		r.fields = nil
+	}
+
+	for _, r := range resources {
+		err = p.plan(r.kind, r.name, r.action, r.fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p.ordered(), nil
+}
+
+type planner struct {
+	cluster *cmv1.Cluster
+	desired *Resources
+	live    *LiveResources
+	prune   bool
+	changes map[string][]*Change
+}
+
+func (p *planner) add(kind string, change *Change) {
+	p.changes[kind] = append(p.changes[kind], change)
+}
+
+func (p *planner) ordered() []*Change {
+	var result []*Change
+	var deletes []*Change
+	for _, kind := range kindOrder {
+		for _, change := range p.changes[kind] {
+			if change.Action != ActionDelete {
+				result = append(result, change)
+			}
+		}
+	}
+	for i := len(kindOrder) - 1; i >= 0; i-- {
+		for _, change := range p.changes[kindOrder[i]] {
+			if change.Action == ActionDelete {
+				deletes = append(deletes, change)
+			}
+		}
+	}
+	return append(result, deletes...)
+}
+
+func (p *planner) plan(kind string, name string, action Action, fields []string) error {
+	if action == ActionDelete && !p.prune {
+		return nil
+	}
+	switch kind {
+	case kindMachinePools:
+		if p.cluster.Hypershift().Enabled() {
+			return p.planNodePool(name, action, fields)
+		}
+		return p.planMachinePool(name, action, fields)
+	case kindIdentityProviders:
+		return p.planIdentityProvider(name, action, fields)
+	case kindIngresses:
+		return p.planIngress(name, action, fields)
+	case kindKubeletConfigs:
+		return p.planKubeletConfig(name, action, fields)
+	case kindTuningConfigs:
+		return p.planTuningConfig(name, action, fields)
+	case kindAutoscaler:
+		return p.planAutoscaler(action, fields)
+	}
+	return nil
+}
+
+func checkUpdatable(kind string, name string, fields []string) error {
+	for _, field := range fields {
+		if !helper.Contains(updatableFields[kind], field) {
+			return fmt.Errorf("Field '%s' of %s '%s' can't be updated", field, kind, name)
+		}
+	}
+	return nil
+}
+
+func (p *planner) planMachinePool(name string, action Action, fields []string) error {
+	const kind = "machine pool"
+	if action == ActionUpdate {
+		for _, machinePool := range p.live.MachinePools {
+			if machinePool.ID() == name {
+				_, autoscaled := machinePool.GetAutoscaling()
+				fields = p.disabledAutoscaling(name, fields, autoscaled)
+			}
+		}
+	}
+	change := &Change{Action: action, Kind: kind, Name: name, Fields: fields}
+	switch action {
+	case ActionCreate, ActionUpdate:
+		var selected fieldSelector
+		if action == ActionUpdate {
+			err := checkUpdatable(kind, name, fields)
+			if err != nil {
+				return err
+			}
+			selected = fields
+		}
+		spec := p.desiredMachinePool(name)
+		machinePool, err := spec.machinePool(selected)
+		if err != nil {
+			return err
+		}
+		change.apply = func(_ context.Context, client *ocm.Client, clusterID string) error {
+			if action == ActionCreate {
+				_, err := client.CreateMachinePool(clusterID, machinePool)
+				return err
+			}
+			_, err := client.UpdateMachinePool(clusterID, machinePool)
+			return err
+		}
+	case ActionDelete:
+		change.apply = func(_ context.Context, client *ocm.Client, clusterID string) error {
+			return client.DeleteMachinePool(clusterID, name)
+		}
+	}
+	p.add(kindMachinePools, change)
+	return nil
+}
+
+func (p *planner) planNodePool(name string, action Action, fields []string) error {
+	const kind = "node pool"
+	if action == ActionUpdate {
+		for _, nodePool := range p.live.NodePools {
+			if nodePool.ID() == name {
+				_, autoscaled := nodePool.GetAutoscaling()
+				fields = p.disabledAutoscaling(name, fields, autoscaled)
+			}
+		}
+	}
+	change := &Change{Action: action, Kind: kind, Name: name, Fields: fields}
+	switch action {
+	case ActionCreate, ActionUpdate:
+		var selected fieldSelector
+		if action == ActionUpdate {
+			err := checkUpdatable(kind, name, fields)
+			if err != nil {
+				return err
+			}
+			selected = fields
+		}
+		spec := p.desiredMachinePool(name)
+		nodePool, err := spec.nodePool(p.cluster, selected)
+		if err != nil {
+			return err
+		}
+		change.apply = func(_ context.Context, client *ocm.Client, clusterID string) error {
+			if action == ActionCreate {
+				_, err := client.CreateNodePool(clusterID, nodePool)
+				return err
+			}
+			_, err := client.UpdateNodePool(clusterID, nodePool)
+			return err
+		}
+	case ActionDelete:
+		change.apply = func(_ context.Context, client *ocm.Client, clusterID string) error {
+			return client.DeleteNodePool(clusterID, name)
+		}
+	}
+	p.add(kindMachinePools, change)
+	return nil
+}
+
+func (p *planner) planIdentityProvider(name string, action Action, fields []string) error {
+	// Identity providers can't be updated, so changed ones are deleted and created again
+	if action == ActionUpdate {
+		action = ActionReplace
+	}
+	change := &Change{Action: action, Kind: "identity provider", Name: name, Fields: fields}
+
+	var liveID string
+	for _, idp := range p.live.IdentityProviders {
+		if idp.Name() == name {
+			liveID = idp.ID()
+			if action == ActionReplace && idp.Type() == cmv1.IdentityProviderTypeHtpasswd {
+				// The replacement can't be created while the live one exists:
+				return fmt.Errorf("Identity provider '%s' can't be replaced, because a cluster can only have "+
+					"one htpasswd identity provider. Delete it with 'rosa delete idp %s' and apply the spec "+
+					"file again", name, name)
+			}
+		}
+	}
+	var idp, temporary *cmv1.IdentityProvider
+	if action != ActionDelete {
+		for i := range p.desired.IdentityProviders {
+			if p.desired.IdentityProviders[i].Name == name {
+				spec := p.desired.IdentityProviders[i]
+				var err error
+				idp, err = spec.identityProvider()
+				if err != nil {
+					return err
+				}
+				spec.Name = temporaryIdentityProviderName(name)
+				temporary, err = spec.identityProvider()
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	change.apply = func(_ context.Context, client *ocm.Client, clusterID string) error {
+		switch action {
+		case ActionDelete:
+			return client.DeleteIdentityProvider(clusterID, liveID)
+		case ActionReplace:
+			return replaceIdentityProvider(client, clusterID, liveID, idp, temporary)
+		}
+		_, err := client.CreateIdentityProvider(clusterID, idp)
+		return err
+	}
+	p.add(kindIdentityProviders, change)
+	return nil
+}
+
+// temporaryIdentityProviderName returns the name of the copy of an identity provider that exists
+// while it is replaced.
+func temporaryIdentityProviderName(name string) string {
+	return name + "-replacement"
+}
+
+// replaceIdentityProvider replaces the live identity provider with the desired one. The names of
+// identity providers are unique and can't be changed, so the desired one is first created with a
+// temporary name. That checks that OCM accepts it before the live one is deleted. The callback URL
+// of OAuth providers contains the name, so users can only log in with the temporary copy if its
+// callback URL is also registered in the OAuth application.
+func replaceIdentityProvider(client *ocm.Client, clusterID string, liveID string,
+	idp *cmv1.IdentityProvider, temporary *cmv1.IdentityProvider) error {
+	created, err := client.CreateIdentityProvider(clusterID, temporary)
+	if err != nil {
+		return fmt.Errorf("The new configuration was rejected, the identity provider wasn't changed: %v", err)
+	}
+	err = client.DeleteIdentityProvider(clusterID, liveID)
+	if err != nil {
+		return fmt.Errorf("The identity provider wasn't deleted, its new configuration is available as "+
+			"identity provider '%s': %v", temporary.Name(), err)
+	}
+	_, err = client.CreateIdentityProvider(clusterID, idp)
+	if err != nil {
+		return fmt.Errorf("The identity provider was deleted, its new configuration is available as "+
+			"identity provider '%s': %v", temporary.Name(), err)
+	}
+	err = client.DeleteIdentityProvider(clusterID, created.ID())
+	if err != nil {
+		return fmt.Errorf("Failed to delete temporary identity provider '%s': %v", temporary.Name(), err)
+	}
+	return nil
+}
+
+func (p *planner) planIngress(name string, action Action, fields []string) error {
+	const kind = "ingress"
+	change := &Change{Action: action, Kind: kind, Name: name, Fields: fields}
+	switch action {
+	case ActionCreate:
+		return fmt.Errorf("Ingress '%s' doesn't exist and ingresses can't be created", name)
+	case ActionUpdate:
+		err := checkUpdatable(kind, name, fields)
+		if err != nil {
+			return err
+		}
+		var ingress *cmv1.Ingress
+		for i := range p.desired.Ingresses {
+			if p.desired.Ingresses[i].ID == name {
+				ingress, err = p.desired.Ingresses[i].ingress(fields)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		change.apply = func(_ context.Context, client *ocm.Client, clusterID string) error {
+			_, err := client.UpdateIngress(clusterID, ingress)
+			return err
+		}
+	case ActionDelete:
+		for _, ingress := range p.live.Ingresses {
+			if ingress.ID() == name && ingress.Default() {
+				// The default ingress can't be deleted
+				return nil
+			}
+		}
+		change.apply = func(_ context.Context, client *ocm.Client, clusterID string) error {
+			return client.DeleteIngress(clusterID, name)
+		}
+	}
+	p.add(kindIngresses, change)
+	return nil
+}
+
+func (p *planner) planKubeletConfig(name string, action Action, fields []string) error {
+	const kind = "kubelet config"
+	change := &Change{Action: action, Kind: kind, Name: name, Fields: fields}
+	var spec *KubeletConfigSpec
+	for i := range p.desired.KubeletConfigs {
+		if p.desired.KubeletConfigs[i].Name == name {
+			spec = &p.desired.KubeletConfigs[i]
+		}
+	}
+	var liveID string
+	for _, kubeletConfig := range p.live.KubeletConfigs {
+		if kubeletConfig.Name() == name {
+			liveID = kubeletConfig.ID()
+		}
+	}
+
+	switch action {
+	case ActionCreate:
+		change.apply = func(_ context.Context, client *ocm.Client, clusterID string) error {
+			_, err := client.CreateKubeletConfig(clusterID, spec.args())
+			return err
+		}
+	case ActionUpdate:
+		err := checkUpdatable(kind, name, fields)
+		if err != nil {
+			return err
+		}
+		change.apply = func(ctx context.Context, client *ocm.Client, clusterID string) error {
+			_, err := client.UpdateKubeletConfig(ctx, clusterID, liveID, spec.args())
+			return err
+		}
+	case ActionDelete:
+		change.apply = func(ctx context.Context, client *ocm.Client, clusterID string) error {
+			return client.DeleteKubeletConfigByName(ctx, clusterID, name)
+		}
+	}
+	p.add(kindKubeletConfigs, change)
+	return nil
+}
+
+func (p *planner) planTuningConfig(name string, action Action, fields []string) error {
+	const kind = "tuning config"
+	change := &Change{Action: action, Kind: kind, Name: name, Fields: fields}
+	var liveID string
+	for _, tuningConfig := range p.live.TuningConfigs {
+		if tuningConfig.Name() == name {
+			liveID = tuningConfig.ID()
+		}
+	}
+
+	switch action {
+	case ActionCreate, ActionUpdate:
+		if action == ActionUpdate {
+			err := checkUpdatable(kind, name, fields)
+			if err != nil {
+				return err
+			}
+		}
+		var tuningConfig *cmv1.TuningConfig
+		for i := range p.desired.TuningConfigs {
+			if p.desired.TuningConfigs[i].Name == name {
+				var err error
+				tuningConfig, err = p.desired.TuningConfigs[i].tuningConfig(liveID)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		change.apply = func(_ context.Context, client *ocm.Client, clusterID string) error {
+			if action == ActionCreate {
+				_, err := client.CreateTuningConfig(clusterID, tuningConfig)
+				return err
+			}
+			_, err := client.UpdateTuningConfig(clusterID, tuningConfig)
+			return err
+		}
+	case ActionDelete:
+		change.apply = func(_ context.Context, client *ocm.Client, clusterID string) error {
+			return client.DeleteTuningConfig(clusterID, liveID)
+		}
+	}
+	p.add(kindTuningConfigs, change)
+	return nil
+}
+
+func (p *planner) planAutoscaler(action Action, fields []string) error {
+	if p.cluster.Hypershift().Enabled() {
+		return fmt.Errorf("The autoscaler can only be configured on classic clusters")
+	}
+	config := p.desired.Autoscaler.Config()
+	change := &Change{Action: action, Kind: "autoscaler", Name: p.cluster.Name(), Fields: fields}
+	change.apply = func(_ context.Context, client *ocm.Client, clusterID string) error {
+		if action == ActionCreate {
+			_, err := client.CreateClusterAutoscaler(clusterID, config)
+			return err
+		}
+		_, err := client.UpdateClusterAutoscaler(clusterID, config)
+		return err
+	}
+	p.add(kindAutoscaler, change)
+	return nil
+}
+
+// disabledAutoscaling adds the autoscaling field to the changed fields of an autoscaled pool that
+// the spec gives a fixed number of replicas. The spec doesn't set the autoscaling field, so it isn't
+// compared, but setting the replicas is what disables the autoscaling of the pool.
+func (p *planner) disabledAutoscaling(name string, fields []string, autoscaled bool) []string {
+	if !autoscaled || p.desiredMachinePool(name).Autoscaling != nil || !helper.Contains(fields, "replicas") ||
+		helper.Contains(fields, "autoscaling") {
+		return fields
+	}
+	result := append([]string{"autoscaling"}, fields...)
+	sort.Strings(result)
+	return result
+}
+
+func (p *planner) desiredMachinePool(name string) *MachinePoolSpec {
+	for i := range p.desired.MachinePools {
+		if p.desired.MachinePools[i].Name == name {
+			return &p.desired.MachinePools[i]
+		}
+	}
+	return &MachinePoolSpec{Name: name}
+}
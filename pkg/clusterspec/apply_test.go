@@ -0,0 +1,299 @@
+package clusterspec
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Plan", func() {
+	var cluster *cmv1.Cluster
+	var live *LiveResources
+
+	BeforeEach(func() {
+		cluster = test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateReady)
+		})
+
+		infra, err := cmv1.NewMachinePool().ID("infra").InstanceType("r5.xlarge").Replicas(3).Build()
+		Expect(err).NotTo(HaveOccurred())
+		gpu, err := cmv1.NewMachinePool().ID("gpu").InstanceType("g4dn.xlarge").Replicas(1).Build()
+		Expect(err).NotTo(HaveOccurred())
+		idp, err := cmv1.NewIdentityProvider().ID("idp-1").Name("github-1").Type(cmv1.IdentityProviderTypeGithub).
+			Github(cmv1.NewGithubIdentityProvider().ClientID("abc").Organizations("my-org")).Build()
+		Expect(err).NotTo(HaveOccurred())
+		ingress, err := cmv1.NewIngress().ID("default").Default(true).Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		live = &LiveResources{
+			MachinePools:      []*cmv1.MachinePool{infra, gpu},
+			IdentityProviders: []*cmv1.IdentityProvider{idp},
+			Ingresses:         []*cmv1.Ingress{ingress},
+		}
+	})
+
+	parse := func(data string) *Document {
+		doc, err := Parse([]byte("apiVersion: rosa.openshift.io/v1alpha1\nkind: Cluster\nspec:\n  name: cluster\n" +
+			data))
+		Expect(err).NotTo(HaveOccurred())
+		return doc
+	}
+
+	summarize := func(changes []*Change) []string {
+		result := []string{}
+		for _, change := range changes {
+			result = append(result, change.String())
+		}
+		return result
+	}
+
+	It("Plans nothing when the spec doesn't list resources", func() {
+		changes, err := Plan(cluster, parse(""), live, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("Plans creations and updates, and deletions only when pruning", func() {
+		doc := parse(`
+resources:
+  kubeletConfigs:
+  - name: high-pids
+    podPidsLimit: 8192
+  machinePools:
+  - name: infra
+    replicas: 6
+    labels:
+      role: infra
+  - name: router
+    instanceType: m5.xlarge
+    replicas: 2
+`)
+		changes, err := Plan(cluster, doc, live, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summarize(changes)).To(Equal([]string{
+			"+ create kubelet config 'high-pids'",
+			"~ update machine pool 'infra': labels, replicas",
+			"+ create machine pool 'router'",
+		}))
+
+		changes, err = Plan(cluster, doc, live, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summarize(changes)).To(Equal([]string{
+			"+ create kubelet config 'high-pids'",
+			"~ update machine pool 'infra': labels, replicas",
+			"+ create machine pool 'router'",
+			"- delete machine pool 'gpu'",
+		}))
+	})
+
+	It("Disables the autoscaling of pools that the spec gives a fixed number of replicas", func() {
+		autoscaled, err := cmv1.NewMachinePool().ID("auto").InstanceType("m5.xlarge").
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(4)).Build()
+		Expect(err).NotTo(HaveOccurred())
+		live.MachinePools = append(live.MachinePools, autoscaled)
+
+		changes, err := Plan(cluster, parse(`
+resources:
+  machinePools:
+  - name: auto
+    replicas: 3
+`), live, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summarize(changes)).To(Equal([]string{"~ update machine pool 'auto': autoscaling, replicas"}))
+
+		t := test.NewTestRuntime()
+		t.ApiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch,
+					fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/machine_pools/auto", cluster.ID())),
+				func(_ http.ResponseWriter, request *http.Request) {
+					machinePool, err := cmv1.UnmarshalMachinePool(request.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(machinePool.Replicas()).To(Equal(3))
+					_, ok := machinePool.GetAutoscaling()
+					Expect(ok).To(BeFalse())
+				},
+				RespondWithJSON(http.StatusOK, test.FormatResource(autoscaled)),
+			),
+		)
+		Expect(changes[0].Apply(context.Background(), t.RosaRuntime.OCMClient, cluster.ID())).To(Succeed())
+	})
+
+	It("Updates the default machine pool only when the spec lists it", func() {
+		worker, err := cmv1.NewMachinePool().ID("worker").InstanceType("m5.xlarge").Replicas(2).Build()
+		Expect(err).NotTo(HaveOccurred())
+		live.MachinePools = append(live.MachinePools, worker)
+
+		changes, err := Plan(cluster, parse(`
+resources:
+  machinePools:
+  - name: worker
+    replicas: 3
+  - name: infra
+  - name: gpu
+`), live, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summarize(changes)).To(Equal([]string{"~ update machine pool 'worker': replicas"}))
+
+		changes, err = Plan(cluster, parse(`
+resources:
+  machinePools:
+  - name: infra
+  - name: gpu
+`), live, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("Rejects changes of fields that can't be updated", func() {
+		_, err := Plan(cluster, parse(`
+resources:
+  machinePools:
+  - name: infra
+    instanceType: r5.2xlarge
+`), live, false)
+		Expect(err).To(MatchError("Field 'instanceType' of machine pool 'infra' can't be updated"))
+	})
+
+	It("Replaces identity providers that changed", func() {
+		changes, err := Plan(cluster, parse(`
+resources:
+  identityProviders:
+  - name: github-1
+    type: github
+    clientID: abc
+    clientSecret: secret
+    organizations: [other-org]
+`), live, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summarize(changes)).To(Equal([]string{"-/+ replace identity provider 'github-1': organizations"}))
+	})
+
+	It("Rejects replacing htpasswd identity providers", func() {
+		htpasswd, err := cmv1.NewIdentityProvider().ID("idp-2").Name("htpasswd-1").
+			Type(cmv1.IdentityProviderTypeHtpasswd).MappingMethod(cmv1.IdentityProviderMappingMethodClaim).Build()
+		Expect(err).NotTo(HaveOccurred())
+		live.IdentityProviders = append(live.IdentityProviders, htpasswd)
+
+		_, err = Plan(cluster, parse(`
+resources:
+  identityProviders:
+  - name: htpasswd-1
+    type: htpasswd
+    mappingMethod: lookup
+    users: [user:password]
+`), live, false)
+		Expect(err).To(MatchError(ContainSubstring("Identity provider 'htpasswd-1' can't be replaced, because a " +
+			"cluster can only have one htpasswd identity provider")))
+	})
+
+	Context("Replacing identity providers", func() {
+		var t *test.TestingRuntime
+		var change *Change
+		var path string
+
+		BeforeEach(func() {
+			t = test.NewTestRuntime()
+			changes, err := Plan(cluster, parse(`
+resources:
+  identityProviders:
+  - name: github-1
+    type: github
+    clientID: abc
+    clientSecret: secret
+    organizations: [other-org]
+`), live, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(HaveLen(1))
+			change = changes[0]
+			path = fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/identity_providers", cluster.ID())
+		})
+
+		requests := func() []string {
+			result := []string{}
+			for _, request := range t.ApiServer.ReceivedRequests() {
+				result = append(result, request.Method+" "+request.URL.Path)
+			}
+			return result
+		}
+
+		It("Creates the replacement with a temporary name before deleting the live one", func() {
+			temporary, err := cmv1.NewIdentityProvider().ID("idp-2").Name("github-1-replacement").Build()
+			Expect(err).NotTo(HaveOccurred())
+			replacement, err := cmv1.NewIdentityProvider().ID("idp-3").Name("github-1").Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					func(_ http.ResponseWriter, request *http.Request) {
+						idp, err := cmv1.UnmarshalIdentityProvider(request.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(idp.Name()).To(Equal("github-1-replacement"))
+						Expect(idp.Github().Organizations()).To(Equal([]string{"other-org"}))
+					},
+					RespondWithJSON(http.StatusCreated, test.FormatResource(temporary)),
+				),
+				RespondWithJSON(http.StatusNoContent, ""),
+				RespondWithJSON(http.StatusCreated, test.FormatResource(replacement)),
+				RespondWithJSON(http.StatusNoContent, ""),
+			)
+
+			Expect(change.Apply(context.Background(), t.RosaRuntime.OCMClient, cluster.ID())).To(Succeed())
+			Expect(requests()).To(Equal([]string{
+				"POST " + path,
+				"DELETE " + path + "/idp-1",
+				"POST " + path,
+				"DELETE " + path + "/idp-2",
+			}))
+		})
+
+		It("Doesn't delete the live identity provider when the replacement is rejected", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusBadRequest, `{"kind": "Error", "reason": "Invalid client ID"}`),
+			)
+
+			err := change.Apply(context.Background(), t.RosaRuntime.OCMClient, cluster.ID())
+			Expect(err).To(MatchError("Failed to replace identity provider 'github-1': The new configuration " +
+				"was rejected, the identity provider wasn't changed: Invalid client ID"))
+			Expect(requests()).To(Equal([]string{"POST " + path}))
+		})
+	})
+
+	It("Requires the secrets of identity providers", func() {
+		_, err := Plan(cluster, parse(`
+resources:
+  identityProviders:
+  - name: google-1
+    type: google
+    clientID: abc
+    clientSecret: REDACTED
+`), live, false)
+		Expect(err).To(MatchError("The client secret of identity provider 'google-1' is required"))
+	})
+
+	It("Never deletes the default ingress", func() {
+		changes, err := Plan(cluster, parse(`
+resources:
+  ingresses: []
+`), live, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("Creates the autoscaler when the cluster has none", func() {
+		changes, err := Plan(cluster, parse(`
+resources:
+  autoscaler:
+    maxNodesTotal: 100
+`), live, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summarize(changes)).To(Equal([]string{"+ create autoscaler 'cluster'"}))
+	})
+})
@@ -0,0 +1,274 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file builds OCM objects out of the resources of a spec document. When a list of fields is
+// given only those fields are set, so that the object can be sent as an update.
+
+package clusterspec
+
+import (
+	"fmt"
+	"strings"
+
+	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// fieldSelector reports whether a field has to be set. Without a list of fields every non empty
+// field is set, as when creating a resource.
+type fieldSelector []string
+
+func (f fieldSelector) want(field string, empty bool) bool {
+	if f == nil {
+		return !empty
+	}
+	return helper.Contains(f, field)
+}
+
+func (m *MachinePoolSpec) machinePool(fields fieldSelector) (*cmv1.MachinePool, error) {
+	builder := cmv1.NewMachinePool().ID(m.Name)
+	if fields.want("instanceType", m.InstanceType == "") {
+		builder.InstanceType(m.InstanceType)
+	}
+	if m.Autoscaling != nil && fields.want("autoscaling", false) {
+		builder.Autoscaling(cmv1.NewMachinePoolAutoscaling().
+			MinReplicas(m.Autoscaling.MinReplicas).
+			MaxReplicas(m.Autoscaling.MaxReplicas))
+	} else if fields.want("replicas", m.Autoscaling != nil) || fields.want("autoscaling", true) {
+		// Setting the replicas is what disables the autoscaling of a pool:
+		builder.Replicas(m.Replicas)
+	}
+	if fields.want("labels", len(m.Labels) == 0) {
+		builder.Labels(m.Labels)
+	}
+	if fields.want("taints", len(m.Taints) == 0) {
+		builder.Taints(m.taints()...)
+	}
+	if fields.want("availabilityZone", m.AvailabilityZone == "") {
+		builder.AvailabilityZones(m.AvailabilityZone)
+	}
+	if fields.want("subnet", m.Subnet == "") {
+		builder.Subnets(m.Subnet)
+	}
+	if fields.want("diskSize", m.DiskSize == "") {
+		size, err := ocm.ParseDiskSizeToGigibyte(m.DiskSize)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid disk size of machine pool '%s': %v", m.Name, err)
+		}
+		builder.RootVolume(cmv1.NewRootVolume().AWS(cmv1.NewAWSVolume().Size(size)))
+	}
+	if fields.want("securityGroupIDs", len(m.SecurityGroupIDs) == 0) {
+		builder.AWS(cmv1.NewAWSMachinePool().AdditionalSecurityGroupIds(m.SecurityGroupIDs...))
+	}
+	return builder.Build()
+}
+
+func (m *MachinePoolSpec) nodePool(cluster *cmv1.Cluster, fields fieldSelector) (*cmv1.NodePool, error) {
+	builder := cmv1.NewNodePool().ID(m.Name)
+	if m.Autoscaling != nil && fields.want("autoscaling", false) {
+		builder.Autoscaling(cmv1.NewNodePoolAutoscaling().
+			MinReplica(m.Autoscaling.MinReplicas).
+			MaxReplica(m.Autoscaling.MaxReplicas))
+	} else if fields.want("replicas", m.Autoscaling != nil) || fields.want("autoscaling", true) {
+		// Setting the replicas is what disables the autoscaling of a pool:
+		builder.Replicas(m.Replicas)
+	}
+	if fields.want("labels", len(m.Labels) == 0) {
+		builder.Labels(m.Labels)
+	}
+	if fields.want("taints", len(m.Taints) == 0) {
+		builder.Taints(m.taints()...)
+	}
+	if fields.want("subnet", m.Subnet == "") {
+		builder.Subnet(m.Subnet)
+	}
+	if fields.want("version", m.Version == "") {
+		builder.Version(cmv1.NewVersion().ID(ocm.CreateVersionID(m.Version, cluster.Version().ChannelGroup())))
+	}
+	if m.AutoRepair != nil && fields.want("autoRepair", false) {
+		builder.AutoRepair(*m.AutoRepair)
+	}
+	if fields.want("kubeletConfigs", len(m.KubeletConfigs) == 0) {
+		builder.KubeletConfigs(m.KubeletConfigs...)
+	}
+	if fields.want("tuningConfigs", len(m.TuningConfigs) == 0) {
+		builder.TuningConfigs(m.TuningConfigs...)
+	}
+
+	awsNodePool := cmv1.NewAWSNodePool()
+	if fields.want("instanceType", m.InstanceType == "") {
+		awsNodePool.InstanceType(m.InstanceType)
+	}
+	if fields.want("diskSize", m.DiskSize == "") {
+		size, err := ocm.ParseDiskSizeToGigibyte(m.DiskSize)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid disk size of machine pool '%s': %v", m.Name, err)
+		}
+		awsNodePool.RootVolume(cmv1.NewAWSVolume().Size(size))
+	}
+	if fields.want("securityGroupIDs", len(m.SecurityGroupIDs) == 0) {
+		awsNodePool.AdditionalSecurityGroupIds(m.SecurityGroupIDs...)
+	}
+	if !awsNodePool.Empty() {
+		builder.AWSNodePool(awsNodePool)
+	}
+	return builder.Build()
+}
+
+func (m *MachinePoolSpec) taints() []*cmv1.TaintBuilder {
+	taints := []*cmv1.TaintBuilder{}
+	for _, taint := range m.Taints {
+		taints = append(taints, cmv1.NewTaint().Key(taint.Key).Value(taint.Value).Effect(taint.Effect))
+	}
+	return taints
+}
+
+func (i *IdentityProviderSpec) identityProvider() (*cmv1.IdentityProvider, error) {
+	var idpType cmv1.IdentityProviderType
+	for ocmType, name := range idpTypes {
+		if name == i.Type {
+			idpType = ocmType
+		}
+	}
+	if idpType == "" {
+		return nil, fmt.Errorf("Invalid type '%s' of identity provider '%s'", i.Type, i.Name)
+	}
+
+	mappingMethod := i.MappingMethod
+	if mappingMethod == "" {
+		mappingMethod = string(cmv1.IdentityProviderMappingMethodClaim)
+	}
+	builder := cmv1.NewIdentityProvider().
+		Name(i.Name).
+		Type(idpType).
+		MappingMethod(cmv1.IdentityProviderMappingMethod(mappingMethod))
+
+	if i.Type != "htpasswd" && i.Type != "ldap" && !isSecretSet(i.ClientSecret) {
+		return nil, fmt.Errorf("The client secret of identity provider '%s' is required", i.Name)
+	}
+	switch idpType {
+	case cmv1.IdentityProviderTypeGithub:
+		github := cmv1.NewGithubIdentityProvider().
+			ClientID(i.ClientID).
+			ClientSecret(i.ClientSecret).
+			Hostname(i.Hostname)
+		if len(i.Organizations) > 0 {
+			github.Organizations(i.Organizations...)
+		} else {
+			github.Teams(i.Teams...)
+		}
+		builder.Github(github)
+	case cmv1.IdentityProviderTypeGitlab:
+		builder.Gitlab(cmv1.NewGitlabIdentityProvider().
+			ClientID(i.ClientID).
+			ClientSecret(i.ClientSecret).
+			URL(i.URL))
+	case cmv1.IdentityProviderTypeGoogle:
+		builder.Google(cmv1.NewGoogleIdentityProvider().
+			ClientID(i.ClientID).
+			ClientSecret(i.ClientSecret).
+			HostedDomain(i.HostedDomain))
+	case cmv1.IdentityProviderTypeOpenID:
+		builder.OpenID(cmv1.NewOpenIDIdentityProvider().
+			ClientID(i.ClientID).
+			ClientSecret(i.ClientSecret).
+			Issuer(i.IssuerURL).
+			ExtraScopes(i.ExtraScopes...).
+			Claims(cmv1.NewOpenIDClaims().
+				Email(i.EmailClaims...).
+				Name(i.NameClaims...).
+				PreferredUsername(i.UsernameClaims...).
+				Groups(i.GroupsClaims...)))
+	case cmv1.IdentityProviderTypeLDAP:
+		if i.BindDN != "" && !isSecretSet(i.BindPassword) {
+			return nil, fmt.Errorf("The bind password of identity provider '%s' is required", i.Name)
+		}
+		builder.LDAP(cmv1.NewLDAPIdentityProvider().
+			URL(i.URL).
+			BindDN(i.BindDN).
+			BindPassword(i.BindPassword).
+			Insecure(i.Insecure).
+			Attributes(cmv1.NewLDAPAttributes().
+				ID(i.IDAttributes...).
+				PreferredUsername(i.UsernameAttributes...).
+				Name(i.NameAttributes...).
+				Email(i.EmailAttributes...)))
+	case cmv1.IdentityProviderTypeHtpasswd:
+		if len(i.Users) == 0 {
+			return nil, fmt.Errorf("The users of identity provider '%s' are required", i.Name)
+		}
+		users := []*cmv1.HTPasswdUserBuilder{}
+		for _, user := range i.Users {
+			username, password, found := strings.Cut(user, ":")
+			if !found {
+				return nil, fmt.Errorf("Users of identity provider '%s' should be 'username:password' pairs", i.Name)
+			}
+			hashedPassword, err := idputils.GenerateHTPasswdCompatibleHash(password)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to hash the password of user '%s': %v", username, err)
+			}
+			users = append(users, cmv1.NewHTPasswdUser().Username(username).HashedPassword(hashedPassword))
+		}
+		builder.Htpasswd(cmv1.NewHTPasswdIdentityProvider().Users(cmv1.NewHTPasswdUserList().Items(users...)))
+	}
+	return builder.Build()
+}
+
+func isSecretSet(secret string) bool {
+	return secret != "" && secret != RedactedValue
+}
+
+func (i *IngressSpec) ingress(fields fieldSelector) (*cmv1.Ingress, error) {
+	builder := cmv1.NewIngress().ID(i.ID)
+	if fields.want("private", !i.Private) {
+		if i.Private {
+			builder.Listening(cmv1.ListeningMethodInternal)
+		} else {
+			builder.Listening(cmv1.ListeningMethodExternal)
+		}
+	}
+	if fields.want("loadBalancerType", i.LoadBalancerType == "") {
+		builder.LoadBalancerType(cmv1.LoadBalancerFlavor(i.LoadBalancerType))
+	}
+	if fields.want("routeSelectors", len(i.RouteSelectors) == 0) {
+		builder.RouteSelectors(i.RouteSelectors)
+	}
+	if fields.want("excludedNamespaces", len(i.ExcludedNamespaces) == 0) {
+		builder.ExcludedNamespaces(i.ExcludedNamespaces...)
+	}
+	if fields.want("wildcardPolicy", i.WildcardPolicy == "") {
+		builder.RouteWildcardPolicy(cmv1.WildcardPolicy(i.WildcardPolicy))
+	}
+	if fields.want("namespaceOwnershipPolicy", i.NamespaceOwnershipPolicy == "") {
+		builder.RouteNamespaceOwnershipPolicy(cmv1.NamespaceOwnershipPolicy(i.NamespaceOwnershipPolicy))
+	}
+	return builder.Build()
+}
+
+func (k *KubeletConfigSpec) args() ocm.KubeletConfigArgs {
+	return ocm.KubeletConfigArgs{Name: k.Name, PodPidsLimit: k.PodPidsLimit}
+}
+
+func (t *TuningConfigSpec) tuningConfig(id string) (*cmv1.TuningConfig, error) {
+	builder := cmv1.NewTuningConfig().Name(t.Name).Spec(t.Spec)
+	if id != "" {
+		builder.ID(id)
+	}
+	return builder.Build()
+}
@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file renders a spec document as the sequence of rosa commands that creates it.
+
+package clusterspec
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/rosa/pkg/clusterautoscaler"
+)
+
+var (
+	safeShellValueRE = regexp.MustCompile(`^[A-Za-z0-9_./:=,@%+-]+$`)
+	shellVariableRE  = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+)
+
+// Commands returns the rosa commands that create the cluster and its resources, in the order
+// they have to be run. The returned warnings list the steps that need manual intervention.
+func Commands(doc *Document) ([]string, []string) {
+	var warnings []string
+	cluster := doc.Spec.Name
+
+	commands := []string{renderCommand("rosa create cluster", doc.Spec.Args())}
+	if doc.Resources == nil {
+		return commands, warnings
+	}
+
+	for _, kubeletConfig := range doc.Resources.KubeletConfigs {
+		commands = append(commands, renderCommand("rosa create kubeletconfig", []Arg{
+			{Flag: "cluster", Value: cluster},
+			{Flag: "name", Value: kubeletConfig.Name},
+			{Flag: "pod-pids-limit", Value: strconv.Itoa(kubeletConfig.PodPidsLimit)},
+		}))
+	}
+	for _, tuningConfig := range doc.Resources.TuningConfigs {
+		specPath := fmt.Sprintf("%s.json", tuningConfig.Name)
+		warnings = append(warnings, fmt.Sprintf(
+			"The spec of tuning config '%s' needs to be saved to '%s'", tuningConfig.Name, specPath))
+		commands = append(commands, renderCommand("rosa create tuning-configs", []Arg{
+			{Flag: "cluster", Value: cluster},
+			{Flag: "name", Value: tuningConfig.Name},
+			{Flag: "spec-path", Value: specPath},
+		}))
+	}
+	for _, machinePool := range doc.Resources.MachinePools {
+		commands = append(commands, renderCommand("rosa create machinepool",
+			append([]Arg{{Flag: "cluster", Value: cluster}}, machinePool.Args()...)))
+	}
+	for _, idp := range doc.Resources.IdentityProviders {
+		args := append([]Arg{{Flag: "cluster", Value: cluster}}, idp.Args()...)
+		for i, arg := range args {
+			if arg.Value != RedactedValue {
+				continue
+			}
+			// Secrets aren't exported, so the command reads them from a shell variable
+			variable := secretVariable(idp.Name, arg.Flag)
+			args[i] = Arg{Flag: arg.Flag, Value: variable, Variable: true}
+			warnings = append(warnings, fmt.Sprintf("The '%s' variable needs to be set to the %s of "+
+				"identity provider '%s'", variable, strings.ReplaceAll(arg.Flag, "-", " "), idp.Name))
+		}
+		commands = append(commands, renderCommand("rosa create idp", args))
+	}
+	if doc.Resources.Autoscaler != nil {
+		commands = append(commands,
+			renderCommand("rosa create autoscaler", []Arg{{Flag: "cluster", Value: cluster}})+
+				clusterautoscaler.BuildAutoscalerOptions(doc.Resources.Autoscaler.Config(), ""))
+	}
+	return commands, warnings
+}
+
+// Args returns the 'rosa create machinepool' flags equivalent to the spec, without the cluster.
+func (m *MachinePoolSpec) Args() []Arg {
+	var a argList
+	a.str("name", m.Name)
+	a.str("instance-type", m.InstanceType)
+	if m.Autoscaling != nil {
+		a.flag("enable-autoscaling", true)
+		a.int("min-replicas", m.Autoscaling.MinReplicas)
+		a.int("max-replicas", m.Autoscaling.MaxReplicas)
+	} else {
+		a = append(a, Arg{Flag: "replicas", Value: strconv.Itoa(m.Replicas)})
+	}
+	if len(m.Labels) > 0 {
+		a.str("labels", joinMap(m.Labels))
+	}
+	if len(m.Taints) > 0 {
+		taints := []string{}
+		for _, taint := range m.Taints {
+			taints = append(taints, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+		}
+		a.list("taints", taints)
+	}
+	a.str("availability-zone", m.AvailabilityZone)
+	a.str("subnet", m.Subnet)
+	a.str("disk-size", m.DiskSize)
+	a.list("additional-security-group-ids", m.SecurityGroupIDs)
+	a.str("version", m.Version)
+	if m.AutoRepair != nil {
+		a.str("autorepair", strconv.FormatBool(*m.AutoRepair))
+	}
+	a.list("kubelet-configs", m.KubeletConfigs)
+	a.list("tuning-configs", m.TuningConfigs)
+	return a
+}
+
+// Args returns the 'rosa create idp' flags equivalent to the spec, without the cluster.
+func (i *IdentityProviderSpec) Args() []Arg {
+	var a argList
+	a.str("type", i.Type)
+	a.str("name", i.Name)
+	a.str("mapping-method", i.MappingMethod)
+	a.str("client-id", i.ClientID)
+	a.str("client-secret", i.ClientSecret)
+	a.str("hostname", i.Hostname)
+	a.list("organizations", i.Organizations)
+	a.list("teams", i.Teams)
+	if i.Type == "gitlab" {
+		a.str("host-url", i.URL)
+	} else {
+		a.str("url", i.URL)
+	}
+	a.str("hosted-domain", i.HostedDomain)
+	a.str("issuer-url", i.IssuerURL)
+	a.list("email-claims", i.EmailClaims)
+	a.list("name-claims", i.NameClaims)
+	a.list("username-claims", i.UsernameClaims)
+	a.list("groups-claims", i.GroupsClaims)
+	a.list("extra-scopes", i.ExtraScopes)
+	a.str("bind-dn", i.BindDN)
+	a.str("bind-password", i.BindPassword)
+	a.flag("insecure", i.Insecure)
+	a.list("id-attributes", i.IDAttributes)
+	a.list("username-attributes", i.UsernameAttributes)
+	a.list("name-attributes", i.NameAttributes)
+	a.list("email-attributes", i.EmailAttributes)
+	a.list("users", i.Users)
+	return a
+}
+
+// secretVariable returns the name of the shell variable that holds the value of a secret flag of
+// a resource, for example 'GITHUB_1_CLIENT_SECRET'.
+func secretVariable(name string, flag string) string {
+	variable := strings.ToUpper(shellVariableRE.ReplaceAllString(name+"_"+flag, "_"))
+	if variable[0] >= '0' && variable[0] <= '9' {
+		variable = "_" + variable
+	}
+	return variable
+}
+
+func renderCommand(command string, args []Arg) string {
+	var b strings.Builder
+	b.WriteString(command)
+	for _, arg := range args {
+		if arg.Variable {
+			fmt.Fprintf(&b, ` --%s "$%s"`, arg.Flag, arg.Value)
+			continue
+		}
+		switch arg.Value {
+		case "true":
+			fmt.Fprintf(&b, " --%s", arg.Flag)
+			continue
+		case "false":
+			fmt.Fprintf(&b, " --%s=false", arg.Flag)
+			continue
+		}
+		fmt.Fprintf(&b, " --%s %s", arg.Flag, quote(arg.Value))
+	}
+	return b.String()
+}
+
+func quote(value string) string {
+	if safeShellValueRE.MatchString(value) {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,309 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file compares a desired spec document with the document exported from a live cluster.
+
+package clusterspec
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+type DifferenceType string
+
+const (
+	// DifferenceChanged is a field whose live value doesn't match the desired value
+	DifferenceChanged DifferenceType = "changed"
+	// DifferenceMissing is a resource of the spec that doesn't exist in the cluster
+	DifferenceMissing DifferenceType = "missing"
+	// DifferenceExtra is a resource of the cluster that isn't listed in the spec
+	DifferenceExtra DifferenceType = "extra"
+)
+
+// Difference is a single field or resource that differs between the spec and the cluster.
+type Difference struct {
+	Path    string         `json:"path"`
+	Type    DifferenceType `json:"type"`
+	Desired interface{}    `json:"desired,omitempty"`
+	Live    interface{}    `json:"live,omitempty"`
+}
+
+func (d Difference) String() string {
+	switch d.Type {
+	case DifferenceMissing:
+		return fmt.Sprintf("+ %s: missing from the cluster", d.Path)
+	case DifferenceExtra:
+		return fmt.Sprintf("- %s: not in the spec", d.Path)
+	default:
+		return fmt.Sprintf("~ %s: desired %s, live %s", d.Path, formatValue(d.Desired), formatValue(d.Live))
+	}
+}
+
+// Keys used to match the entries of resource lists between the spec and the cluster
+var listKeys = map[string]string{
+	"resources.machinePools":      "name",
+	"resources.identityProviders": "name",
+	"resources.ingresses":         "id",
+	"resources.kubeletConfigs":    "name",
+	"resources.tuningConfigs":     "name",
+}
+
+// Fields that are compared as a whole instead of key by key
+var atomicFields = map[string]bool{
+	"tags":           true,
+	"labels":         true,
+	"routeSelectors": true,
+	"spec":           true,
+}
+
+// Secrets are never returned by OCM, so they can't be compared. A changed secret alone isn't a
+// difference, so secrets can't be rotated by applying a spec: the resource has to be deleted first.
+var secretFields = map[string]bool{
+	"clientSecret": true,
+	"bindPassword": true,
+	"users":        true,
+}
+
+// LiveDocument returns the document of the live cluster that the desired document is compared with.
+// The default machine pools are described by the cluster settings, so they aren't exported as
+// resources, but they are compared when the desired document lists them.
+func LiveDocument(cluster *cmv1.Cluster, live *LiveResources, desired *Document) *Document {
+	doc, _ := FromCluster(cluster, live)
+	if live == nil || desired.Resources == nil {
+		return doc
+	}
+	listed := map[string]bool{}
+	for _, machinePool := range desired.Resources.MachinePools {
+		listed[machinePool.Name] = true
+	}
+	for _, machinePool := range live.MachinePools {
+		if machinePool.ID() == defaultClassicMachinePool && listed[machinePool.ID()] {
+			doc.Resources.MachinePools = append(doc.Resources.MachinePools, machinePoolFromMachinePool(machinePool))
+		}
+	}
+	for _, nodePool := range live.NodePools {
+		if defaultHostedMachinePoolRE.MatchString(nodePool.ID()) && listed[nodePool.ID()] {
+			doc.Resources.MachinePools = append(doc.Resources.MachinePools, machinePoolFromNodePool(nodePool))
+		}
+	}
+	return doc
+}
+
+// Diff compares the fields set in the desired document with the live document. Fields that the
+// desired document doesn't set are not compared, so a spec can describe part of a cluster. Live
+// resources are only reported as extra when the desired document lists resources of that kind.
+func Diff(desired *Document, live *Document) ([]Difference, error) {
+	return diffSections(desired, live, "spec", "resources")
+}
+
+func diffSections(desired *Document, live *Document, sections ...string) ([]Difference, error) {
+	desiredMap, err := desired.toMap()
+	if err != nil {
+		return nil, err
+	}
+	liveMap, err := live.toMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var differences []Difference
+	for _, section := range sections {
+		desiredSection, ok := desiredMap[section].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		differences = append(differences, diffObject(section, desiredSection, liveMap[section])...)
+	}
+	return differences, nil
+}
+
+// toMap returns the generic representation of the document. When the document was parsed from a
+// file the original content is used, so that fields explicitly set to their zero value are kept.
+func (d *Document) toMap() (map[string]interface{}, error) {
+	var data []byte
+	var err error
+	if d.raw != nil {
+		data, err = yaml.YAMLToJSON(d.raw)
+	} else {
+		data, err = json.Marshal(d)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]interface{}{}
+	err = json.Unmarshal(data, &result)
+	return result, err
+}
+
+func diffValue(path string, desired interface{}, live interface{}) []Difference {
+	if key, ok := listKeys[path]; ok {
+		return diffList(path, key, desired, live)
+	}
+
+	desiredObject, isObject := desired.(map[string]interface{})
+	if isObject && !atomicFields[lastElement(path)] {
+		return diffObject(path, desiredObject, live)
+	}
+
+	if equalValues(desired, live) {
+		return nil
+	}
+	if lastElement(path) == "diskSize" && equalDiskSizes(desired, live) {
+		return nil
+	}
+	return []Difference{{Path: path, Type: DifferenceChanged, Desired: desired, Live: live}}
+}
+
+// equalDiskSizes compares two disk sizes in the units accepted by 'rosa create machinepool', as
+// the live sizes are always exported in GiB.
+func equalDiskSizes(desired interface{}, live interface{}) bool {
+	desiredSize, ok := desired.(string)
+	if !ok {
+		return false
+	}
+	liveSize, ok := live.(string)
+	if !ok {
+		return false
+	}
+	desiredGiB, err := ocm.ParseDiskSizeToGigibyte(desiredSize)
+	if err != nil {
+		return false
+	}
+	liveGiB, err := ocm.ParseDiskSizeToGigibyte(liveSize)
+	return err == nil && desiredGiB == liveGiB
+}
+
+func diffObject(path string, desired map[string]interface{}, live interface{}) []Difference {
+	liveObject, _ := live.(map[string]interface{})
+	var differences []Difference
+	for _, field := range sortedFields(desired) {
+		if secretFields[field] {
+			continue
+		}
+		differences = append(differences, diffValue(path+"."+field, desired[field], liveObject[field])...)
+	}
+	return differences
+}
+
+func diffList(path string, key string, desired interface{}, live interface{}) []Difference {
+	desiredItems := indexList(desired, key)
+	liveItems := indexList(live, key)
+
+	var differences []Difference
+	for _, name := range sortedFields(desiredItems) {
+		itemPath := fmt.Sprintf("%s[%s]", path, name)
+		liveItem, ok := liveItems[name]
+		if !ok {
+			differences = append(differences, Difference{Path: itemPath, Type: DifferenceMissing})
+			continue
+		}
+		differences = append(differences, diffValue(itemPath, desiredItems[name], liveItem)...)
+	}
+	for _, name := range sortedFields(liveItems) {
+		if _, ok := desiredItems[name]; !ok {
+			differences = append(differences, Difference{Path: fmt.Sprintf("%s[%s]", path, name), Type: DifferenceExtra})
+		}
+	}
+	return differences
+}
+
+func indexList(list interface{}, key string) map[string]interface{} {
+	result := map[string]interface{}{}
+	items, _ := list.([]interface{})
+	for _, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		result[fmt.Sprintf("%v", object[key])] = object
+	}
+	return result
+}
+
+// equalValues compares two generic values. Missing values are equal to zero values, and lists of
+// strings are compared regardless of their order.
+func equalValues(desired interface{}, live interface{}) bool {
+	if isZero(desired) && isZero(live) {
+		return true
+	}
+	desiredList, desiredIsList := desired.([]interface{})
+	liveList, liveIsList := live.([]interface{})
+	if desiredIsList && liveIsList {
+		return reflect.DeepEqual(sortedStrings(desiredList), sortedStrings(liveList))
+	}
+	return reflect.DeepEqual(desired, live)
+}
+
+func isZero(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func sortedStrings(values []interface{}) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, fmt.Sprintf("%v", value))
+	}
+	sort.Strings(result)
+	return result
+}
+
+func sortedFields(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for k := range object {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func lastElement(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "<unset>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
@@ -0,0 +1,144 @@
+package clusterspec
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Diff", func() {
+	var live *Document
+
+	BeforeEach(func() {
+		sts := true
+		live = &Document{
+			APIVersion: APIVersion,
+			Kind:       KindCluster,
+			Spec: ClusterSpec{
+				Name:    "mycluster",
+				Region:  "us-east-1",
+				STS:     &sts,
+				MultiAZ: true,
+				Tags:    map[string]string{"owner": "me", "team": "sre"},
+				Compute: &ComputeSpec{MachineType: "m5.xlarge", Replicas: 3},
+				Network: &NetworkSpec{SubnetIDs: []string{"subnet-2", "subnet-1"}},
+			},
+			Resources: &Resources{
+				MachinePools: []MachinePoolSpec{
+					{Name: "infra", InstanceType: "r5.xlarge", Replicas: 3, DiskSize: "1024GiB"},
+					{Name: "gpu", InstanceType: "g4dn.xlarge", Replicas: 1},
+				},
+				IdentityProviders: []IdentityProviderSpec{
+					{Name: "github-1", Type: "github", ClientID: "abc", ClientSecret: RedactedValue},
+				},
+			},
+		}
+	})
+
+	parse := func(data string) *Document {
+		doc, err := Parse([]byte("apiVersion: rosa.openshift.io/v1alpha1\nkind: Cluster\n" + data))
+		Expect(err).NotTo(HaveOccurred())
+		return doc
+	}
+
+	It("Reports no drift when the set fields match", func() {
+		differences, err := Diff(parse(`
+spec:
+  name: mycluster
+  multiAZ: true
+  compute:
+    replicas: 3
+  network:
+    subnetIDs: [subnet-1, subnet-2]
+`), live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(differences).To(BeEmpty())
+	})
+
+	It("Reports changed fields", func() {
+		differences, err := Diff(parse(`
+spec:
+  name: mycluster
+  multiAZ: false
+  tags:
+    owner: me
+  compute:
+    replicas: 2
+`), live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(differences).To(Equal([]Difference{
+			{Path: "spec.compute.replicas", Type: DifferenceChanged, Desired: float64(2), Live: float64(3)},
+			{Path: "spec.multiAZ", Type: DifferenceChanged, Desired: false, Live: true},
+			{Path: "spec.tags", Type: DifferenceChanged,
+				Desired: map[string]interface{}{"owner": "me"},
+				Live:    map[string]interface{}{"owner": "me", "team": "sre"}},
+		}))
+		Expect(differences[0].String()).To(Equal("~ spec.compute.replicas: desired 2, live 3"))
+	})
+
+	It("Reports missing and extra resources", func() {
+		differences, err := Diff(parse(`
+spec:
+  name: mycluster
+resources:
+  machinePools:
+  - name: infra
+    instanceType: r5.2xlarge
+  - name: router
+    replicas: 2
+  identityProviders:
+  - name: github-1
+    type: github
+    clientID: abc
+    clientSecret: secret
+`), live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(differences).To(Equal([]Difference{
+			{Path: "resources.machinePools[infra].instanceType", Type: DifferenceChanged,
+				Desired: "r5.2xlarge", Live: "r5.xlarge"},
+			{Path: "resources.machinePools[router]", Type: DifferenceMissing},
+			{Path: "resources.machinePools[gpu]", Type: DifferenceExtra},
+		}))
+		Expect(differences[1].String()).To(Equal("+ resources.machinePools[router]: missing from the cluster"))
+		Expect(differences[2].String()).To(Equal("- resources.machinePools[gpu]: not in the spec"))
+	})
+
+	It("Compares disk sizes regardless of their units", func() {
+		differences, err := Diff(parse(`
+spec:
+  name: mycluster
+resources:
+  machinePools:
+  - name: infra
+    diskSize: 1TiB
+  - name: gpu
+`), live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(differences).To(BeEmpty())
+
+		differences, err = Diff(parse(`
+spec:
+  name: mycluster
+resources:
+  machinePools:
+  - name: infra
+    diskSize: 500 GiB
+  - name: gpu
+`), live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(differences).To(Equal([]Difference{
+			{Path: "resources.machinePools[infra].diskSize", Type: DifferenceChanged,
+				Desired: "500 GiB", Live: "1024GiB"},
+		}))
+	})
+
+	It("Ignores resource kinds that the spec doesn't list", func() {
+		differences, err := Diff(parse(`
+spec:
+  name: mycluster
+resources:
+  kubeletConfigs: []
+`), live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(differences).To(BeEmpty())
+	})
+})
@@ -0,0 +1,412 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file builds spec documents out of live clusters.
+
+package clusterspec
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// RedactedValue is written in place of secrets that OCM doesn't return.
+const RedactedValue = "REDACTED"
+
+const defaultClassicMachinePool = "worker"
+
+var defaultHostedMachinePoolRE = regexp.MustCompile(`^workers(-[0-9]+)?$`)
+
+var idpTypes = map[cmv1.IdentityProviderType]string{
+	cmv1.IdentityProviderTypeGithub:   "github",
+	cmv1.IdentityProviderTypeGitlab:   "gitlab",
+	cmv1.IdentityProviderTypeGoogle:   "google",
+	cmv1.IdentityProviderTypeHtpasswd: "htpasswd",
+	cmv1.IdentityProviderTypeLDAP:     "ldap",
+	cmv1.IdentityProviderTypeOpenID:   "openid",
+}
+
+// FromCluster builds a spec document equivalent to the live cluster and its resources. The
+// returned warnings list every setting that can't be reproduced from the document.
+func FromCluster(cluster *cmv1.Cluster, live *LiveResources) (*Document, []string) {
+	var warnings []string
+	warn := func(format string, a ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(format, a...))
+	}
+
+	doc := &Document{
+		APIVersion: APIVersion,
+		Kind:       KindCluster,
+		Spec:       clusterSpecFromCluster(cluster, warn),
+	}
+	if live == nil {
+		return doc, warnings
+	}
+
+	isHostedCP := cluster.Hypershift().Enabled()
+	resources := &Resources{}
+	for _, machinePool := range live.MachinePools {
+		if machinePool.ID() == defaultClassicMachinePool {
+			continue
+		}
+		resources.MachinePools = append(resources.MachinePools, machinePoolFromMachinePool(machinePool))
+	}
+	for _, nodePool := range live.NodePools {
+		if defaultHostedMachinePoolRE.MatchString(nodePool.ID()) {
+			continue
+		}
+		resources.MachinePools = append(resources.MachinePools, machinePoolFromNodePool(nodePool))
+	}
+	for _, idp := range live.IdentityProviders {
+		resources.IdentityProviders = append(resources.IdentityProviders, identityProviderFromIDP(idp, warn))
+	}
+	for _, ingress := range live.Ingresses {
+		spec := ingressFromIngress(ingress)
+		if spec.Default {
+			doc.Spec.DefaultIngress = defaultIngressFromIngress(spec)
+		} else {
+			warn("Ingress '%s' is not the default ingress and can't be created with rosa", ingress.ID())
+		}
+		resources.Ingresses = append(resources.Ingresses, spec)
+	}
+	for _, kubeletConfig := range live.KubeletConfigs {
+		resources.KubeletConfigs = append(resources.KubeletConfigs, KubeletConfigSpec{
+			Name:         kubeletConfig.Name(),
+			PodPidsLimit: kubeletConfig.PodPidsLimit(),
+		})
+	}
+	for _, tuningConfig := range live.TuningConfigs {
+		spec, _ := tuningConfig.Spec().(map[string]interface{})
+		resources.TuningConfigs = append(resources.TuningConfigs, TuningConfigSpec{
+			Name: tuningConfig.Name(),
+			Spec: spec,
+		})
+	}
+	if live.Autoscaler != nil && !isHostedCP {
+		resources.Autoscaler = autoscalerFromAutoscaler(live.Autoscaler, warn)
+	}
+	doc.Resources = resources
+	return doc, warnings
+}
+
+func clusterSpecFromCluster(cluster *cmv1.Cluster, warn func(string, ...interface{})) ClusterSpec {
+	isHostedCP := cluster.Hypershift().Enabled()
+	awsCluster := cluster.AWS()
+	sts := awsCluster.STS().RoleARN() != ""
+
+	spec := ClusterSpec{
+		Name:                      cluster.Name(),
+		DomainPrefix:              cluster.DomainPrefix(),
+		Region:                    cluster.Region().ID(),
+		Version:                   cluster.Version().RawID(),
+		HostedCP:                  isHostedCP,
+		STS:                       &sts,
+		MultiAZ:                   cluster.MultiAZ(),
+		FIPS:                      cluster.FIPS(),
+		EtcdEncryption:            cluster.EtcdEncryption(),
+		EtcdEncryptionKMSArn:      awsCluster.EtcdEncryption().KMSKeyARN(),
+		KMSKeyArn:                 awsCluster.KMSKeyArn(),
+		DisableWorkloadMonitoring: cluster.DisableUserWorkloadMonitoring(),
+		Ec2MetadataHttpTokens:     string(awsCluster.Ec2MetadataHttpTokens()),
+		BillingAccount:            awsCluster.BillingAccountID(),
+		AuditLogRoleARN:           awsCluster.AuditLog().RoleArn(),
+		Tags:                      awsCluster.Tags(),
+	}
+	if spec.Ec2MetadataHttpTokens == string(cmv1.Ec2MetadataHttpTokensOptional) {
+		spec.Ec2MetadataHttpTokens = ""
+	}
+	if channelGroup := cluster.Version().ChannelGroup(); channelGroup != ocm.DefaultChannelGroup {
+		spec.ChannelGroup = channelGroup
+	}
+	if awsCluster.PrivateLink() {
+		if isHostedCP {
+			spec.Private = true
+		} else {
+			spec.PrivateLink = true
+		}
+	} else if cluster.API().Listening() == cmv1.ListeningMethodInternal {
+		spec.Private = true
+	}
+
+	if sts {
+		stsCluster := awsCluster.STS()
+		spec.Roles = &RolesSpec{
+			InstallerRoleARN:    stsCluster.RoleARN(),
+			SupportRoleARN:      stsCluster.SupportRoleARN(),
+			ControlPlaneRoleARN: stsCluster.InstanceIAMRoles().MasterRoleARN(),
+			WorkerRoleARN:       stsCluster.InstanceIAMRoles().WorkerRoleARN(),
+			ExternalID:          stsCluster.ExternalID(),
+			OperatorRolesPrefix: stsCluster.OperatorRolePrefix(),
+			PermissionsBoundary: stsCluster.PermissionBoundary(),
+			OIDCConfigID:        stsCluster.OidcConfig().ID(),
+		}
+	} else {
+		warn("The AWS credentials of non-STS cluster '%s' are not exported", cluster.Name())
+	}
+
+	nodes := cluster.Nodes()
+	spec.Compute = &ComputeSpec{
+		MachineType:      nodes.ComputeMachineType().ID(),
+		Labels:           nodes.ComputeLabels(),
+		SecurityGroupIDs: awsCluster.AdditionalComputeSecurityGroupIds(),
+	}
+	if autoscaling, ok := nodes.GetAutoscaleCompute(); ok {
+		spec.Compute.Autoscaling = &AutoscalingSpec{
+			MinReplicas: autoscaling.MinReplicas(),
+			MaxReplicas: autoscaling.MaxReplicas(),
+		}
+	} else {
+		spec.Compute.Replicas = nodes.Compute()
+	}
+	if size := nodes.ComputeRootVolume().AWS().Size(); size != 0 {
+		spec.Compute.DiskSize = fmt.Sprintf("%dGiB", size)
+	}
+	if len(awsCluster.AdditionalInfraSecurityGroupIds()) > 0 ||
+		len(awsCluster.AdditionalControlPlaneSecurityGroupIds()) > 0 {
+		warn("Additional infra and control plane security groups are not part of the spec")
+	}
+
+	network := cluster.Network()
+	spec.Network = &NetworkSpec{
+		Type:        network.Type(),
+		MachineCIDR: network.MachineCIDR(),
+		ServiceCIDR: network.ServiceCIDR(),
+		PodCIDR:     network.PodCIDR(),
+		HostPrefix:  network.HostPrefix(),
+		SubnetIDs:   awsCluster.SubnetIDs(),
+	}
+	if len(spec.Network.SubnetIDs) == 0 {
+		spec.Network.AvailabilityZones = nodes.AvailabilityZones()
+	}
+
+	if proxy, ok := cluster.GetProxy(); ok {
+		spec.Proxy = &ProxySpec{
+			HTTPProxy:  proxy.HTTPProxy(),
+			HTTPSProxy: proxy.HTTPSProxy(),
+		}
+		if proxy.NoProxy() != "" {
+			spec.Proxy.NoProxy = strings.Split(proxy.NoProxy(), ",")
+		}
+	}
+	if cluster.AdditionalTrustBundle() != "" {
+		warn("The additional trust bundle must be saved to a file and set in 'proxy.additionalTrustBundleFile'")
+	}
+
+	if awsCluster.PrivateHostedZoneID() != "" {
+		spec.SharedVPC = &SharedVPCSpec{
+			PrivateHostedZoneID: awsCluster.PrivateHostedZoneID(),
+			RoleARN:             awsCluster.PrivateHostedZoneRoleARN(),
+			BaseDomain:          cluster.DNS().BaseDomain(),
+		}
+	}
+
+	if len(awsCluster.AdditionalAllowedPrincipals()) > 0 {
+		warn("Additional allowed principals are not part of the spec")
+	}
+	if _, ok := cluster.GetExpirationTimestamp(); ok {
+		warn("The expiration time of cluster '%s' is not exported", cluster.Name())
+	}
+	return spec
+}
+
+func machinePoolFromMachinePool(machinePool *cmv1.MachinePool) MachinePoolSpec {
+	spec := MachinePoolSpec{
+		Name:             machinePool.ID(),
+		InstanceType:     machinePool.InstanceType(),
+		Labels:           machinePool.Labels(),
+		Taints:           taintsFromTaints(machinePool.Taints()),
+		SecurityGroupIDs: machinePool.AWS().AdditionalSecurityGroupIds(),
+	}
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		spec.Autoscaling = &AutoscalingSpec{
+			MinReplicas: autoscaling.MinReplicas(),
+			MaxReplicas: autoscaling.MaxReplicas(),
+		}
+	} else {
+		spec.Replicas = machinePool.Replicas()
+	}
+	if len(machinePool.AvailabilityZones()) == 1 {
+		spec.AvailabilityZone = machinePool.AvailabilityZones()[0]
+	}
+	if len(machinePool.Subnets()) == 1 {
+		spec.Subnet = machinePool.Subnets()[0]
+		spec.AvailabilityZone = ""
+	}
+	if size := machinePool.RootVolume().AWS().Size(); size != 0 {
+		spec.DiskSize = fmt.Sprintf("%dGiB", size)
+	}
+	return spec
+}
+
+func machinePoolFromNodePool(nodePool *cmv1.NodePool) MachinePoolSpec {
+	autoRepair := nodePool.AutoRepair()
+	spec := MachinePoolSpec{
+		Name:             nodePool.ID(),
+		InstanceType:     nodePool.AWSNodePool().InstanceType(),
+		Labels:           nodePool.Labels(),
+		Taints:           taintsFromTaints(nodePool.Taints()),
+		Subnet:           nodePool.Subnet(),
+		SecurityGroupIDs: nodePool.AWSNodePool().AdditionalSecurityGroupIds(),
+		Version:          nodePool.Version().RawID(),
+		AutoRepair:       &autoRepair,
+		KubeletConfigs:   nodePool.KubeletConfigs(),
+		TuningConfigs:    nodePool.TuningConfigs(),
+	}
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		spec.Autoscaling = &AutoscalingSpec{
+			MinReplicas: autoscaling.MinReplica(),
+			MaxReplicas: autoscaling.MaxReplica(),
+		}
+	} else {
+		spec.Replicas = nodePool.Replicas()
+	}
+	if size := nodePool.AWSNodePool().RootVolume().Size(); size != 0 {
+		spec.DiskSize = fmt.Sprintf("%dGiB", size)
+	}
+	return spec
+}
+
+func taintsFromTaints(taints []*cmv1.Taint) []TaintSpec {
+	var specs []TaintSpec
+	for _, taint := range taints {
+		specs = append(specs, TaintSpec{Key: taint.Key(), Value: taint.Value(), Effect: taint.Effect()})
+	}
+	return specs
+}
+
+func identityProviderFromIDP(idp *cmv1.IdentityProvider, warn func(string, ...interface{})) IdentityProviderSpec {
+	spec := IdentityProviderSpec{
+		Name:          idp.Name(),
+		Type:          idpTypes[idp.Type()],
+		MappingMethod: string(idp.MappingMethod()),
+	}
+	if spec.MappingMethod == string(cmv1.IdentityProviderMappingMethodClaim) {
+		spec.MappingMethod = ""
+	}
+
+	switch idp.Type() {
+	case cmv1.IdentityProviderTypeGithub:
+		spec.ClientID = idp.Github().ClientID()
+		spec.Hostname = idp.Github().Hostname()
+		spec.Organizations = idp.Github().Organizations()
+		spec.Teams = idp.Github().Teams()
+	case cmv1.IdentityProviderTypeGitlab:
+		spec.ClientID = idp.Gitlab().ClientID()
+		spec.URL = idp.Gitlab().URL()
+	case cmv1.IdentityProviderTypeGoogle:
+		spec.ClientID = idp.Google().ClientID()
+		spec.HostedDomain = idp.Google().HostedDomain()
+	case cmv1.IdentityProviderTypeOpenID:
+		spec.ClientID = idp.OpenID().ClientID()
+		spec.IssuerURL = idp.OpenID().Issuer()
+		spec.EmailClaims = idp.OpenID().Claims().Email()
+		spec.NameClaims = idp.OpenID().Claims().Name()
+		spec.UsernameClaims = idp.OpenID().Claims().PreferredUsername()
+		spec.GroupsClaims = idp.OpenID().Claims().Groups()
+		spec.ExtraScopes = idp.OpenID().ExtraScopes()
+	case cmv1.IdentityProviderTypeLDAP:
+		spec.URL = idp.LDAP().URL()
+		spec.BindDN = idp.LDAP().BindDN()
+		spec.Insecure = idp.LDAP().Insecure()
+		spec.IDAttributes = idp.LDAP().Attributes().ID()
+		spec.UsernameAttributes = idp.LDAP().Attributes().PreferredUsername()
+		spec.NameAttributes = idp.LDAP().Attributes().Name()
+		spec.EmailAttributes = idp.LDAP().Attributes().Email()
+		if spec.BindDN != "" {
+			spec.BindPassword = RedactedValue
+			warn("The bind password of identity provider '%s' is not exported", idp.Name())
+		}
+	case cmv1.IdentityProviderTypeHtpasswd:
+		warn("The users of htpasswd identity provider '%s' are not exported", idp.Name())
+	}
+
+	if spec.ClientID != "" {
+		spec.ClientSecret = RedactedValue
+		warn("The client secret of identity provider '%s' is not exported", idp.Name())
+	}
+	return spec
+}
+
+func ingressFromIngress(ingress *cmv1.Ingress) IngressSpec {
+	return IngressSpec{
+		ID:                       ingress.ID(),
+		Default:                  ingress.Default(),
+		Private:                  ingress.Listening() == cmv1.ListeningMethodInternal,
+		LoadBalancerType:         string(ingress.LoadBalancerType()),
+		RouteSelectors:           ingress.RouteSelectors(),
+		ExcludedNamespaces:       ingress.ExcludedNamespaces(),
+		WildcardPolicy:           string(ingress.RouteWildcardPolicy()),
+		NamespaceOwnershipPolicy: string(ingress.RouteNamespaceOwnershipPolicy()),
+	}
+}
+
+func defaultIngressFromIngress(ingress IngressSpec) *DefaultIngressSpec {
+	spec := &DefaultIngressSpec{
+		RouteSelectors:           ingress.RouteSelectors,
+		ExcludedNamespaces:       ingress.ExcludedNamespaces,
+		WildcardPolicy:           ingress.WildcardPolicy,
+		NamespaceOwnershipPolicy: ingress.NamespaceOwnershipPolicy,
+	}
+	if reflect.DeepEqual(spec, &DefaultIngressSpec{}) {
+		return nil
+	}
+	return spec
+}
+
+func autoscalerFromAutoscaler(autoscaler *cmv1.ClusterAutoscaler,
+	warn func(string, ...interface{})) *AutoscalerSpec {
+	spec := &AutoscalerSpec{
+		BalanceSimilarNodeGroups:    autoscaler.BalanceSimilarNodeGroups(),
+		SkipNodesWithLocalStorage:   autoscaler.SkipNodesWithLocalStorage(),
+		LogVerbosity:                autoscaler.LogVerbosity(),
+		MaxPodGracePeriod:           autoscaler.MaxPodGracePeriod(),
+		PodPriorityThreshold:        autoscaler.PodPriorityThreshold(),
+		IgnoreDaemonsetsUtilization: autoscaler.IgnoreDaemonsetsUtilization(),
+		MaxNodeProvisionTime:        autoscaler.MaxNodeProvisionTime(),
+		BalancingIgnoredLabels:      autoscaler.BalancingIgnoredLabels(),
+	}
+	if limits, ok := autoscaler.GetResourceLimits(); ok {
+		spec.MaxNodesTotal = limits.MaxNodesTotal()
+		if cores, ok := limits.GetCores(); ok {
+			spec.Cores = &ResourceRangeSpec{Min: cores.Min(), Max: cores.Max()}
+		}
+		if memory, ok := limits.GetMemory(); ok {
+			spec.Memory = &ResourceRangeSpec{Min: memory.Min(), Max: memory.Max()}
+		}
+		if len(limits.GPUS()) > 0 {
+			warn("GPU limits of the autoscaler are not part of the spec")
+		}
+	}
+	if scaleDown, ok := autoscaler.GetScaleDown(); ok {
+		threshold, _ := strconv.ParseFloat(scaleDown.UtilizationThreshold(), 64)
+		spec.ScaleDown = &AutoscalerScaleDown{
+			Enabled:              scaleDown.Enabled(),
+			UnneededTime:         scaleDown.UnneededTime(),
+			UtilizationThreshold: threshold,
+			DelayAfterAdd:        scaleDown.DelayAfterAdd(),
+			DelayAfterDelete:     scaleDown.DelayAfterDelete(),
+			DelayAfterFailure:    scaleDown.DelayAfterFailure(),
+		}
+	}
+	return spec
+}
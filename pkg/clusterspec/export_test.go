@@ -0,0 +1,115 @@
+package clusterspec
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Export", func() {
+	var cluster *cmv1.Cluster
+	var live *LiveResources
+
+	BeforeEach(func() {
+		cluster = test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.Region(cmv1.NewCloudRegion().ID("us-east-1"))
+			c.Version(cmv1.NewVersion().RawID("4.14.10").ChannelGroup("stable"))
+			c.MultiAZ(true)
+			c.AWS(cmv1.NewAWS().
+				SubnetIDs("subnet-1", "subnet-2", "subnet-3", "subnet-4", "subnet-5", "subnet-6").
+				STS(cmv1.NewSTS().
+					RoleARN("arn:aws:iam::123:role/Installer").
+					SupportRoleARN("arn:aws:iam::123:role/Support").
+					OperatorRolePrefix("prefix").
+					InstanceIAMRoles(cmv1.NewInstanceIAMRoles().
+						MasterRoleARN("arn:aws:iam::123:role/ControlPlane").
+						WorkerRoleARN("arn:aws:iam::123:role/Worker"))))
+			c.Nodes(cmv1.NewClusterNodes().
+				ComputeMachineType(cmv1.NewMachineType().ID("m5.xlarge")).
+				Compute(3))
+			c.Network(cmv1.NewNetwork().MachineCIDR("10.0.0.0/16").HostPrefix(23))
+		})
+
+		infra, err := cmv1.NewMachinePool().ID("infra").InstanceType("r5.xlarge").Replicas(3).
+			Labels(map[string]string{"role": "infra"}).
+			Taints(cmv1.NewTaint().Key("infra").Value("true").Effect("NoSchedule")).Build()
+		Expect(err).NotTo(HaveOccurred())
+		worker, err := cmv1.NewMachinePool().ID("worker").Replicas(3).Build()
+		Expect(err).NotTo(HaveOccurred())
+		idp, err := cmv1.NewIdentityProvider().Name("github-1").Type(cmv1.IdentityProviderTypeGithub).
+			MappingMethod(cmv1.IdentityProviderMappingMethodClaim).
+			Github(cmv1.NewGithubIdentityProvider().ClientID("abc").Organizations("my-org")).Build()
+		Expect(err).NotTo(HaveOccurred())
+		ingress, err := cmv1.NewIngress().ID("default").Default(true).
+			RouteWildcardPolicy(cmv1.WildcardPolicyWildcardsAllowed).Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		live = &LiveResources{
+			MachinePools:      []*cmv1.MachinePool{worker, infra},
+			IdentityProviders: []*cmv1.IdentityProvider{idp},
+			Ingresses:         []*cmv1.Ingress{ingress},
+		}
+	})
+
+	It("Builds a valid spec from the cluster", func() {
+		doc, _ := FromCluster(cluster, live)
+		Expect(doc.Spec.Validate()).To(Succeed())
+		Expect(doc.Spec.Name).To(Equal(test.MockClusterName))
+		Expect(*doc.Spec.STS).To(BeTrue())
+		Expect(doc.Spec.Roles.OperatorRolesPrefix).To(Equal("prefix"))
+		Expect(doc.Spec.Compute.Replicas).To(Equal(3))
+		Expect(doc.Spec.Network.SubnetIDs).To(HaveLen(6))
+		Expect(doc.Spec.ChannelGroup).To(BeEmpty())
+		Expect(doc.Spec.DefaultIngress.WildcardPolicy).To(Equal("WildcardsAllowed"))
+	})
+
+	It("Skips the default machine pool", func() {
+		doc, _ := FromCluster(cluster, live)
+		Expect(doc.Resources.MachinePools).To(HaveLen(1))
+		Expect(doc.Resources.MachinePools[0].Name).To(Equal("infra"))
+	})
+
+	It("Warns about secrets that can't be exported", func() {
+		doc, warnings := FromCluster(cluster, live)
+		Expect(doc.Resources.IdentityProviders[0].ClientSecret).To(Equal(RedactedValue))
+		Expect(warnings).To(ContainElement("The client secret of identity provider 'github-1' is not exported"))
+	})
+
+	It("Round trips through the spec file format", func() {
+		doc, _ := FromCluster(cluster, live)
+		data, err := Marshal(doc)
+		Expect(err).NotTo(HaveOccurred())
+		again, err := Parse(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again.Spec.Args()).To(Equal(doc.Spec.Args()))
+	})
+
+	It("Renders the create commands", func() {
+		doc, _ := FromCluster(cluster, live)
+		commands, warnings := Commands(doc)
+		Expect(commands).To(Equal([]string{
+			"rosa create cluster --cluster-name cluster --sts --region us-east-1 --version 4.14.10 --multi-az" +
+				" --role-arn arn:aws:iam::123:role/Installer --support-role-arn arn:aws:iam::123:role/Support" +
+				" --controlplane-iam-role-arn arn:aws:iam::123:role/ControlPlane" +
+				" --worker-iam-role-arn arn:aws:iam::123:role/Worker --operator-roles-prefix prefix" +
+				" --compute-machine-type m5.xlarge --replicas 3 --machine-cidr 10.0.0.0/16 --host-prefix 23" +
+				" --subnet-ids subnet-1,subnet-2,subnet-3,subnet-4,subnet-5,subnet-6" +
+				" --default-ingress-wildcard-policy WildcardsAllowed",
+			"rosa create machinepool --cluster cluster --name infra --instance-type r5.xlarge --replicas 3" +
+				" --labels role=infra --taints infra=true:NoSchedule",
+			"rosa create idp --cluster cluster --type github --name github-1 --client-id abc" +
+				` --client-secret "$GITHUB_1_CLIENT_SECRET" --organizations my-org`,
+		}))
+		Expect(warnings).To(ContainElement(
+			"The 'GITHUB_1_CLIENT_SECRET' variable needs to be set to the client secret of identity provider " +
+				"'github-1'"))
+	})
+
+	It("Quotes values that aren't shell safe", func() {
+		Expect(quote("a b")).To(Equal("'a b'"))
+		Expect(quote("it's")).To(Equal(`'it'\''s'`))
+		Expect(quote("key=value,foo=bar")).To(Equal("key=value,foo=bar"))
+	})
+})
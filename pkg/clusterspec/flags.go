@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file translates a cluster spec into 'rosa create cluster' flags.
+
+package clusterspec
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/ingress"
+	"github.com/openshift/rosa/pkg/interactive/securitygroups"
+)
+
+// Arg is a single 'rosa create cluster' flag and its value. Boolean flags carry the value "true".
+type Arg struct {
+	Flag  string
+	Value string
+
+	// Variable is true when the value is the name of the shell variable that holds it, as for the
+	// secrets that aren't exported.
+	Variable bool
+}
+
+// Args returns the 'rosa create cluster' flags equivalent to the spec, in a stable order.
+func (s *ClusterSpec) Args() []Arg {
+	var a argList
+
+	a.str("cluster-name", s.Name)
+	a.str("domain-prefix", s.DomainPrefix)
+	if s.STS != nil {
+		if *s.STS {
+			a.flag("sts", true)
+		} else {
+			a.flag("non-sts", true)
+		}
+	}
+	a.flag("hosted-cp", s.HostedCP)
+	a.str("region", s.Region)
+	a.str("version", s.Version)
+	a.str("channel-group", s.ChannelGroup)
+	a.flag("multi-az", s.MultiAZ)
+	a.flag("private", s.Private)
+	a.flag("private-link", s.PrivateLink)
+	a.flag("fips", s.FIPS)
+	a.flag("etcd-encryption", s.EtcdEncryption)
+	a.str("etcd-encryption-kms-arn", s.EtcdEncryptionKMSArn)
+	if s.KMSKeyArn != "" {
+		a.flag("enable-customer-managed-key", true)
+		a.str("kms-key-arn", s.KMSKeyArn)
+	}
+	a.flag("disable-workload-monitoring", s.DisableWorkloadMonitoring)
+	a.str("ec2-metadata-http-tokens", s.Ec2MetadataHttpTokens)
+	a.str("billing-account", s.BillingAccount)
+	a.str("audit-log-arn", s.AuditLogRoleARN)
+	if len(s.Tags) > 0 {
+		a.str("tags", joinTags(s.Tags))
+	}
+
+	if r := s.Roles; r != nil {
+		a.str("role-arn", r.InstallerRoleARN)
+		a.str("support-role-arn", r.SupportRoleARN)
+		a.str("controlplane-iam-role-arn", r.ControlPlaneRoleARN)
+		a.str("worker-iam-role-arn", r.WorkerRoleARN)
+		a.str("external-id", r.ExternalID)
+		a.str("operator-roles-prefix", r.OperatorRolesPrefix)
+		a.str("permissions-boundary", r.PermissionsBoundary)
+		a.str("oidc-config-id", r.OIDCConfigID)
+	}
+
+	if c := s.Compute; c != nil {
+		a.str("compute-machine-type", c.MachineType)
+		if c.Autoscaling != nil {
+			a.flag("enable-autoscaling", true)
+			a.int("min-replicas", c.Autoscaling.MinReplicas)
+			a.int("max-replicas", c.Autoscaling.MaxReplicas)
+		} else {
+			a.int("replicas", c.Replicas)
+		}
+		if len(c.Labels) > 0 {
+			a.str(arguments.NewDefaultMPLabelsFlag, joinMap(c.Labels))
+		}
+		a.str("worker-disk-size", c.DiskSize)
+		a.list(securitygroups.ComputeSecurityGroupFlag, c.SecurityGroupIDs)
+	}
+
+	if n := s.Network; n != nil {
+		a.str("network-type", n.Type)
+		a.str("machine-cidr", n.MachineCIDR)
+		a.str("service-cidr", n.ServiceCIDR)
+		a.str("pod-cidr", n.PodCIDR)
+		a.int("host-prefix", n.HostPrefix)
+		a.list("subnet-ids", n.SubnetIDs)
+		a.list("availability-zones", n.AvailabilityZones)
+	}
+
+	if p := s.Proxy; p != nil {
+		a.str("http-proxy", p.HTTPProxy)
+		a.str("https-proxy", p.HTTPSProxy)
+		a.list("no-proxy", p.NoProxy)
+		a.str("additional-trust-bundle-file", p.AdditionalTrustBundleFile)
+	}
+
+	if v := s.SharedVPC; v != nil {
+		a.str("private-hosted-zone-id", v.PrivateHostedZoneID)
+		a.str("shared-vpc-role-arn", v.RoleARN)
+		a.str("base-domain", v.BaseDomain)
+	}
+
+	if i := s.DefaultIngress; i != nil {
+		if len(i.RouteSelectors) > 0 {
+			a.str(ingress.DefaultIngressRouteSelectorFlag, joinMap(i.RouteSelectors))
+		}
+		a.list(ingress.DefaultIngressExcludedNamespacesFlag, i.ExcludedNamespaces)
+		a.str(ingress.DefaultIngressWildcardPolicyFlag, i.WildcardPolicy)
+		a.str(ingress.DefaultIngressNamespaceOwnershipPolicyFlag, i.NamespaceOwnershipPolicy)
+	}
+
+	return a
+}
+
+// ApplyToFlags sets every flag of the spec on the given flag set, unless the flag was already
+// given on the command line. This lets command line flags override values from the spec file.
+func (s *ClusterSpec) ApplyToFlags(flags *pflag.FlagSet) error {
+	for _, arg := range s.Args() {
+		flag := flags.Lookup(arg.Flag)
+		if flag == nil {
+			return fmt.Errorf("Unknown flag '%s'", arg.Flag)
+		}
+		if flag.Changed {
+			continue
+		}
+		err := flags.Set(arg.Flag, arg.Value)
+		if err != nil {
+			return fmt.Errorf("Invalid value '%s' for flag '%s': %v", arg.Value, arg.Flag, err)
+		}
+	}
+	return nil
+}
+
+type argList []Arg
+
+func (a *argList) str(flag string, value string) {
+	if value != "" {
+		*a = append(*a, Arg{Flag: flag, Value: value})
+	}
+}
+
+func (a *argList) int(flag string, value int) {
+	if value != 0 {
+		*a = append(*a, Arg{Flag: flag, Value: strconv.Itoa(value)})
+	}
+}
+
+func (a *argList) flag(flag string, value bool) {
+	if value {
+		*a = append(*a, Arg{Flag: flag, Value: "true"})
+	}
+}
+
+func (a *argList) list(flag string, values []string) {
+	if len(values) > 0 {
+		*a = append(*a, Arg{Flag: flag, Value: strings.Join(values, ",")})
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinMap(m map[string]string) string {
+	pairs := []string{}
+	for _, k := range sortedKeys(m) {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, m[k]))
+	}
+	return strings.Join(pairs, ",")
+}
+
+// joinTags uses the same delimiter rules as the '--tags' flag: ':' unless a key or value
+// contains a colon, in which case a space is used.
+func joinTags(tags map[string]string) string {
+	delim := ":"
+	for k, v := range tags {
+		if strings.Contains(k, ":") || strings.Contains(v, ":") {
+			delim = " "
+			break
+		}
+	}
+	pairs := []string{}
+	for _, k := range sortedKeys(tags) {
+		pairs = append(pairs, fmt.Sprintf("%s%s%s", k, delim, tags[k]))
+	}
+	return strings.Join(pairs, ",")
+}
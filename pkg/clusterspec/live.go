@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterspec
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// LiveResources holds the day-2 resources of a cluster as reported by OCM.
+type LiveResources struct {
+	MachinePools      []*cmv1.MachinePool
+	NodePools         []*cmv1.NodePool
+	IdentityProviders []*cmv1.IdentityProvider
+	Ingresses         []*cmv1.Ingress
+	KubeletConfigs    []*cmv1.KubeletConfig
+	TuningConfigs     []*cmv1.TuningConfig
+	Autoscaler        *cmv1.ClusterAutoscaler
+}
+
+// FetchLiveResources loads the day-2 resources of the cluster from OCM. Resources that don't
+// apply to the cluster topology are not requested.
+func FetchLiveResources(ctx context.Context, client *ocm.Client, cluster *cmv1.Cluster) (*LiveResources, error) {
+	var err error
+	live := &LiveResources{}
+	isHostedCP := cluster.Hypershift().Enabled()
+
+	if isHostedCP {
+		live.NodePools, err = client.GetNodePools(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get node pools: %v", err)
+		}
+		live.TuningConfigs, err = client.GetTuningConfigs(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get tuning configs: %v", err)
+		}
+	} else {
+		live.MachinePools, err = client.GetMachinePools(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get machine pools: %v", err)
+		}
+		live.Ingresses, err = client.GetIngresses(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get ingresses: %v", err)
+		}
+		live.Autoscaler, err = client.GetClusterAutoscaler(cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get autoscaler: %v", err)
+		}
+	}
+
+	live.IdentityProviders, err = client.GetIdentityProviders(cluster.ID())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get identity providers: %v", err)
+	}
+	live.KubeletConfigs, err = client.ListKubeletConfigs(ctx, cluster.ID())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get kubelet configs: %v", err)
+	}
+	return live, nil
+}
@@ -0,0 +1,192 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types of the day-2 resources that can be listed in a spec document.
+
+package clusterspec
+
+import (
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// Resources holds the day-2 resources of a cluster.
+type Resources struct {
+	// MachinePools are machine pools on classic clusters and node pools on Hosted Control Plane clusters
+	MachinePools      []MachinePoolSpec      `json:"machinePools,omitempty"`
+	IdentityProviders []IdentityProviderSpec `json:"identityProviders,omitempty"`
+	Ingresses         []IngressSpec          `json:"ingresses,omitempty"`
+	KubeletConfigs    []KubeletConfigSpec    `json:"kubeletConfigs,omitempty"`
+	TuningConfigs     []TuningConfigSpec     `json:"tuningConfigs,omitempty"`
+	Autoscaler        *AutoscalerSpec        `json:"autoscaler,omitempty"`
+}
+
+// MachinePoolSpec describes a machine pool or a node pool.
+type MachinePoolSpec struct {
+	Name             string            `json:"name"`
+	InstanceType     string            `json:"instanceType,omitempty"`
+	Replicas         int               `json:"replicas,omitempty"`
+	Autoscaling      *AutoscalingSpec  `json:"autoscaling,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	Taints           []TaintSpec       `json:"taints,omitempty"`
+	AvailabilityZone string            `json:"availabilityZone,omitempty"`
+	Subnet           string            `json:"subnet,omitempty"`
+	DiskSize         string            `json:"diskSize,omitempty"`
+	SecurityGroupIDs []string          `json:"securityGroupIDs,omitempty"`
+
+	// Hosted Control Plane only
+	Version        string   `json:"version,omitempty"`
+	AutoRepair     *bool    `json:"autoRepair,omitempty"`
+	KubeletConfigs []string `json:"kubeletConfigs,omitempty"`
+	TuningConfigs  []string `json:"tuningConfigs,omitempty"`
+}
+
+// TaintSpec describes a node taint.
+type TaintSpec struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+// IdentityProviderSpec describes an identity provider. Only the fields of the given type are used.
+type IdentityProviderSpec struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	MappingMethod string `json:"mappingMethod,omitempty"`
+	ClientID      string `json:"clientID,omitempty"`
+	ClientSecret  string `json:"clientSecret,omitempty"`
+
+	// GitHub
+	Hostname      string   `json:"hostname,omitempty"`
+	Organizations []string `json:"organizations,omitempty"`
+	Teams         []string `json:"teams,omitempty"`
+
+	// GitLab and LDAP
+	URL string `json:"url,omitempty"`
+
+	// Google
+	HostedDomain string `json:"hostedDomain,omitempty"`
+
+	// OpenID
+	IssuerURL      string   `json:"issuerURL,omitempty"`
+	EmailClaims    []string `json:"emailClaims,omitempty"`
+	NameClaims     []string `json:"nameClaims,omitempty"`
+	UsernameClaims []string `json:"usernameClaims,omitempty"`
+	GroupsClaims   []string `json:"groupsClaims,omitempty"`
+	ExtraScopes    []string `json:"extraScopes,omitempty"`
+
+	// LDAP
+	BindDN             string   `json:"bindDN,omitempty"`
+	BindPassword       string   `json:"bindPassword,omitempty"`
+	Insecure           bool     `json:"insecure,omitempty"`
+	IDAttributes       []string `json:"idAttributes,omitempty"`
+	UsernameAttributes []string `json:"usernameAttributes,omitempty"`
+	NameAttributes     []string `json:"nameAttributes,omitempty"`
+	EmailAttributes    []string `json:"emailAttributes,omitempty"`
+
+	// HTPasswd, as 'username:password' pairs
+	Users []string `json:"users,omitempty"`
+}
+
+// IngressSpec describes an ingress of a classic cluster.
+type IngressSpec struct {
+	ID                       string            `json:"id"`
+	Default                  bool              `json:"default,omitempty"`
+	Private                  bool              `json:"private,omitempty"`
+	LoadBalancerType         string            `json:"loadBalancerType,omitempty"`
+	RouteSelectors           map[string]string `json:"routeSelectors,omitempty"`
+	ExcludedNamespaces       []string          `json:"excludedNamespaces,omitempty"`
+	WildcardPolicy           string            `json:"wildcardPolicy,omitempty"`
+	NamespaceOwnershipPolicy string            `json:"namespaceOwnershipPolicy,omitempty"`
+}
+
+// KubeletConfigSpec describes a kubelet config.
+type KubeletConfigSpec struct {
+	Name         string `json:"name"`
+	PodPidsLimit int    `json:"podPidsLimit"`
+}
+
+// TuningConfigSpec describes a tuning config. The spec is the Tuned object as accepted by
+// 'rosa create tuning-configs --spec-path'.
+type TuningConfigSpec struct {
+	Name string                 `json:"name"`
+	Spec map[string]interface{} `json:"spec"`
+}
+
+// AutoscalerSpec describes the cluster autoscaler of a classic cluster.
+type AutoscalerSpec struct {
+	BalanceSimilarNodeGroups    bool                 `json:"balanceSimilarNodeGroups,omitempty"`
+	SkipNodesWithLocalStorage   bool                 `json:"skipNodesWithLocalStorage,omitempty"`
+	LogVerbosity                int                  `json:"logVerbosity,omitempty"`
+	MaxPodGracePeriod           int                  `json:"maxPodGracePeriod,omitempty"`
+	PodPriorityThreshold        int                  `json:"podPriorityThreshold,omitempty"`
+	IgnoreDaemonsetsUtilization bool                 `json:"ignoreDaemonsetsUtilization,omitempty"`
+	MaxNodeProvisionTime        string               `json:"maxNodeProvisionTime,omitempty"`
+	BalancingIgnoredLabels      []string             `json:"balancingIgnoredLabels,omitempty"`
+	MaxNodesTotal               int                  `json:"maxNodesTotal,omitempty"`
+	Cores                       *ResourceRangeSpec   `json:"cores,omitempty"`
+	Memory                      *ResourceRangeSpec   `json:"memory,omitempty"`
+	ScaleDown                   *AutoscalerScaleDown `json:"scaleDown,omitempty"`
+}
+
+// ResourceRangeSpec holds the bounds of an autoscaler resource limit.
+type ResourceRangeSpec struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// AutoscalerScaleDown holds the scale down settings of the cluster autoscaler.
+type AutoscalerScaleDown struct {
+	Enabled              bool    `json:"enabled,omitempty"`
+	UnneededTime         string  `json:"unneededTime,omitempty"`
+	UtilizationThreshold float64 `json:"utilizationThreshold,omitempty"`
+	DelayAfterAdd        string  `json:"delayAfterAdd,omitempty"`
+	DelayAfterDelete     string  `json:"delayAfterDelete,omitempty"`
+	DelayAfterFailure    string  `json:"delayAfterFailure,omitempty"`
+}
+
+// Config converts the autoscaler spec into the configuration used by the OCM client.
+func (a *AutoscalerSpec) Config() *ocm.AutoscalerConfig {
+	config := &ocm.AutoscalerConfig{
+		BalanceSimilarNodeGroups:    a.BalanceSimilarNodeGroups,
+		SkipNodesWithLocalStorage:   a.SkipNodesWithLocalStorage,
+		LogVerbosity:                a.LogVerbosity,
+		MaxPodGracePeriod:           a.MaxPodGracePeriod,
+		PodPriorityThreshold:        a.PodPriorityThreshold,
+		IgnoreDaemonsetsUtilization: a.IgnoreDaemonsetsUtilization,
+		MaxNodeProvisionTime:        a.MaxNodeProvisionTime,
+		BalancingIgnoredLabels:      a.BalancingIgnoredLabels,
+		ResourceLimits: ocm.ResourceLimits{
+			MaxNodesTotal: a.MaxNodesTotal,
+		},
+	}
+	if a.Cores != nil {
+		config.ResourceLimits.Cores = ocm.ResourceRange{Min: a.Cores.Min, Max: a.Cores.Max}
+	}
+	if a.Memory != nil {
+		config.ResourceLimits.Memory = ocm.ResourceRange{Min: a.Memory.Min, Max: a.Memory.Max}
+	}
+	if a.ScaleDown != nil {
+		config.ScaleDown = ocm.ScaleDownConfig{
+			Enabled:              a.ScaleDown.Enabled,
+			UnneededTime:         a.ScaleDown.UnneededTime,
+			UtilizationThreshold: a.ScaleDown.UtilizationThreshold,
+			DelayAfterAdd:        a.ScaleDown.DelayAfterAdd,
+			DelayAfterDelete:     a.ScaleDown.DelayAfterDelete,
+			DelayAfterFailure:    a.ScaleDown.DelayAfterFailure,
+		}
+	}
+	return config
+}
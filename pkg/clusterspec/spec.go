@@ -0,0 +1,173 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types of the declarative cluster spec file accepted by
+// 'rosa create cluster --from-file'.
+
+package clusterspec
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	APIVersion  = "rosa.openshift.io/v1alpha1"
+	KindCluster = "Cluster"
+)
+
+// Document is the versioned envelope of a cluster spec file. Both YAML and JSON
+// encodings are accepted.
+type Document struct {
+	APIVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Spec       ClusterSpec `json:"spec"`
+	Resources  *Resources  `json:"resources,omitempty"`
+
+	// raw is the content the document was parsed from, if any
+	raw []byte
+}
+
+// ClusterSpec describes a cluster the same way the 'rosa create cluster' flags do. Every
+// field maps onto a field of ocm.Spec through the flag of the same meaning.
+type ClusterSpec struct {
+	Name                      string            `json:"name"`
+	DomainPrefix              string            `json:"domainPrefix,omitempty"`
+	Region                    string            `json:"region,omitempty"`
+	Version                   string            `json:"version,omitempty"`
+	ChannelGroup              string            `json:"channelGroup,omitempty"`
+	HostedCP                  bool              `json:"hostedCP,omitempty"`
+	STS                       *bool             `json:"sts,omitempty"`
+	MultiAZ                   bool              `json:"multiAZ,omitempty"`
+	Private                   bool              `json:"private,omitempty"`
+	PrivateLink               bool              `json:"privateLink,omitempty"`
+	FIPS                      bool              `json:"fips,omitempty"`
+	EtcdEncryption            bool              `json:"etcdEncryption,omitempty"`
+	EtcdEncryptionKMSArn      string            `json:"etcdEncryptionKMSArn,omitempty"`
+	KMSKeyArn                 string            `json:"kmsKeyArn,omitempty"`
+	DisableWorkloadMonitoring bool              `json:"disableWorkloadMonitoring,omitempty"`
+	Ec2MetadataHttpTokens     string            `json:"ec2MetadataHttpTokens,omitempty"`
+	BillingAccount            string            `json:"billingAccount,omitempty"`
+	AuditLogRoleARN           string            `json:"auditLogRoleARN,omitempty"`
+	Tags                      map[string]string `json:"tags,omitempty"`
+
+	Roles          *RolesSpec          `json:"roles,omitempty"`
+	Compute        *ComputeSpec        `json:"compute,omitempty"`
+	Network        *NetworkSpec        `json:"network,omitempty"`
+	Proxy          *ProxySpec          `json:"proxy,omitempty"`
+	SharedVPC      *SharedVPCSpec      `json:"sharedVPC,omitempty"`
+	DefaultIngress *DefaultIngressSpec `json:"defaultIngress,omitempty"`
+}
+
+// RolesSpec holds the account roles, operator roles and OIDC configuration of an STS cluster.
+type RolesSpec struct {
+	InstallerRoleARN    string `json:"installerRoleARN,omitempty"`
+	SupportRoleARN      string `json:"supportRoleARN,omitempty"`
+	ControlPlaneRoleARN string `json:"controlPlaneRoleARN,omitempty"`
+	WorkerRoleARN       string `json:"workerRoleARN,omitempty"`
+	ExternalID          string `json:"externalID,omitempty"`
+	OperatorRolesPrefix string `json:"operatorRolesPrefix,omitempty"`
+	PermissionsBoundary string `json:"permissionsBoundary,omitempty"`
+	OIDCConfigID        string `json:"oidcConfigID,omitempty"`
+}
+
+// ComputeSpec holds the settings of the default worker machine pool.
+type ComputeSpec struct {
+	MachineType      string            `json:"machineType,omitempty"`
+	Replicas         int               `json:"replicas,omitempty"`
+	Autoscaling      *AutoscalingSpec  `json:"autoscaling,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	DiskSize         string            `json:"diskSize,omitempty"`
+	SecurityGroupIDs []string          `json:"securityGroupIDs,omitempty"`
+}
+
+// AutoscalingSpec holds the replica bounds of an autoscaled machine pool.
+type AutoscalingSpec struct {
+	MinReplicas int `json:"minReplicas"`
+	MaxReplicas int `json:"maxReplicas"`
+}
+
+// NetworkSpec holds the networking settings of the cluster.
+type NetworkSpec struct {
+	Type              string   `json:"type,omitempty"`
+	MachineCIDR       string   `json:"machineCIDR,omitempty"`
+	ServiceCIDR       string   `json:"serviceCIDR,omitempty"`
+	PodCIDR           string   `json:"podCIDR,omitempty"`
+	HostPrefix        int      `json:"hostPrefix,omitempty"`
+	SubnetIDs         []string `json:"subnetIDs,omitempty"`
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+}
+
+// ProxySpec holds the cluster-wide proxy settings.
+type ProxySpec struct {
+	HTTPProxy                 string   `json:"httpProxy,omitempty"`
+	HTTPSProxy                string   `json:"httpsProxy,omitempty"`
+	NoProxy                   []string `json:"noProxy,omitempty"`
+	AdditionalTrustBundleFile string   `json:"additionalTrustBundleFile,omitempty"`
+}
+
+// SharedVPCSpec holds the settings needed to install a cluster into a shared VPC.
+type SharedVPCSpec struct {
+	PrivateHostedZoneID string `json:"privateHostedZoneID"`
+	RoleARN             string `json:"roleARN"`
+	BaseDomain          string `json:"baseDomain"`
+}
+
+// DefaultIngressSpec holds the settings of the default ingress of a classic cluster.
+type DefaultIngressSpec struct {
+	RouteSelectors           map[string]string `json:"routeSelectors,omitempty"`
+	ExcludedNamespaces       []string          `json:"excludedNamespaces,omitempty"`
+	WildcardPolicy           string            `json:"wildcardPolicy,omitempty"`
+	NamespaceOwnershipPolicy string            `json:"namespaceOwnershipPolicy,omitempty"`
+}
+
+// Load reads and parses the spec file at the given path.
+func Load(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read spec file '%s': %v", path, err)
+	}
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse spec file '%s': %v", path, err)
+	}
+	return doc, nil
+}
+
+// Parse decodes a YAML or JSON spec document. Unknown fields are rejected so that typos
+// don't silently fall back to defaults.
+func Parse(data []byte) (*Document, error) {
+	doc := &Document{}
+	err := yaml.UnmarshalStrict(data, doc)
+	if err != nil {
+		return nil, err
+	}
+	if doc.APIVersion != APIVersion {
+		return nil, fmt.Errorf("Unsupported apiVersion '%s', expected '%s'", doc.APIVersion, APIVersion)
+	}
+	if doc.Kind != KindCluster {
+		return nil, fmt.Errorf("Unsupported kind '%s', expected '%s'", doc.Kind, KindCluster)
+	}
+	doc.raw = data
+	return doc, nil
+}
+
+// Marshal encodes the document as YAML.
+func Marshal(doc *Document) ([]byte, error) {
+	return yaml.Marshal(doc)
+}
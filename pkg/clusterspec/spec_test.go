@@ -0,0 +1,159 @@
+package clusterspec
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+)
+
+const specYAML = `
+apiVersion: rosa.openshift.io/v1alpha1
+kind: Cluster
+spec:
+  name: my-cluster
+  region: us-east-1
+  sts: true
+  multiAZ: true
+  tags:
+    owner: me
+    team: sre
+  compute:
+    machineType: m5.xlarge
+    autoscaling:
+      minReplicas: 3
+      maxReplicas: 6
+  network:
+    machineCIDR: 10.0.0.0/16
+    hostPrefix: 23
+`
+
+var _ = Describe("Cluster spec", func() {
+	Context("Parse", func() {
+		It("Parses a YAML document", func() {
+			doc, err := Parse([]byte(specYAML))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(doc.Spec.Name).To(Equal("my-cluster"))
+			Expect(*doc.Spec.STS).To(BeTrue())
+			Expect(doc.Spec.Compute.Autoscaling.MaxReplicas).To(Equal(6))
+		})
+
+		It("Parses a JSON document", func() {
+			doc, err := Parse([]byte(`{"apiVersion":"rosa.openshift.io/v1alpha1","kind":"Cluster",` +
+				`"spec":{"name":"my-cluster"}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(doc.Spec.Name).To(Equal("my-cluster"))
+		})
+
+		It("Rejects unknown fields", func() {
+			_, err := Parse([]byte("apiVersion: rosa.openshift.io/v1alpha1\nkind: Cluster\nspec:\n  nmae: foo\n"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Rejects an unsupported apiVersion", func() {
+			_, err := Parse([]byte("apiVersion: v2\nkind: Cluster\nspec:\n  name: foo\n"))
+			Expect(err).To(MatchError("Unsupported apiVersion 'v2', expected 'rosa.openshift.io/v1alpha1'"))
+		})
+
+		It("Round trips through Marshal", func() {
+			doc, err := Parse([]byte(specYAML))
+			Expect(err).NotTo(HaveOccurred())
+			data, err := Marshal(doc)
+			Expect(err).NotTo(HaveOccurred())
+			again, err := Parse(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(again.Spec).To(Equal(doc.Spec))
+			Expect(again.Resources).To(Equal(doc.Resources))
+		})
+	})
+
+	Context("Validate", func() {
+		var spec *ClusterSpec
+
+		BeforeEach(func() {
+			doc, err := Parse([]byte(specYAML))
+			Expect(err).NotTo(HaveOccurred())
+			spec = &doc.Spec
+		})
+
+		It("Accepts a valid spec", func() {
+			Expect(spec.Validate()).To(Succeed())
+		})
+
+		It("Rejects an invalid cluster name", func() {
+			spec.Name = "My_Cluster"
+			Expect(spec.Validate()).To(MatchError(ContainSubstring("Cluster name must consist")))
+		})
+
+		It("Rejects an invalid CIDR", func() {
+			spec.Network.PodCIDR = "10.0.0.0"
+			Expect(spec.Validate()).To(MatchError(ContainSubstring("Expected a valid CIDR for 'podCIDR'")))
+		})
+
+		It("Rejects an invalid HTTP proxy", func() {
+			spec.Proxy = &ProxySpec{HTTPProxy: "https://proxy.example.com"}
+			Expect(spec.Validate()).To(MatchError("Expected http-proxy to have an http:// scheme"))
+		})
+
+		It("Rejects an invalid HTTPS proxy", func() {
+			spec.Proxy = &ProxySpec{HTTPSProxy: "https://proxy example.com"}
+			Expect(spec.Validate()).To(MatchError(ContainSubstring("Expected a valid 'httpsProxy'")))
+		})
+
+		It("Rejects a wrong number of subnets", func() {
+			spec.Network.SubnetIDs = []string{"subnet-1"}
+			Expect(spec.Validate()).To(MatchError(ContainSubstring("The number of subnets")))
+		})
+
+		It("Rejects autoscaling bounds that are not a multiple of 3 for multi AZ", func() {
+			spec.Compute.Autoscaling.MinReplicas = 2
+			Expect(spec.Validate()).To(MatchError(ContainSubstring("multiple of 3")))
+		})
+	})
+
+	Context("ApplyToFlags", func() {
+		var flags *pflag.FlagSet
+
+		BeforeEach(func() {
+			flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String("cluster-name", "", "")
+			flags.String("region", "", "")
+			flags.Bool("sts", false, "")
+			flags.Bool("multi-az", false, "")
+			flags.StringSlice("tags", nil, "")
+			flags.String("compute-machine-type", "", "")
+			flags.Bool("enable-autoscaling", false, "")
+			flags.Int("min-replicas", 2, "")
+			flags.Int("max-replicas", 2, "")
+			flags.IPNet("machine-cidr", net.IPNet{}, "")
+			flags.Int("host-prefix", 0, "")
+		})
+
+		It("Sets the flags from the spec", func() {
+			doc, err := Parse([]byte(specYAML))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(doc.Spec.ApplyToFlags(flags)).To(Succeed())
+
+			Expect(flags.Lookup("cluster-name").Value.String()).To(Equal("my-cluster"))
+			Expect(flags.Lookup("sts").Value.String()).To(Equal("true"))
+			Expect(flags.Lookup("tags").Value.String()).To(Equal("[owner:me,team:sre]"))
+			Expect(flags.Lookup("max-replicas").Value.String()).To(Equal("6"))
+			Expect(flags.Lookup("machine-cidr").Value.String()).To(Equal("10.0.0.0/16"))
+		})
+
+		It("Keeps flags given on the command line", func() {
+			Expect(flags.Parse([]string{"--region", "eu-west-1"})).To(Succeed())
+			doc, err := Parse([]byte(specYAML))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(doc.Spec.ApplyToFlags(flags)).To(Succeed())
+
+			Expect(flags.Lookup("region").Value.String()).To(Equal("eu-west-1"))
+		})
+
+		It("Fails on flags that don't exist", func() {
+			spec := &ClusterSpec{Name: "foo", FIPS: true}
+			Expect(spec.ApplyToFlags(flags)).To(MatchError("Unknown flag 'fips'"))
+		})
+	})
+})
@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterspec
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+const (
+	minHostPrefix = 23
+	maxHostPrefix = 26
+)
+
+// Validate checks the spec with the same validators used by the 'rosa create cluster' flags.
+// Checks that require OCM or AWS are left to the create cluster flow.
+func (s *ClusterSpec) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("Cluster name is required")
+	}
+	if err := ocm.ClusterNameValidator(s.Name); err != nil {
+		return err
+	}
+	if err := ocm.ClusterDomainPrefixValidator(s.DomainPrefix); err != nil {
+		return err
+	}
+	if err := ocm.ValidateHttpTokensValue(s.Ec2MetadataHttpTokens); err != nil {
+		return err
+	}
+	if s.FIPS && s.HostedCP {
+		return fmt.Errorf("FIPS is not supported for Hosted Control Plane clusters")
+	}
+	if s.STS != nil && !*s.STS && s.HostedCP {
+		return fmt.Errorf("Hosted Control Plane clusters require STS")
+	}
+
+	if s.Compute != nil {
+		if err := s.Compute.validate(s.MultiAZ, s.HostedCP); err != nil {
+			return err
+		}
+	}
+	if s.Network != nil {
+		if err := s.Network.validate(s.MultiAZ, s.PrivateLink, s.HostedCP); err != nil {
+			return err
+		}
+	}
+	if s.Proxy != nil {
+		if err := s.Proxy.validate(); err != nil {
+			return err
+		}
+	}
+	if s.SharedVPC != nil {
+		if s.SharedVPC.PrivateHostedZoneID == "" || s.SharedVPC.RoleARN == "" || s.SharedVPC.BaseDomain == "" {
+			return fmt.Errorf("Shared VPC requires 'privateHostedZoneID', 'roleARN' and 'baseDomain'")
+		}
+	}
+	return nil
+}
+
+func (c *ComputeSpec) validate(multiAZ bool, hostedCP bool) error {
+	if c.Replicas < 0 {
+		return fmt.Errorf("Compute replicas must be a non-negative number")
+	}
+	if c.Autoscaling != nil {
+		if c.Replicas != 0 {
+			return fmt.Errorf("Compute 'replicas' can't be set together with 'autoscaling'")
+		}
+		if c.Autoscaling.MinReplicas < 1 {
+			return fmt.Errorf("Autoscaling 'minReplicas' must be greater than zero")
+		}
+		if c.Autoscaling.MaxReplicas < c.Autoscaling.MinReplicas {
+			return fmt.Errorf("Autoscaling 'maxReplicas' must be greater or equal to 'minReplicas'")
+		}
+		if multiAZ && !hostedCP && c.Autoscaling.MinReplicas%3 != 0 {
+			return fmt.Errorf("Multi AZ clusters require that 'minReplicas' be a multiple of 3")
+		}
+	}
+	if multiAZ && !hostedCP && c.Replicas%3 != 0 {
+		return fmt.Errorf("Multi AZ clusters require that 'replicas' be a multiple of 3")
+	}
+	if _, err := ocm.ParseDiskSizeToGigibyte(c.DiskSize); err != nil {
+		return fmt.Errorf("Expected a valid compute disk size: %v", err)
+	}
+	return nil
+}
+
+func (n *NetworkSpec) validate(multiAZ bool, privateLink bool, hostedCP bool) error {
+	for _, cidr := range []struct{ name, value string }{
+		{"machineCIDR", n.MachineCIDR},
+		{"serviceCIDR", n.ServiceCIDR},
+		{"podCIDR", n.PodCIDR},
+	} {
+		if cidr.value == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(cidr.value); err != nil {
+			return fmt.Errorf("Expected a valid CIDR for '%s': %v", cidr.name, err)
+		}
+	}
+	if n.HostPrefix != 0 && (n.HostPrefix < minHostPrefix || n.HostPrefix > maxHostPrefix) {
+		return fmt.Errorf("Invalid Network Host Prefix /%d: Subnet length should be between %d and %d",
+			n.HostPrefix, minHostPrefix, maxHostPrefix)
+	}
+	if n.Type != "" && !helper.Contains(ocm.NetworkTypes, n.Type) {
+		return fmt.Errorf("Expected a valid network type. Valid values: %v", ocm.NetworkTypes)
+	}
+	if len(n.SubnetIDs) > 0 && len(n.AvailabilityZones) > 0 {
+		return fmt.Errorf("Setting 'availabilityZones' is not supported together with 'subnetIDs'")
+	}
+	if len(n.SubnetIDs) > 0 && !hostedCP {
+		if err := ocm.ValidateSubnetsCount(multiAZ, privateLink, len(n.SubnetIDs)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *ProxySpec) validate() error {
+	if err := ocm.ValidateHTTPProxy(p.HTTPProxy); err != nil {
+		return err
+	}
+	if err := interactive.IsURL(p.HTTPSProxy); err != nil {
+		return fmt.Errorf("Expected a valid 'httpsProxy': %v", err)
+	}
+	if err := ocm.ValidateAdditionalTrustBundle(p.AdditionalTrustBundleFile); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package cmdcontext
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCmdContext(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Command context suite")
+}
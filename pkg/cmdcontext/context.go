@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cmdcontext contains the context of the command that is running. It is cancelled when the
+// process receives SIGINT or SIGTERM, or when the time given with the '--timeout' flag expires, so
+// that the calls to OCM and AWS and the loops that watch resources stop cleanly.
+package cmdcontext
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+var (
+	once   sync.Once
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+)
+
+// Current returns the context of the command. It is created the first time it is requested, after
+// the command line flags have been parsed.
+func Current() context.Context {
+	once.Do(func() {
+		ctx, cancel = New(context.Background(), Timeout())
+	})
+	return ctx
+}
+
+// Err returns nil while the context of the command is active, or an error that explains why it was
+// cancelled.
+func Err() error {
+	if Current().Err() == nil {
+		return nil
+	}
+	return context.Cause(Current())
+}
+
+// Explain replaces the errors caused by the cancellation of the context of the command, usually
+// 'context canceled' or 'context deadline exceeded' returned by the OCM or AWS clients, with the
+// reason of the cancellation. Other errors are returned unchanged.
+func Explain(err error) error {
+	if err == nil {
+		return nil
+	}
+	cause := Err()
+	if cause == nil {
+		return err
+	}
+	return &rosaerrors.Error{
+		Category: rosaerrors.Classify(cause).Category,
+		Message:  fmt.Sprintf("%v: %v", cause, err),
+		Err:      err,
+	}
+}
+
+// New returns a context derived from the given one that is cancelled when the process receives
+// SIGINT or SIGTERM, or when the timeout expires if it isn't zero. The first signal only cancels
+// the context, a second one terminates the process immediately.
+func New(parent context.Context, timeout time.Duration) (context.Context, context.CancelCauseFunc) {
+	result, cancelResult := context.WithCancelCause(parent)
+	if timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		result, cancelTimeout = context.WithTimeoutCause(result, timeout, rosaerrors.New(
+			rosaerrors.CategoryTimeout, "Timed out after %s, the limit set with '--timeout'", timeout))
+		cancelAll := cancelResult
+		cancelResult = func(cause error) {
+			cancelAll(cause)
+			cancelTimeout()
+		}
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-signals:
+			signal.Stop(signals)
+			reporter.CreateReporter().Warnf("Interrupted, stopping. Press Ctrl-C again to exit immediately")
+			cancelResult(Interrupted)
+		case <-result.Done():
+			signal.Stop(signals)
+		}
+	}()
+
+	return result, cancelResult
+}
+
+// Sleep waits for the given duration, or until the given context is cancelled. In that case it
+// returns the reason of the cancellation, so that loops that poll at fixed intervals stop at the
+// first Ctrl-C instead of at the next attempt.
+func Sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
+
+// Interrupted is the cause of the cancellation of the context when the process receives a signal.
+var Interrupted = rosaerrors.New(rosaerrors.CategoryInterrupted, "Interrupted")
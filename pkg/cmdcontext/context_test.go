@@ -0,0 +1,86 @@
+package cmdcontext
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+)
+
+var _ = Describe("Command context", func() {
+	It("Parses the timeout flag", func() {
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		AddTimeoutFlag(flags)
+		DeferCleanup(SetTimeout, time.Duration(0))
+		Expect(flags.Parse([]string{"--timeout", "30m"})).To(Succeed())
+		Expect(Timeout()).To(Equal(30 * time.Minute))
+	})
+
+	It("Has no deadline without a timeout", func() {
+		ctx, cancel := New(context.Background(), 0)
+		defer cancel(nil)
+		_, ok := ctx.Deadline()
+		Expect(ok).To(BeFalse())
+		Expect(ctx.Err()).ToNot(HaveOccurred())
+	})
+
+	It("Explains why the timeout cancelled the context", func() {
+		ctx, cancel := New(context.Background(), 10*time.Millisecond)
+		defer cancel(nil)
+		Eventually(ctx.Done()).Should(BeClosed())
+		cause := context.Cause(ctx)
+		Expect(cause).To(MatchError("Timed out after 10ms, the limit set with '--timeout'"))
+		Expect(rosaerrors.ExitCode(cause)).To(Equal(rosaerrors.CategoryTimeout.ExitCode()))
+	})
+
+	It("Keeps the cause when it is cancelled", func() {
+		ctx, cancel := New(context.Background(), time.Minute)
+		cancel(Interrupted)
+		Expect(ctx.Err()).To(MatchError(context.Canceled))
+		Expect(context.Cause(ctx)).To(Equal(Interrupted))
+		Expect(rosaerrors.ExitCode(Interrupted)).To(Equal(130))
+	})
+
+	It("Doesn't change errors while the command is running", func() {
+		err := fmt.Errorf("Failed to get cluster")
+		Expect(Explain(err)).To(Equal(err))
+		Expect(Explain(nil)).To(BeNil())
+		Expect(Err()).ToNot(HaveOccurred())
+	})
+
+	It("Sleeps until the context is cancelled", func() {
+		Expect(Sleep(context.Background(), time.Millisecond)).To(Succeed())
+
+		ctx, cancel := New(context.Background(), 0)
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel(Interrupted)
+		}()
+		start := time.Now()
+		Expect(Sleep(ctx, time.Minute)).To(Equal(Interrupted))
+		Expect(time.Since(start)).To(BeNumerically("<", time.Minute))
+	})
+
+	It("Classifies context errors of the clients", func() {
+		err := fmt.Errorf("Failed to get cluster: %w", context.Canceled)
+		Expect(rosaerrors.ExitCode(err)).To(Equal(rosaerrors.CategoryInterrupted.ExitCode()))
+	})
+
+	It("Calls the exit functions once before exiting", func() {
+		DeferCleanup(func(previous func(int)) { osExit = previous }, osExit)
+		exited := -1
+		osExit = func(code int) { exited = code }
+		calls := []string{}
+		OnExit(func(code int) { calls = append(calls, fmt.Sprintf("first %d", code)) })
+		OnExit(func(code int) { calls = append(calls, fmt.Sprintf("second %d", code)) })
+		Exit(4)
+		Finish(0)
+		Expect(calls).To(Equal([]string{"first 4", "second 4"}))
+		Expect(exited).To(Equal(4))
+	})
+})
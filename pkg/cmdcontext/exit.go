@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions used to terminate the command.
+
+package cmdcontext
+
+import (
+	"os"
+	"sync"
+)
+
+var (
+	exitLock  sync.Mutex
+	exitHooks []func(code int)
+	osExit    = os.Exit
+)
+
+// OnExit registers a function that is called with the exit code when the command finishes, either
+// because it returned or because it called Exit. It is used to write the trace of the command and
+// the summary of the time spent calling OCM and AWS.
+func OnExit(hook func(code int)) {
+	exitLock.Lock()
+	defer exitLock.Unlock()
+	exitHooks = append(exitHooks, hook)
+}
+
+// Finish calls the functions registered with OnExit, in the order they were registered. Only the
+// first call has an effect.
+func Finish(code int) {
+	exitLock.Lock()
+	hooks := exitHooks
+	exitHooks = nil
+	exitLock.Unlock()
+	for _, hook := range hooks {
+		hook(code)
+	}
+}
+
+// Exit finishes the command and terminates the process with the given code. Commands must call it
+// instead of 'os.Exit'.
+func Exit(code int) {
+	Finish(code)
+	exitLock.Lock()
+	exit := osExit
+	exitLock.Unlock()
+	exit(code)
+}
+
+// SetExit replaces the function that Exit calls to terminate the process, and returns a function
+// that restores the previous one. It allows tests to run commands in-process, see the
+// 'pkg/test/harness' package. The replacement must not return, it would usually panic and the test
+// would recover where it runs the command.
+func SetExit(value func(code int)) (restore func()) {
+	exitLock.Lock()
+	defer exitLock.Unlock()
+	previous := osExit
+	osExit = value
+	return func() {
+		exitLock.Lock()
+		defer exitLock.Unlock()
+		osExit = previous
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to implement the '--timeout' command line option.
+
+package cmdcontext
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// AddTimeoutFlag adds the timeout flag to the given set of command line flags. The 'rosa wait'
+// commands also use it as the maximum time to wait.
+func AddTimeoutFlag(flags *pflag.FlagSet) {
+	flags.DurationVar(
+		&timeout,
+		"timeout",
+		0,
+		"Maximum time that the command can run, for example '30m'. The command is stopped when it "+
+			"expires. Zero means no limit.",
+	)
+}
+
+// Timeout returns the maximum time that the command can run, or zero when there is no limit.
+func Timeout() time.Duration {
+	return timeout
+}
+
+func SetTimeout(value time.Duration) {
+	timeout = value
+}
+
+// timeout is the maximum time that the command can run.
+var timeout time.Duration
@@ -55,20 +55,29 @@ type Config struct {
 	TokenURL     string   `json:"token_url,omitempty" doc:"OpenID token URL."`
 	URL          string   `json:"url,omitempty" doc:"URL of the API gateway."`
 	FedRAMP      bool     `json:"fedramp,omitempty" doc:"Indicates FedRAMP."`
+	AWSProfile   string   `json:"aws_profile,omitempty" doc:"Default AWS profile."`
+	AWSRegion    string   `json:"aws_region,omitempty" doc:"Default AWS region."`
+
+	// The settings above belong to the current context, the other contexts are stored aside
+	CurrentContext string             `json:"current_context,omitempty" doc:"Name of the current context."`
+	Contexts       map[string]*Config `json:"contexts,omitempty"`
 }
 
-var DisallowedSetConfigProperties = []string{"scopes"}
+var DisallowedSetConfigProperties = []string{"scopes", "current_context"}
 
 func ConfigPropertiesNamesAndDocs() ([]string, []string) {
 	configType := reflect.ValueOf(Config{}).Type()
-	names := make([]string, configType.NumField())
-	docs := make([]string, configType.NumField())
+	names := make([]string, 0, configType.NumField())
+	docs := make([]string, 0, configType.NumField())
 	for i := 0; i < configType.NumField(); i++ {
 		tag := configType.Field(i).Tag
-		propName := strings.Split(tag.Get("json"), ",")[0]
-		names[i] = propName
 		propDoc := tag.Get("doc")
-		docs[i] = propDoc
+		if propDoc == "" {
+			continue
+		}
+		propName := strings.Split(tag.Get("json"), ",")[0]
+		names = append(names, propName)
+		docs = append(docs, propDoc)
 	}
 	return names, docs
 }
@@ -98,8 +107,24 @@ func GetAllowedConfigProperties() []string {
 	return allowedProperties
 }
 
-// Loads the configuration from the OS keyring if requested, load from the configuration file if not
+// Load returns the configuration of the selected context. The configuration is loaded from the OS
+// keyring if requested, from the configuration file if not.
 func Load() (cfg *Config, err error) {
+	cfg, err = load()
+	if err != nil || cfg == nil || selectedContext == "" || selectedContext == cfg.Context() {
+		return
+	}
+	settings := cfg.Contexts[selectedContext]
+	if settings == nil {
+		return nil, nil
+	}
+	cfg = settings.settings()
+	cfg.CurrentContext = selectedContext
+	return cfg, nil
+}
+
+// load returns the whole configuration, including the contexts that aren't current.
+func load() (cfg *Config, err error) {
 	if keyring, ok := IsKeyringManaged(); ok {
 		return loadFromOS(keyring)
 	}
@@ -160,8 +185,25 @@ func loadFromFile() (cfg *Config, err error) {
 	return
 }
 
-// Save saves the given configuration to the configuration file.
+// Save saves the given configuration as the configuration of the selected context.
 func Save(cfg *Config) error {
+	if selectedContext != "" && cfg != nil {
+		stored, err := load()
+		if err != nil {
+			return err
+		}
+		if stored == nil && selectedContext != DefaultContext {
+			cfg.CurrentContext = selectedContext
+		}
+		if stored != nil && stored.Context() != selectedContext {
+			stored.setContext(selectedContext, cfg)
+			cfg = stored
+		}
+	}
+	return save(cfg)
+}
+
+func save(cfg *Config) error {
 	file, err := Location()
 	if err != nil {
 		return err
@@ -192,8 +234,23 @@ func Save(cfg *Config) error {
 	return nil
 }
 
-// Remove removes the configuration file.
+// Remove removes the configuration of the selected context. The configuration file is removed
+// when no other context is stored in it.
 func Remove() error {
+	stored, err := load()
+	if err == nil && stored != nil {
+		if selectedContext != "" && selectedContext != stored.Context() {
+			delete(stored.Contexts, selectedContext)
+			return save(stored)
+		}
+		if len(stored.Contexts) > 0 {
+			return save(&Config{Contexts: stored.Contexts})
+		}
+	}
+	return remove()
+}
+
+func remove() error {
 	if keyring, ok := IsKeyringManaged(); ok {
 		err := RemoveConfigFromKeyring(keyring)
 		if err != nil {
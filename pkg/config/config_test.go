@@ -45,15 +45,18 @@ func TestConfig(t *testing.T) {
 
 var _ = Describe("Config", Ordered, func() {
 	propNamesAndDocs := map[string]string{
-		"access_token":  "Bearer access token.",
-		"client_id":     "OpenID client identifier.",
-		"client_secret": "OpenID client secret.",
-		"insecure":      "Enables insecure communication with the server.",
-		"refresh_token": "Offline or refresh token.",
-		"scopes":        "OpenID scope.",
-		"token_url":     "OpenID token URL.",
-		"url":           "URL of the API gateway.",
-		"fedramp":       "Indicates FedRAMP.",
+		"access_token":    "Bearer access token.",
+		"client_id":       "OpenID client identifier.",
+		"client_secret":   "OpenID client secret.",
+		"insecure":        "Enables insecure communication with the server.",
+		"refresh_token":   "Offline or refresh token.",
+		"scopes":          "OpenID scope.",
+		"token_url":       "OpenID token URL.",
+		"url":             "URL of the API gateway.",
+		"fedramp":         "Indicates FedRAMP.",
+		"aws_profile":     "Default AWS profile.",
+		"aws_region":      "Default AWS region.",
+		"current_context": "Name of the current context.",
 	}
 
 	It("Shows properties and docs for config", func() {
@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions used to manage named contexts. A context is a complete set of
+// settings, so that users can switch between environments and organizations without logging in
+// again. The settings of the current context are stored at the top level of the configuration, so
+// that the file stays compatible with other OCM clients, and the other contexts are stored aside.
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/spf13/pflag"
+)
+
+// DefaultContext is the name of the context of a configuration that was never given a name.
+const DefaultContext = "default"
+
+// selectedContext is the context requested with the '--context' flag, if any.
+var selectedContext string
+
+// AddContextFlag adds the '--context' flag to the given set of command line flags.
+func AddContextFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&selectedContext,
+		"context",
+		"",
+		"Use a specific configuration context instead of the current one.",
+	)
+}
+
+// SelectContext selects the context used by the following loads and saves of the configuration.
+func SelectContext(name string) {
+	selectedContext = name
+}
+
+// Context returns the name of the current context of the configuration.
+func (c *Config) Context() string {
+	if c.CurrentContext == "" {
+		return DefaultContext
+	}
+	return c.CurrentContext
+}
+
+// settings returns a copy of the configuration without any context information.
+func (c *Config) settings() *Config {
+	result := *c
+	result.CurrentContext = ""
+	result.Contexts = nil
+	return &result
+}
+
+func (c *Config) isEmpty() bool {
+	return reflect.DeepEqual(*c.settings(), Config{})
+}
+
+func (c *Config) setContext(name string, settings *Config) {
+	if c.Contexts == nil {
+		c.Contexts = map[string]*Config{}
+	}
+	c.Contexts[name] = settings.settings()
+}
+
+// GetContexts returns the name of the current context and the settings of every context.
+func GetContexts() (current string, contexts map[string]*Config, err error) {
+	contexts = map[string]*Config{}
+	cfg, err := load()
+	if err != nil || cfg == nil {
+		return
+	}
+	for name, settings := range cfg.Contexts {
+		contexts[name] = settings
+	}
+	current = cfg.Context()
+	if !cfg.isEmpty() || cfg.CurrentContext != "" {
+		contexts[current] = cfg.settings()
+	}
+	return
+}
+
+// ContextNames returns the sorted names of the given contexts.
+func ContextNames(contexts map[string]*Config) []string {
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UseContext makes the given context the current one. The settings of the previous context are
+// stored aside so that it can be used again later.
+func UseContext(name string) error {
+	cfg, err := load()
+	if err != nil {
+		return err
+	}
+	if cfg != nil && cfg.Context() == name {
+		return nil
+	}
+	if cfg == nil || cfg.Contexts[name] == nil {
+		return fmt.Errorf("Context '%s' doesn't exist, run 'rosa login --context %s' to create it", name, name)
+	}
+
+	next := cfg.Contexts[name].settings()
+	next.Contexts = cfg.Contexts
+	delete(next.Contexts, name)
+	if !cfg.isEmpty() {
+		next.setContext(cfg.Context(), cfg)
+	}
+	next.CurrentContext = name
+	return save(next)
+}
+
+// DeleteContext removes the given context. The current context can't be deleted.
+func DeleteContext(name string) error {
+	cfg, err := load()
+	if err != nil {
+		return err
+	}
+	if cfg != nil && cfg.Context() == name {
+		return fmt.Errorf("Context '%s' is the current context, switch to another context before deleting it",
+			name)
+	}
+	if cfg == nil || cfg.Contexts[name] == nil {
+		return fmt.Errorf("Context '%s' doesn't exist", name)
+	}
+	delete(cfg.Contexts, name)
+	return save(cfg)
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Contexts", func() {
+	BeforeEach(func() {
+		tmpdir, err := os.MkdirTemp("/tmp", ".ocm-config-*")
+		Expect(err).NotTo(HaveOccurred())
+		os.Setenv("OCM_CONFIG", tmpdir+"/ocm_config.json")
+		DeferCleanup(func() {
+			SelectContext("")
+			os.Setenv("OCM_CONFIG", "")
+			os.RemoveAll(tmpdir)
+		})
+
+		Expect(Save(&Config{URL: "https://api.openshift.com", AccessToken: "prod-token"})).To(Succeed())
+	})
+
+	It("Saves a new context aside the current one", func() {
+		SelectContext("staging")
+		cfg, err := Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg).To(BeNil())
+
+		Expect(Save(&Config{URL: "https://api.stage.openshift.com", AWSProfile: "stage"})).To(Succeed())
+		cfg, err = Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.URL).To(Equal("https://api.stage.openshift.com"))
+		Expect(cfg.Context()).To(Equal("staging"))
+
+		SelectContext("")
+		cfg, err = Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.AccessToken).To(Equal("prod-token"))
+		Expect(cfg.Context()).To(Equal(DefaultContext))
+	})
+
+	It("Switches between contexts", func() {
+		SelectContext("staging")
+		Expect(Save(&Config{URL: "https://api.stage.openshift.com"})).To(Succeed())
+		SelectContext("")
+
+		Expect(UseContext("staging")).To(Succeed())
+		cfg, err := Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.URL).To(Equal("https://api.stage.openshift.com"))
+		Expect(cfg.Contexts).To(HaveKey(DefaultContext))
+		Expect(cfg.Contexts).NotTo(HaveKey("staging"))
+
+		current, contexts, err := GetContexts()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current).To(Equal("staging"))
+		Expect(ContextNames(contexts)).To(Equal([]string{DefaultContext, "staging"}))
+
+		Expect(UseContext(DefaultContext)).To(Succeed())
+		cfg, err = Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.AccessToken).To(Equal("prod-token"))
+		Expect(cfg.CurrentContext).To(Equal(DefaultContext))
+	})
+
+	It("Fails to switch to a context that doesn't exist", func() {
+		Expect(UseContext("missing")).To(MatchError(ContainSubstring("Context 'missing' doesn't exist")))
+	})
+
+	It("Deletes contexts other than the current one", func() {
+		SelectContext("staging")
+		Expect(Save(&Config{URL: "https://api.stage.openshift.com"})).To(Succeed())
+		SelectContext("")
+
+		Expect(DeleteContext(DefaultContext)).To(MatchError(ContainSubstring("is the current context")))
+		Expect(DeleteContext("staging")).To(Succeed())
+		_, contexts, err := GetContexts()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ContextNames(contexts)).To(Equal([]string{DefaultContext}))
+	})
+
+	It("Only removes the selected context when logging out", func() {
+		SelectContext("staging")
+		Expect(Save(&Config{URL: "https://api.stage.openshift.com"})).To(Succeed())
+		Expect(Remove()).To(Succeed())
+		SelectContext("")
+
+		cfg, err := Load()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.AccessToken).To(Equal("prod-token"))
+		Expect(cfg.Contexts).To(BeEmpty())
+	})
+})
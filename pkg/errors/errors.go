@@ -0,0 +1,274 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package errors contains the errors reported by the commands, grouped in categories that
+// determine the exit code of the command. The exit codes are part of the interface of the tool
+// and must not change:
+//
+//	0   Success
+//	1   General error
+//	2   Differences found by 'rosa diff'
+//	3   Invalid usage, like a missing or invalid flag
+//	4   Resource not found
+//	5   Conflict with an existing resource
+//	6   Not logged in to OCM, or the OCM token expired
+//	7   Operation not allowed for the user
+//	8   Quota exceeded
+//	9   Missing or invalid AWS credentials
+//	10  Other error returned by AWS
+//	11  OCM is unavailable or returned a server error
+//	12  Timed out waiting for an operation, or the time given with '--timeout' expired
+//	130 Interrupted with Ctrl-C or SIGTERM
+//
+// When the '--output json' flag is used the error is also written to the standard error stream as
+// a JSON document with the category, the exit code and the OCM or AWS error code.
+//
+// The errors get these exit codes when they are passed to Print: the errors returned to the runner
+// created with rosa.DefaultRunner, and the errors that commands report themselves with
+// 'cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, ...)))'. Commands that
+// report an error with the reporter and exit with code 1 don't tell its category, use Print instead.
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/smithy-go"
+	"github.com/zgalor/weberr"
+)
+
+type Category string
+
+const (
+	CategoryGeneral        Category = "general"
+	CategoryUsage          Category = "usage"
+	CategoryNotFound       Category = "not_found"
+	CategoryConflict       Category = "conflict"
+	CategoryUnauthorized   Category = "unauthorized"
+	CategoryForbidden      Category = "forbidden"
+	CategoryQuotaExceeded  Category = "quota_exceeded"
+	CategoryAWSCredentials Category = "aws_credentials"
+	CategoryAWS            Category = "aws"
+	CategoryOCMServer      Category = "ocm_server"
+	CategoryTimeout        Category = "timeout"
+	CategoryInterrupted    Category = "interrupted"
+)
+
+// DriftExitCode is the exit code of 'rosa diff' when differences are found. It isn't an error, so it
+// has no category.
+const DriftExitCode = 2
+
+// DriftError is returned by the commands that compare resources, like 'rosa diff cluster', when they
+// find differences. The differences are the output of the command, so the error isn't printed and
+// the command exits with DriftExitCode.
+type DriftError struct {
+	Message string
+}
+
+// NewDriftError returns the error for the differences found by a command.
+func NewDriftError(format string, args ...interface{}) error {
+	return &DriftError{Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *DriftError) Error() string {
+	return e.Message
+}
+
+var exitCodes = map[Category]int{
+	CategoryGeneral:        1,
+	CategoryUsage:          3,
+	CategoryNotFound:       4,
+	CategoryConflict:       5,
+	CategoryUnauthorized:   6,
+	CategoryForbidden:      7,
+	CategoryQuotaExceeded:  8,
+	CategoryAWSCredentials: 9,
+	CategoryAWS:            10,
+	CategoryOCMServer:      11,
+	CategoryTimeout:        12,
+	CategoryInterrupted:    130,
+}
+
+// ExitCode returns the exit code of the category.
+func (c Category) ExitCode() int {
+	if code, ok := exitCodes[c]; ok {
+		return code
+	}
+	return exitCodes[CategoryGeneral]
+}
+
+// Error is an error of a known category. It may wrap the error returned by OCM or AWS.
+type Error struct {
+	Category Category
+	// Code is the error code returned by OCM or AWS, for example 'CLUSTERS-MGMT-404' or
+	// 'NoSuchEntity'
+	Code string
+	// Status is the HTTP status returned by OCM
+	Status  int
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" && e.Err != nil {
+		return e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Type returns the HTTP status as a weberr type, so that 'weberr.GetType' keeps working for the
+// errors returned by the OCM client.
+func (e *Error) Type() weberr.ErrorType {
+	return weberr.ErrorType(e.Status)
+}
+
+// ExitCode returns the exit code of the category of the error.
+func (e *Error) ExitCode() int {
+	return e.Category.ExitCode()
+}
+
+// New returns an error of the given category.
+func New(category Category, format string, args ...interface{}) error {
+	return &Error{Category: category, Message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap sets the category of an error, keeping its message. It returns nil for a nil error.
+func Wrap(category Category, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Category: category, Message: err.Error(), Err: err}
+}
+
+// Wrapf adds a message to an error, keeping its category and error code.
+func Wrapf(err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	classified := Classify(err)
+	return &Error{
+		Category: classified.Category,
+		Code:     classified.Code,
+		Status:   classified.Status,
+		Message:  fmt.Sprintf("%s: %v", fmt.Sprintf(format, args...), err),
+		Err:      err,
+	}
+}
+
+// NewOCMError returns the error for a response of OCM, categorized by HTTP status.
+func NewOCMError(status int, code string, message string) error {
+	category := CategoryGeneral
+	switch {
+	case status == http.StatusPaymentRequired || isQuotaMessage(message):
+		category = CategoryQuotaExceeded
+	case status == http.StatusBadRequest:
+		category = CategoryUsage
+	case status == http.StatusUnauthorized:
+		category = CategoryUnauthorized
+	case status == http.StatusForbidden:
+		category = CategoryForbidden
+	case status == http.StatusNotFound:
+		category = CategoryNotFound
+	case status == http.StatusConflict:
+		category = CategoryConflict
+	case status >= http.StatusInternalServerError:
+		category = CategoryOCMServer
+	}
+	return &Error{Category: category, Code: code, Status: status, Message: message}
+}
+
+func isQuotaMessage(message string) bool {
+	return strings.Contains(strings.ToLower(message), "quota")
+}
+
+// AWS error codes grouped by category, the other codes are reported as 'aws'
+var awsCategories = map[string]Category{
+	"AuthFailure":                   CategoryAWSCredentials,
+	"ExpiredToken":                  CategoryAWSCredentials,
+	"ExpiredTokenException":         CategoryAWSCredentials,
+	"IncompleteSignature":           CategoryAWSCredentials,
+	"InvalidAccessKeyId":            CategoryAWSCredentials,
+	"InvalidClientTokenId":          CategoryAWSCredentials,
+	"RequestExpired":                CategoryAWSCredentials,
+	"SignatureDoesNotMatch":         CategoryAWSCredentials,
+	"UnrecognizedClientException":   CategoryAWSCredentials,
+	"AccessDenied":                  CategoryForbidden,
+	"AccessDeniedException":         CategoryForbidden,
+	"OptInRequired":                 CategoryForbidden,
+	"UnauthorizedOperation":         CategoryForbidden,
+	"NoSuchEntity":                  CategoryNotFound,
+	"ResourceNotFoundException":     CategoryNotFound,
+	"DeleteConflict":                CategoryConflict,
+	"EntityAlreadyExists":           CategoryConflict,
+	"AddressLimitExceeded":          CategoryQuotaExceeded,
+	"InstanceLimitExceeded":         CategoryQuotaExceeded,
+	"LimitExceeded":                 CategoryQuotaExceeded,
+	"ServiceQuotaExceededException": CategoryQuotaExceeded,
+	"VpcLimitExceeded":              CategoryQuotaExceeded,
+	"InvalidParameterValue":         CategoryUsage,
+	"MalformedPolicyDocument":       CategoryUsage,
+	"ValidationError":               CategoryUsage,
+}
+
+// Classify returns the category and the error code of an error. Errors of the OCM client, of the
+// AWS SDK and timeouts are recognized even when they are wrapped with '%w'. Other errors are in the
+// general category.
+func Classify(err error) *Error {
+	var typed *Error
+	if errors.As(err, &typed) {
+		return typed
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		category, ok := awsCategories[apiErr.ErrorCode()]
+		if !ok {
+			category = CategoryAWS
+		}
+		return &Error{Category: category, Code: apiErr.ErrorCode(), Message: err.Error(), Err: err}
+	}
+	var webErr interface{ Type() weberr.ErrorType }
+	if errors.As(err, &webErr) && webErr.Type() != weberr.NoType {
+		classified := NewOCMError(int(webErr.Type()), "", err.Error()).(*Error)
+		classified.Err = err
+		return classified
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &Error{Category: CategoryTimeout, Message: err.Error(), Err: err}
+	}
+	if errors.Is(err, context.Canceled) {
+		return &Error{Category: CategoryInterrupted, Message: err.Error(), Err: err}
+	}
+	return &Error{Category: CategoryGeneral, Message: err.Error(), Err: err}
+}
+
+// ExitCode returns the exit code of the command for the given error, 0 for nil.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var drift *DriftError
+	if errors.As(err, &drift) {
+		return DriftExitCode
+	}
+	return Classify(err).ExitCode()
+}
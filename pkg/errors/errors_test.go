@@ -0,0 +1,134 @@
+package errors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/zgalor/weberr"
+)
+
+var _ = Describe("Errors", func() {
+	Context("Classify", func() {
+		DescribeTable("Categorizes OCM responses by status",
+			func(status int, message string, category Category) {
+				err := NewOCMError(status, "CLUSTERS-MGMT-1", message)
+				Expect(Classify(err).Category).To(Equal(category))
+				Expect(err.Error()).To(Equal(message))
+			},
+			Entry("bad request", http.StatusBadRequest, "Invalid name", CategoryUsage),
+			Entry("unauthorized", http.StatusUnauthorized, "Token expired", CategoryUnauthorized),
+			Entry("forbidden", http.StatusForbidden, "Not allowed", CategoryForbidden),
+			Entry("not found", http.StatusNotFound, "No cluster", CategoryNotFound),
+			Entry("conflict", http.StatusConflict, "Already exists", CategoryConflict),
+			Entry("payment required", http.StatusPaymentRequired, "No subscription", CategoryQuotaExceeded),
+			Entry("quota in the reason", http.StatusBadRequest, "Insufficient quota", CategoryQuotaExceeded),
+			Entry("server error", http.StatusServiceUnavailable, "Unavailable", CategoryOCMServer),
+			Entry("unknown", http.StatusTeapot, "Teapot", CategoryGeneral),
+		)
+
+		It("Keeps the weberr type of OCM errors", func() {
+			err := NewOCMError(http.StatusNotFound, "CLUSTERS-MGMT-404", "No cluster")
+			Expect(weberr.GetType(err)).To(Equal(weberr.NotFound))
+		})
+
+		It("Finds wrapped errors", func() {
+			err := fmt.Errorf("Failed to get cluster: %w",
+				NewOCMError(http.StatusNotFound, "CLUSTERS-MGMT-404", "No cluster"))
+			classified := Classify(err)
+			Expect(classified.Category).To(Equal(CategoryNotFound))
+			Expect(classified.Code).To(Equal("CLUSTERS-MGMT-404"))
+			Expect(ExitCode(err)).To(Equal(4))
+		})
+
+		It("Categorizes weberr types", func() {
+			Expect(Classify(weberr.Conflict.Errorf("Exists")).Category).To(Equal(CategoryConflict))
+			Expect(Classify(weberr.Errorf("Untyped")).Category).To(Equal(CategoryGeneral))
+		})
+
+		DescribeTable("Categorizes AWS error codes",
+			func(code string, category Category) {
+				err := fmt.Errorf("Failed to get role: %w", &smithy.GenericAPIError{Code: code, Message: "failed"})
+				classified := Classify(err)
+				Expect(classified.Category).To(Equal(category))
+				Expect(classified.Code).To(Equal(code))
+			},
+			Entry("invalid token", "InvalidClientTokenId", CategoryAWSCredentials),
+			Entry("bad signature", "SignatureDoesNotMatch", CategoryAWSCredentials),
+			Entry("access denied", "AccessDenied", CategoryForbidden),
+			Entry("no such entity", "NoSuchEntity", CategoryNotFound),
+			Entry("already exists", "EntityAlreadyExists", CategoryConflict),
+			Entry("limit exceeded", "LimitExceeded", CategoryQuotaExceeded),
+			Entry("other", "Throttling", CategoryAWS),
+		)
+
+		It("Categorizes deadlines as timeouts", func() {
+			err := fmt.Errorf("Failed to list clusters: %w", context.DeadlineExceeded)
+			Expect(Classify(err).Category).To(Equal(CategoryTimeout))
+		})
+
+		It("Categorizes other errors as general", func() {
+			Expect(ExitCode(fmt.Errorf("Something failed"))).To(Equal(1))
+			Expect(ExitCode(nil)).To(Equal(0))
+		})
+	})
+
+	Context("Wrapping", func() {
+		It("Wraps an error in a category", func() {
+			err := Wrap(CategoryUsage, fmt.Errorf("Invalid flag"))
+			Expect(err.Error()).To(Equal("Invalid flag"))
+			Expect(ExitCode(err)).To(Equal(3))
+			Expect(Wrap(CategoryUsage, nil)).To(BeNil())
+		})
+
+		It("Keeps the category and code when adding a message", func() {
+			err := Wrapf(NewOCMError(http.StatusForbidden, "ACCT-MGMT-11", "Not allowed"), "Failed to link role")
+			Expect(err.Error()).To(Equal("Failed to link role: Not allowed"))
+			classified := Classify(err)
+			Expect(classified.Category).To(Equal(CategoryForbidden))
+			Expect(classified.Code).To(Equal("ACCT-MGMT-11"))
+		})
+	})
+
+	Context("Exit codes", func() {
+		It("Has a distinct exit code per category", func() {
+			seen := map[int]Category{DriftExitCode: ""}
+			for category, code := range exitCodes {
+				Expect(seen).NotTo(HaveKey(code), "exit code %d of %s is already used", code, category)
+				seen[code] = category
+			}
+		})
+
+		It("Uses the general exit code for unknown categories", func() {
+			Expect(Category("unknown").ExitCode()).To(Equal(1))
+		})
+
+		It("Uses the drift exit code for differences", func() {
+			err := NewDriftError("Cluster 'mycluster' doesn't match spec file 'mycluster.yaml'")
+			Expect(ExitCode(fmt.Errorf("Failed: %w", err))).To(Equal(DriftExitCode))
+		})
+	})
+
+	Context("JSON document", func() {
+		It("Writes the category, exit code and error code", func() {
+			var b bytes.Buffer
+			err := NewOCMError(http.StatusNotFound, "CLUSTERS-MGMT-404", "No cluster")
+			Expect(WriteJSON(&b, fmt.Errorf("Failed to get cluster: %w", err))).To(Succeed())
+			var document map[string]interface{}
+			Expect(json.Unmarshal(b.Bytes(), &document)).To(Succeed())
+			Expect(document).To(Equal(map[string]interface{}{
+				"kind":      "Error",
+				"category":  "not_found",
+				"exit_code": float64(4),
+				"code":      "CLUSTERS-MGMT-404",
+				"status":    float64(404),
+				"message":   "Failed to get cluster: No cluster",
+			}))
+		})
+	})
+})
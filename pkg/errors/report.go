@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+// Document is the JSON representation of an error written to the standard error stream when the
+// '--output json' flag is used.
+type Document struct {
+	Kind     string   `json:"kind"`
+	Category Category `json:"category"`
+	ExitCode int      `json:"exit_code"`
+	Code     string   `json:"code,omitempty"`
+	Status   int      `json:"status,omitempty"`
+	Message  string   `json:"message"`
+}
+
+// NewDocument returns the JSON representation of an error.
+func NewDocument(err error) Document {
+	classified := Classify(err)
+	return Document{
+		Kind:     "Error",
+		Category: classified.Category,
+		ExitCode: classified.ExitCode(),
+		Code:     classified.Code,
+		Status:   classified.Status,
+		Message:  err.Error(),
+	}
+}
+
+// Print writes the error to the standard error stream, as a JSON document when the '--output json'
+// flag is used, and returns the exit code for the error. Differences found by a command aren't
+// printed, they are already part of its output.
+func Print(r *reporter.Object, err error) int {
+	var drift *DriftError
+	if errors.As(err, &drift) {
+		return DriftExitCode
+	}
+	if output.Output() == output.JSON {
+		if writeErr := WriteJSON(os.Stderr, err); writeErr == nil {
+			return ExitCode(err)
+		}
+	}
+	_ = r.Errorf("%s", err)
+	return ExitCode(err)
+}
+
+// WriteJSON writes the JSON representation of the error.
+func WriteJSON(w io.Writer, err error) error {
+	data, marshalErr := json.MarshalIndent(NewDocument(err), "", "  ")
+	if marshalErr != nil {
+		return marshalErr
+	}
+	_, writeErr := fmt.Fprintf(w, "%s\n", data)
+	return writeErr
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/reporter"
 )
 
@@ -161,10 +162,10 @@ func DisplaySpinnerWithDelay(reporter *reporter.Object, infoMessage string, dela
 		spin := spinner.New(spinner.CharSets[9], 100*time.Millisecond)
 		reporter.Infof(infoMessage)
 		spin.Start()
-		time.Sleep(delay)
+		_ = cmdcontext.Sleep(cmdcontext.Current(), delay)
 		spin.Stop()
 	} else {
-		time.Sleep(delay)
+		_ = cmdcontext.Sleep(cmdcontext.Current(), delay)
 	}
 }
 
@@ -0,0 +1,13 @@
+package iamplan
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestIAMPlan(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "IAM Plan Suite")
+}
@@ -0,0 +1,304 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package iamplan computes the AWS IAM resources that creating an STS cluster needs, together with
+// their current state, without creating or modifying any of them.
+package iamplan
+
+import (
+	"fmt"
+	"sort"
+
+	awserr "github.com/openshift-online/ocm-common/pkg/aws/errors"
+	awsCommonValidations "github.com/openshift-online/ocm-common/pkg/aws/validations"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+type State string
+
+const (
+	// StateExists is a resource that exists and can be used as is
+	StateExists State = "exists"
+	// StateMissing is a resource that has to be created
+	StateMissing State = "missing"
+	// StateOutdated is a resource that exists but isn't compatible with the cluster version
+	StateOutdated State = "outdated"
+)
+
+type Kind string
+
+const (
+	KindAccountRole  Kind = "account-role"
+	KindOperatorRole Kind = "operator-role"
+	KindPolicy       Kind = "policy"
+	KindOIDCConfig   Kind = "oidc-config"
+	KindOIDCProvider Kind = "oidc-provider"
+)
+
+// Resource is a single IAM resource required by the cluster.
+type Resource struct {
+	Kind    Kind   `json:"kind"`
+	Name    string `json:"name"`
+	ARN     string `json:"arn,omitempty"`
+	State   State  `json:"state"`
+	Version string `json:"version,omitempty"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// Plan lists the IAM resources required by a cluster in the order they are used by the
+// installation: account roles first, then operator roles and finally the OIDC configuration.
+type Plan struct {
+	Resources []Resource `json:"resources"`
+}
+
+// Count returns the number of resources of the plan in the given state.
+func (p *Plan) Count(state State) int {
+	count := 0
+	for _, resource := range p.Resources {
+		if resource.State == state {
+			count++
+		}
+	}
+	return count
+}
+
+// Input holds the settings of the cluster that determine its IAM resources.
+type Input struct {
+	// Version is the OpenShift version of the cluster
+	Version          string
+	ManagedPolicies  bool
+	HostedCPPolicies bool
+	SharedVPC        bool
+	Creator          *aws.Creator
+
+	// AccountRoleARNs maps the account role types to the ARNs of the roles
+	AccountRoleARNs     map[string]string
+	AccountRolePrefix   string
+	OperatorRolesPrefix string
+	OperatorRolePath    string
+
+	CredRequests map[string]*cmv1.STSOperator
+	// Policies are the managed policies returned by OCM, only used with managed policies
+	Policies   map[string]*cmv1.AWSSTSPolicy
+	OidcConfig *cmv1.OidcConfig
+}
+
+// Order in which the account roles are listed
+var accountRoleTypes = []string{
+	aws.InstallerAccountRole,
+	aws.SupportAccountRole,
+	aws.ControlPlaneAccountRole,
+	aws.WorkerAccountRole,
+}
+
+// Build looks up every IAM resource required by the cluster. Only read operations of the AWS
+// client are used.
+func Build(awsClient aws.Client, input Input) (*Plan, error) {
+	builder := &planBuilder{
+		awsClient: awsClient,
+		input:     input,
+		minor:     ocm.GetVersionMinor(input.Version),
+		plan:      &Plan{Resources: []Resource{}},
+	}
+	steps := []func() error{
+		builder.accountRoles,
+		builder.operatorRoles,
+		builder.oidc,
+	}
+	for _, step := range steps {
+		err := step()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return builder.plan, nil
+}
+
+type planBuilder struct {
+	awsClient aws.Client
+	input     Input
+	minor     string
+	plan      *Plan
+}
+
+func (b *planBuilder) add(resource Resource) {
+	b.plan.Resources = append(b.plan.Resources, resource)
+}
+
+func (b *planBuilder) accountRoles() error {
+	for _, roleType := range accountRoleTypes {
+		roleARN := b.input.AccountRoleARNs[roleType]
+		if roleARN == "" {
+			continue
+		}
+		roleName, err := aws.GetResourceIdFromARN(roleARN)
+		if err != nil {
+			return err
+		}
+
+		resource := Resource{Kind: KindAccountRole, Name: roleName, ARN: roleARN, State: StateExists}
+		role, err := b.awsClient.GetRoleByARN(roleARN)
+		switch {
+		case awserr.IsNoSuchEntityException(err):
+			resource.State = StateMissing
+		case err != nil:
+			return fmt.Errorf("Failed to get account role '%s': %v", roleARN, err)
+		case b.input.ManagedPolicies:
+			resource.Detail = "Uses AWS managed policies"
+		default:
+			_, resource.Version = aws.GetTagValues(role.Tags)
+			compatible, err := awsCommonValidations.HasCompatibleVersionTags(role.Tags, b.minor)
+			if err != nil {
+				return fmt.Errorf("Failed to validate account role '%s': %v", roleARN, err)
+			}
+			if !compatible {
+				resource.State = StateOutdated
+			}
+		}
+		b.add(resource)
+
+		if b.input.ManagedPolicies {
+			continue
+		}
+		path, err := aws.GetPathFromARN(roleARN)
+		if err != nil {
+			return err
+		}
+		err = b.policy(aws.GetPolicyARN(b.input.Creator.Partition, b.input.Creator.AccountID, roleName, path))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *planBuilder) operatorRoles() error {
+	keys := make([]string, 0, len(b.input.CredRequests))
+	for key := range b.input.CredRequests {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		operator := b.input.CredRequests[key]
+		if operator.MinVersion() != "" {
+			isSupported, err := ocm.CheckSupportedVersion(b.minor, operator.MinVersion())
+			if err != nil {
+				return fmt.Errorf("Failed to validate version of operator role '%s': %v", operator.Name(), err)
+			}
+			if !isSupported {
+				continue
+			}
+		}
+
+		roleARN := aws.ComputeOperatorRoleArn(b.input.OperatorRolesPrefix, operator, b.input.Creator,
+			b.input.OperatorRolePath)
+		roleName, err := aws.GetResourceIdFromARN(roleARN)
+		if err != nil {
+			return err
+		}
+		resource := Resource{
+			Kind:   KindOperatorRole,
+			Name:   roleName,
+			ARN:    roleARN,
+			State:  StateExists,
+			Detail: fmt.Sprintf("%s/%s", operator.Namespace(), operator.Name()),
+		}
+		_, err = b.awsClient.GetRoleByName(roleName)
+		if awserr.IsNoSuchEntityException(err) {
+			resource.State = StateMissing
+		} else if err != nil {
+			return fmt.Errorf("Failed to get operator role '%s': %v", roleName, err)
+		}
+		b.add(resource)
+
+		if b.input.ManagedPolicies {
+			policyKey := aws.GetOperatorPolicyKey(key, b.input.HostedCPPolicies, b.input.SharedVPC)
+			policyARN, err := aws.GetManagedPolicyARN(b.input.Policies, policyKey)
+			if err != nil {
+				return err
+			}
+			b.add(Resource{Kind: KindPolicy, Name: policyKey, ARN: policyARN, State: StateExists,
+				Detail: "AWS managed policy"})
+			continue
+		}
+		err = b.policy(aws.GetOperatorPolicyARN(b.input.Creator.Partition, b.input.Creator.AccountID,
+			b.input.AccountRolePrefix, operator.Namespace(), operator.Name(), b.input.OperatorRolePath))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// policy adds a customer managed policy, which is outdated when its version tag isn't compatible
+// with the cluster version.
+func (b *planBuilder) policy(policyARN string) error {
+	policyName, err := aws.GetResourceIdFromARN(policyARN)
+	if err != nil {
+		return err
+	}
+	resource := Resource{Kind: KindPolicy, Name: policyName, ARN: policyARN, State: StateExists}
+	_, err = b.awsClient.IsPolicyExists(policyARN)
+	if awserr.IsNoSuchEntityException(err) {
+		resource.State = StateMissing
+		b.add(resource)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to get policy '%s': %v", policyARN, err)
+	}
+	compatible, err := b.awsClient.IsPolicyCompatible(policyARN, b.minor)
+	if err != nil {
+		return fmt.Errorf("Failed to validate policy '%s': %v", policyARN, err)
+	}
+	if !compatible {
+		resource.State = StateOutdated
+	}
+	b.add(resource)
+	return nil
+}
+
+func (b *planBuilder) oidc() error {
+	oidcConfig := b.input.OidcConfig
+	if oidcConfig == nil {
+		// Without a registered configuration the OIDC provider is created along with the cluster
+		b.add(Resource{Kind: KindOIDCConfig, State: StateMissing, Detail: "Created with the cluster"})
+		b.add(Resource{Kind: KindOIDCProvider, State: StateMissing, Detail: "Created after the cluster"})
+		return nil
+	}
+
+	detail := "Managed"
+	if !oidcConfig.Managed() {
+		detail = "Unmanaged"
+	}
+	b.add(Resource{Kind: KindOIDCConfig, Name: oidcConfig.ID(), State: StateExists, Detail: detail})
+
+	resource := Resource{Kind: KindOIDCProvider, Name: oidcConfig.IssuerUrl(), State: StateExists}
+	exists, err := b.awsClient.HasOpenIDConnectProvider(oidcConfig.IssuerUrl(),
+		b.input.Creator.Partition, b.input.Creator.AccountID)
+	if err != nil {
+		return fmt.Errorf("Failed to get OIDC provider '%s': %v", oidcConfig.IssuerUrl(), err)
+	}
+	if !exists {
+		resource.State = StateMissing
+	}
+	b.add(resource)
+	return nil
+}
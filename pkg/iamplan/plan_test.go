@@ -0,0 +1,141 @@
+package iamplan
+
+import (
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	awsCommonValidations "github.com/openshift-online/ocm-common/pkg/aws/validations"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+)
+
+const (
+	installerARN = "arn:aws:iam::123456789012:role/prefix-Installer-Role"
+	workerARN    = "arn:aws:iam::123456789012:role/prefix-Worker-Role"
+)
+
+var _ = Describe("Build", func() {
+	var mockClient *aws.MockClient
+	var input Input
+
+	versionTags := func(version string) []iamtypes.Tag {
+		return []iamtypes.Tag{{
+			Key:   awssdk.String(awsCommonValidations.OpenShiftVersion),
+			Value: awssdk.String(version),
+		}}
+	}
+
+	BeforeEach(func() {
+		mockClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		operator, err := cmv1.NewSTSOperator().Name("ebs-cloud-credentials").
+			Namespace("openshift-cluster-csi-drivers").Build()
+		Expect(err).NotTo(HaveOccurred())
+		input = Input{
+			Version: "4.15.2",
+			Creator: &aws.Creator{AccountID: "123456789012", Partition: "aws"},
+			AccountRoleARNs: map[string]string{
+				aws.InstallerAccountRole: installerARN,
+				aws.WorkerAccountRole:    workerARN,
+			},
+			AccountRolePrefix:   "prefix",
+			OperatorRolesPrefix: "mycluster",
+			CredRequests:        map[string]*cmv1.STSOperator{"csi_driver": operator},
+		}
+	})
+
+	It("Reports the state of unmanaged roles and policies", func() {
+		mockClient.EXPECT().GetRoleByARN(installerARN).Return(iamtypes.Role{Tags: versionTags("4.15")}, nil)
+		mockClient.EXPECT().GetRoleByARN(workerARN).Return(iamtypes.Role{Tags: versionTags("4.12")}, nil)
+		mockClient.EXPECT().IsPolicyExists("arn:aws:iam::123456789012:policy/prefix-Installer-Role-Policy").
+			Return(nil, nil)
+		mockClient.EXPECT().IsPolicyCompatible("arn:aws:iam::123456789012:policy/prefix-Installer-Role-Policy",
+			"4.15").Return(true, nil)
+		mockClient.EXPECT().IsPolicyExists("arn:aws:iam::123456789012:policy/prefix-Worker-Role-Policy").
+			Return(nil, nil)
+		mockClient.EXPECT().IsPolicyCompatible("arn:aws:iam::123456789012:policy/prefix-Worker-Role-Policy",
+			"4.15").Return(false, nil)
+		mockClient.EXPECT().GetRoleByName("mycluster-openshift-cluster-csi-drivers-ebs-cloud-credentials").
+			Return(iamtypes.Role{}, &iamtypes.NoSuchEntityException{})
+		mockClient.EXPECT().IsPolicyExists(
+			"arn:aws:iam::123456789012:policy/prefix-openshift-cluster-csi-drivers-ebs-cloud-credentials").
+			Return(nil, &iamtypes.NoSuchEntityException{})
+
+		plan, err := Build(mockClient, input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Resources).To(Equal([]Resource{
+			{Kind: KindAccountRole, Name: "prefix-Installer-Role", ARN: installerARN, State: StateExists,
+				Version: "4.15"},
+			{Kind: KindPolicy, Name: "prefix-Installer-Role-Policy",
+				ARN: "arn:aws:iam::123456789012:policy/prefix-Installer-Role-Policy", State: StateExists},
+			{Kind: KindAccountRole, Name: "prefix-Worker-Role", ARN: workerARN, State: StateOutdated,
+				Version: "4.12"},
+			{Kind: KindPolicy, Name: "prefix-Worker-Role-Policy",
+				ARN: "arn:aws:iam::123456789012:policy/prefix-Worker-Role-Policy", State: StateOutdated},
+			{Kind: KindOperatorRole, Name: "mycluster-openshift-cluster-csi-drivers-ebs-cloud-credentials",
+				ARN:    "arn:aws:iam::123456789012:role/mycluster-openshift-cluster-csi-drivers-ebs-cloud-credentials",
+				State:  StateMissing,
+				Detail: "openshift-cluster-csi-drivers/ebs-cloud-credentials"},
+			{Kind: KindPolicy, Name: "prefix-openshift-cluster-csi-drivers-ebs-cloud-credentials",
+				ARN:   "arn:aws:iam::123456789012:policy/prefix-openshift-cluster-csi-drivers-ebs-cloud-credentials",
+				State: StateMissing},
+			{Kind: KindOIDCConfig, State: StateMissing, Detail: "Created with the cluster"},
+			{Kind: KindOIDCProvider, State: StateMissing, Detail: "Created after the cluster"},
+		}))
+		Expect(plan.Count(StateMissing)).To(Equal(4))
+		Expect(plan.Count(StateOutdated)).To(Equal(2))
+	})
+
+	It("Uses the managed policies and checks the OIDC provider", func() {
+		input.ManagedPolicies = true
+		input.HostedCPPolicies = true
+		input.AccountRoleARNs = map[string]string{aws.InstallerAccountRole: installerARN}
+		input.Policies = map[string]*cmv1.AWSSTSPolicy{}
+		policy, err := cmv1.NewAWSSTSPolicy().ARN("arn:aws:iam::aws:policy/service-role/ROSAKubeControllerPolicy").
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		input.Policies["openshift_hcp_csi_driver_policy"] = policy
+		input.OidcConfig, err = cmv1.NewOidcConfig().ID("123").Managed(true).
+			IssuerUrl("https://oidc.example.com/123").Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		mockClient.EXPECT().GetRoleByARN(installerARN).Return(iamtypes.Role{}, nil)
+		mockClient.EXPECT().GetRoleByName(gomock.Any()).Return(iamtypes.Role{}, nil)
+		mockClient.EXPECT().HasOpenIDConnectProvider("https://oidc.example.com/123", "aws", "123456789012").
+			Return(false, nil)
+
+		plan, err := Build(mockClient, input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Resources).To(HaveLen(5))
+		Expect(plan.Resources[0].Detail).To(Equal("Uses AWS managed policies"))
+		Expect(plan.Resources[2]).To(Equal(Resource{Kind: KindPolicy, Name: "openshift_hcp_csi_driver_policy",
+			ARN:   "arn:aws:iam::aws:policy/service-role/ROSAKubeControllerPolicy",
+			State: StateExists, Detail: "AWS managed policy"}))
+		Expect(plan.Resources[3]).To(Equal(Resource{Kind: KindOIDCConfig, Name: "123", State: StateExists,
+			Detail: "Managed"}))
+		Expect(plan.Resources[4]).To(Equal(Resource{Kind: KindOIDCProvider, Name: "https://oidc.example.com/123",
+			State: StateMissing}))
+	})
+
+	It("Skips operator roles that the cluster version doesn't support", func() {
+		operator, err := cmv1.NewSTSOperator().Name("cloud-credentials").Namespace("openshift-ingress-operator").
+			MinVersion("4.16").Build()
+		Expect(err).NotTo(HaveOccurred())
+		input.AccountRoleARNs = nil
+		input.CredRequests = map[string]*cmv1.STSOperator{"ingress": operator}
+
+		plan, err := Build(mockClient, input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Resources).To(HaveLen(2))
+		Expect(plan.Count(StateExists)).To(Equal(0))
+	})
+
+	It("Fails when a lookup fails", func() {
+		mockClient.EXPECT().GetRoleByARN(installerARN).Return(iamtypes.Role{}, &iamtypes.ServiceFailureException{})
+
+		_, err := Build(mockClient, input)
+		Expect(err).To(MatchError(ContainSubstring("Failed to get account role '" + installerARN + "'")))
+	})
+})
@@ -2,11 +2,12 @@ package oidc
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	. "github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -33,8 +34,7 @@ func GetOidcConfigID(r *rosa.Runtime, cmd *cobra.Command) string {
 		Required: true,
 	})
 	if err != nil {
-		r.Reporter.Errorf("Expected a valid OIDC Config ID: %s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid OIDC Config ID")))
 	}
 	return strings.TrimSpace(strings.Split(oidcConfigId, "|")[0])
 }
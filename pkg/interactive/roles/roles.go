@@ -2,13 +2,14 @@ package roles
 
 import (
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	. "github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/output"
@@ -26,8 +27,7 @@ func GetInstallerRoleArn(r *rosa.Runtime, cmd *cobra.Command,
 	// Find all installer roles in the current account using AWS resource tags
 	roleARNs, err := findRoleARNs(aws.InstallerAccountRole, minMinorVersion)
 	if err != nil {
-		r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to find %s role", role.Name)))
 	}
 	spin.Stop()
 
@@ -58,8 +58,7 @@ func GetInstallerRoleArn(r *rosa.Runtime, cmd *cobra.Command,
 				Required: true,
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid role ARN: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid role ARN")))
 			}
 		}
 	} else if len(roleARNs) == 1 {
@@ -71,7 +70,7 @@ func GetInstallerRoleArn(r *rosa.Runtime, cmd *cobra.Command,
 		createAccountRolesCommand := "rosa create account-roles"
 		r.Reporter.Warnf(fmt.Sprintf("No account roles found. You will need to manually set them in the "+
 			"next steps or run '%s' to create them first.", createAccountRolesCommand))
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	return roleARN
 }
@@ -2,7 +2,6 @@ package securitygroups
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 
 	awsSdk "github.com/aws/aws-sdk-go-v2/aws"
@@ -11,6 +10,8 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	. "github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -44,8 +45,8 @@ func GetSecurityGroupIds(r *rosa.Runtime, cmd *cobra.Command,
 	targetVpcId string, kind string, id string) []string {
 	possibleSgs, err := r.AWSClient.GetSecurityGroupIds(targetVpcId)
 	if err != nil {
-		r.Reporter.Errorf("There was a problem retrieving security groups for VPC '%s': %v", targetVpcId, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err,
+			"There was a problem retrieving security groups for VPC '%s'", targetVpcId)))
 	}
 	securityGroupIds := []string{}
 	if len(possibleSgs) > 0 {
@@ -67,8 +68,7 @@ func GetSecurityGroupIds(r *rosa.Runtime, cmd *cobra.Command,
 			Options:  options,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected valid Security Group IDs: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected valid Security Group IDs")))
 		}
 		for i, sg := range securityGroupIds {
 			securityGroupIds[i] = aws.ParseOption(sg)
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to implement the '--log-format' command line option.
+
+package logformat
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	Text = "text"
+	JSON = "json"
+)
+
+var format = Text
+
+var options = []string{Text, JSON}
+
+// AddFlag adds the log format flag to the given command and its subcommands.
+func AddFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(
+		&format,
+		"log-format",
+		Text,
+		fmt.Sprintf("Format of the messages written to the log. Allowed options are %s. With '%s' "+
+			"each message is a JSON object in a single line, with the level, the time, the command and "+
+			"the cluster.", options, JSON),
+	)
+
+	cmd.RegisterFlagCompletionFunc("log-format", completion)
+}
+
+func completion(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return options, cobra.ShellCompDirectiveDefault
+}
+
+// Validate checks that the value of the flag is one of the allowed options.
+func Validate() error {
+	for _, option := range options {
+		if format == option {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid log format '%s'. Allowed options are %s", format, options)
+}
+
+// UseJSON returns a bool that indicates whether the messages are written as JSON lines.
+func UseJSON() bool {
+	return format == JSON
+}
+
+func SetFormat(formatOption string) {
+	format = formatOption
+}
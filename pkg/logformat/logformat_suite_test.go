@@ -0,0 +1,13 @@
+package logformat
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestLogFormat(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Log format suite")
+}
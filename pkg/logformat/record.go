@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the JSON representation of the messages written to the log.
+
+package logformat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Record is the JSON object written for each message when the '--log-format=json' flag is used.
+type Record struct {
+	Level     string    `json:"level"`
+	Timestamp time.Time `json:"timestamp"`
+	Command   string    `json:"command,omitempty"`
+	ClusterID string    `json:"cluster_id,omitempty"`
+	Message   string    `json:"message"`
+}
+
+var (
+	lock      sync.Mutex
+	command   string
+	clusterID string
+)
+
+// SetCommand sets the command that is added to the messages, for example 'rosa create cluster'.
+func SetCommand(value string) {
+	lock.Lock()
+	defer lock.Unlock()
+	command = value
+}
+
+// SetClusterID sets the identifier of the cluster that is added to the messages once it is known.
+func SetClusterID(value string) {
+	lock.Lock()
+	defer lock.Unlock()
+	clusterID = value
+}
+
+// NewRecord returns the record of a message, with the current command and cluster.
+func NewRecord(level string, message string) Record {
+	lock.Lock()
+	defer lock.Unlock()
+	return Record{
+		Level:     level,
+		Timestamp: time.Now().UTC(),
+		Command:   command,
+		ClusterID: clusterID,
+		Message:   message,
+	}
+}
+
+// Write writes the record as a single line.
+func (r Record) Write(w io.Writer) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+// Formatter is a logrus formatter that writes the same JSON lines as the reporter.
+type Formatter struct{}
+
+// Format implements the logrus.Formatter interface.
+func (f *Formatter) Format(entry *logrus.Entry) ([]byte, error) {
+	record := NewRecord(entry.Level.String(), entry.Message)
+	record.Timestamp = entry.Time.UTC()
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
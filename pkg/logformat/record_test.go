@@ -0,0 +1,54 @@
+package logformat
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Log format", func() {
+	AfterEach(func() {
+		SetFormat(Text)
+		SetCommand("")
+		SetClusterID("")
+	})
+
+	It("Validates the format", func() {
+		SetFormat(JSON)
+		Expect(Validate()).To(Succeed())
+		Expect(UseJSON()).To(BeTrue())
+		SetFormat("xml")
+		Expect(Validate()).To(MatchError("Invalid log format 'xml'. Allowed options are [text json]"))
+		Expect(UseJSON()).To(BeFalse())
+	})
+
+	It("Omits the command and cluster until they are known", func() {
+		var b bytes.Buffer
+		Expect(NewRecord("info", "Hello").Write(&b)).To(Succeed())
+		Expect(b.String()).NotTo(ContainSubstring("command"))
+		Expect(b.String()).NotTo(ContainSubstring("cluster_id"))
+	})
+
+	It("Formats logrus entries as JSON lines", func() {
+		SetCommand("rosa create cluster")
+		SetClusterID("123")
+		var b bytes.Buffer
+		logger := logrus.New()
+		logger.SetOutput(&b)
+		logger.SetFormatter(&Formatter{})
+		logger.Warnf("Hello %s", "World")
+
+		var entry Record
+		Expect(json.Unmarshal(b.Bytes(), &entry)).To(Succeed())
+		Expect(entry.Level).To(Equal("warning"))
+		Expect(entry.Command).To(Equal("rosa create cluster"))
+		Expect(entry.ClusterID).To(Equal("123"))
+		Expect(entry.Message).To(Equal("Hello World"))
+		Expect(entry.Timestamp).To(BeTemporally("~", time.Now(), time.Minute))
+		Expect(entry.Timestamp.Location()).To(Equal(time.UTC))
+	})
+})
@@ -21,20 +21,34 @@ package logging
 import (
 	"fmt"
 
+	"github.com/aws/smithy-go/logging"
 	"github.com/sirupsen/logrus"
+
+	"github.com/openshift/rosa/pkg/redact"
 )
 
 // AWSLoggerBuilder contains the information and logic needed to create an AWS logger that uses
 // the logging framework of the project. Don't create instances of this type directly; use the
 // NewAWSLogger function instead.
 type AWSLoggerBuilder struct {
-	logger *logrus.Logger
+	logger   *logrus.Logger
+	redactor *redact.Redactor
 }
 
 // AWSLogger is an implementation of the OCM logger interface that uses the logging framework of
 // the project. Don't create instances of this type directly; use the NewAWSLogger function instead.
 type AWSLogger struct {
-	logger *logrus.Logger
+	logger   *logrus.Logger
+	redactor *redact.Redactor
+}
+
+// Make sure that we implement the logger interface of the AWS SDK.
+var _ logging.Logger = &AWSLogger{}
+
+// NewAWSLogger creates new builder that can then be used to configure and build an AWS logger that
+// uses the logging framework of the project.
+func NewAWSLogger() *AWSLoggerBuilder {
+	return &AWSLoggerBuilder{}
 }
 
 // Logger sets the underlying logger that will be used by the OCM logger to send the messages to the
@@ -44,6 +58,13 @@ func (b *AWSLoggerBuilder) Logger(value *logrus.Logger) *AWSLoggerBuilder {
 	return b
 }
 
+// Redactor sets the redactor applied to the messages, which contain the dumps of the requests and
+// responses when the AWS SDK logs them. The default is the redactor of the process.
+func (b *AWSLoggerBuilder) Redactor(value *redact.Redactor) *AWSLoggerBuilder {
+	b.redactor = value
+	return b
+}
+
 // Build uses the information stored in the builder to create a new OCM logger that uses the logging
 // framework of the project.
 func (b *AWSLoggerBuilder) Build() (result *AWSLogger, err error) {
@@ -53,14 +74,35 @@ func (b *AWSLoggerBuilder) Build() (result *AWSLogger, err error) {
 		return
 	}
 
+	redactor := b.redactor
+	if redactor == nil {
+		redactor = redact.Current()
+	}
+
 	// Create and populate the object:
 	result = &AWSLogger{
-		logger: b.logger,
+		logger:   b.logger,
+		redactor: redactor,
 	}
 
 	return
 }
 
 func (l *AWSLogger) Log(args ...interface{}) {
-	l.logger.Info(args...)
+	if l.logger.IsLevelEnabled(logrus.InfoLevel) {
+		l.logger.Info(l.redactor.Text(fmt.Sprint(args...)))
+	}
+}
+
+// Logf is the implementation of the logger interface of the AWS SDK. Warnings are sent to the log
+// as warnings, and the rest of the messages, including the dumps of requests and responses, as
+// debug messages.
+func (l *AWSLogger) Logf(classification logging.Classification, format string, args ...interface{}) {
+	level := logrus.DebugLevel
+	if classification == logging.Warn {
+		level = logrus.WarnLevel
+	}
+	if l.logger.IsLevelEnabled(level) {
+		l.logger.Log(level, l.redactor.Text(fmt.Sprintf(format, args...)))
+	}
 }
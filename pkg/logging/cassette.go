@@ -0,0 +1,471 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains an implementation of the http.RoundTripper interface that records the
+// requests sent and the responses received to cassette files, or that replays the responses
+// from those files instead of sending the requests.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/openshift/rosa/pkg/redact"
+)
+
+var cassetteFileName = regexp.MustCompile(`^\d+-.*\.json$`)
+
+const base64Encoding = "base64"
+
+// CassetteBuilder contains the information and logic needed to build a new cassette. Don't create
+// instances of this type directly; use the NewCassette function instead.
+type CassetteBuilder struct {
+	logger   *logrus.Logger
+	redactor *redact.Redactor
+	redact   []string
+	dir      string
+	replay   bool
+}
+
+// Cassette records HTTP exchanges to the files of a directory, one file per exchange, or replays
+// them from those files. The round trippers returned by the Wrap method of the same cassette share
+// the files, so all the clients of a process can use one cassette. Don't create instances of this
+// type directly; use the NewCassette function instead.
+type Cassette struct {
+	logger   *logrus.Logger
+	redactor *redact.Redactor
+	dir      string
+	replay   bool
+
+	lock      sync.Mutex
+	sequence  int
+	exchanges []*Exchange
+	used      []bool
+}
+
+// Exchange is a request and the response received for it, as stored in a cassette file. Bodies
+// that are JSON documents are stored in the JSON fields so that they are easy to read, the rest in
+// the Body fields, encoded with base64 when they aren't text.
+type Exchange struct {
+	Request  ExchangeRequest  `json:"request"`
+	Response ExchangeResponse `json:"response"`
+}
+
+// ExchangeRequest is the request of an exchange.
+type ExchangeRequest struct {
+	Method   string          `json:"method"`
+	URL      string          `json:"url"`
+	Header   http.Header     `json:"header,omitempty"`
+	Body     string          `json:"body,omitempty"`
+	JSON     json.RawMessage `json:"json,omitempty"`
+	Encoding string          `json:"encoding,omitempty"`
+}
+
+// ExchangeResponse is the response of an exchange.
+type ExchangeResponse struct {
+	Status   int             `json:"status"`
+	Header   http.Header     `json:"header,omitempty"`
+	Body     string          `json:"body,omitempty"`
+	JSON     json.RawMessage `json:"json,omitempty"`
+	Encoding string          `json:"encoding,omitempty"`
+}
+
+// NewCassette creates a builder that can then be used to create a cassette that records or
+// replays HTTP exchanges.
+func NewCassette() *CassetteBuilder {
+	return &CassetteBuilder{}
+}
+
+// Logger sets the logger that the cassette uses to report the exchanges that it records or
+// replays. This is mandatory.
+func (b *CassetteBuilder) Logger(value *logrus.Logger) *CassetteBuilder {
+	b.logger = value
+	return b
+}
+
+// Redactor sets the redactor applied to the exchanges written to the cassette files. The default
+// is the redactor of the process.
+func (b *CassetteBuilder) Redactor(value *redact.Redactor) *CassetteBuilder {
+	b.redactor = value
+	return b
+}
+
+// Redact specifies a field whose value is replaced in the bodies written to the cassette files, in
+// addition to the ones of the redactor. Fields of JSON documents, forms and XML documents are
+// redacted at any depth.
+func (b *CassetteBuilder) Redact(value string) *CassetteBuilder {
+	b.redact = append(b.redact, value)
+	return b
+}
+
+// Record sets the directory where the exchanges are written. The directory is created if it
+// doesn't exist, and the exchanges are added after the ones that it already contains.
+func (b *CassetteBuilder) Record(dir string) *CassetteBuilder {
+	b.dir = dir
+	b.replay = false
+	return b
+}
+
+// Replay sets the directory where the exchanges are read from.
+func (b *CassetteBuilder) Replay(dir string) *CassetteBuilder {
+	b.dir = dir
+	b.replay = true
+	return b
+}
+
+// Build uses the information stored in the builder to create a new cassette.
+func (b *CassetteBuilder) Build() (result *Cassette, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = fmt.Errorf("Logger is mandatory")
+		return
+	}
+	if b.dir == "" {
+		err = fmt.Errorf("Directory is mandatory")
+		return
+	}
+
+	redactor := b.redactor
+	if redactor == nil {
+		redactor = redact.Current()
+	}
+	if len(b.redact) > 0 {
+		redactor = redactor.With(b.redact...)
+	}
+	result = &Cassette{
+		logger:   b.logger,
+		redactor: redactor,
+		dir:      b.dir,
+		replay:   b.replay,
+	}
+
+	if b.replay {
+		err = result.load()
+		return
+	}
+	err = os.MkdirAll(b.dir, 0700)
+	if err != nil {
+		err = fmt.Errorf("Failed to create cassette directory '%s': %v", b.dir, err)
+		return
+	}
+	names, err := result.files()
+	if err != nil {
+		return
+	}
+	result.sequence = len(names)
+	return
+}
+
+// Replaying returns true if the cassette replays exchanges instead of recording them.
+func (c *Cassette) Replaying() bool {
+	return c.replay
+}
+
+// Exchanges returns the exchanges loaded from the cassette files when replaying.
+func (c *Cassette) Exchanges() []*Exchange {
+	return c.exchanges
+}
+
+// Wrap returns a round tripper that records the exchanges of the given round tripper to the
+// cassette, or that replays them from the cassette without calling it.
+func (c *Cassette) Wrap(next http.RoundTripper) http.RoundTripper {
+	return &cassetteRoundTripper{
+		cassette: c,
+		next:     next,
+	}
+}
+
+type cassetteRoundTripper struct {
+	cassette *Cassette
+	next     http.RoundTripper
+}
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (t *cassetteRoundTripper) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	var body []byte
+	if request.Body != nil {
+		body, err = io.ReadAll(request.Body)
+		if err != nil {
+			return
+		}
+		err = request.Body.Close()
+		if err != nil {
+			return
+		}
+		request.Body = io.NopCloser(bytes.NewBuffer(body))
+	}
+
+	if t.cassette.replay {
+		return t.cassette.play(request, body)
+	}
+
+	response, err = t.next.RoundTrip(request)
+	if err != nil {
+		return
+	}
+	var responseBody []byte
+	if response.Body != nil {
+		responseBody, err = io.ReadAll(response.Body)
+		if err != nil {
+			return
+		}
+		err = response.Body.Close()
+		if err != nil {
+			return
+		}
+		response.Body = io.NopCloser(bytes.NewBuffer(responseBody))
+	}
+	err = t.cassette.record(request, body, response, responseBody)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// record writes an exchange to a new file of the cassette.
+func (c *Cassette) record(request *http.Request, requestBody []byte, response *http.Response,
+	responseBody []byte) error {
+	exchange := &Exchange{
+		Request: ExchangeRequest{
+			Method: request.Method,
+			URL:    request.URL.String(),
+			Header: c.redactHeader(request.Header),
+		},
+		Response: ExchangeResponse{
+			Status: response.StatusCode,
+			Header: c.redactHeader(response.Header),
+		},
+	}
+	exchange.Request.Body, exchange.Request.JSON, exchange.Request.Encoding = c.redactBody(
+		request.Header, requestBody)
+	exchange.Response.Body, exchange.Response.JSON, exchange.Response.Encoding = c.redactBody(
+		response.Header, responseBody)
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(exchange)
+	if err != nil {
+		return fmt.Errorf("Failed to record request to '%s': %v", request.URL, err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.sequence++
+	name := fmt.Sprintf("%04d-%s-%s.json", c.sequence, strings.ToLower(request.Method),
+		strings.ReplaceAll(request.URL.Hostname(), ".", "-"))
+	err = os.WriteFile(filepath.Join(c.dir, name), buffer.Bytes(), 0600)
+	if err != nil {
+		return fmt.Errorf("Failed to record request to '%s': %v", request.URL, err)
+	}
+	c.logger.Debugf("Recorded %s '%s' to '%s'", request.Method, request.URL, name)
+	return nil
+}
+
+// play returns the response of the recorded exchange that matches the request. Requests are
+// matched by method, URL, operation and body, falling back to ignoring the body. Exchanges are
+// used in the order they were recorded, and the last one that matched is used again when all
+// have been used, so that polling works.
+func (c *Cassette) play(request *http.Request, body []byte) (*http.Response, error) {
+	redactedBody, redactedJSON, _ := c.redactBody(request.Header, body)
+	key := exchangeKey(request.Method, request.URL.String(), request.Header)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	matches := []func(*Exchange) bool{
+		func(e *Exchange) bool {
+			return exchangeKey(e.Request.Method, e.Request.URL, e.Request.Header) == key &&
+				e.Request.Body == redactedBody && compactJSON(e.Request.JSON) == compactJSON(redactedJSON)
+		},
+		func(e *Exchange) bool {
+			return exchangeKey(e.Request.Method, e.Request.URL, e.Request.Header) == key
+		},
+	}
+	found := -1
+	for _, match := range matches {
+		last := -1
+		for i, exchange := range c.exchanges {
+			if !match(exchange) {
+				continue
+			}
+			if !c.used[i] {
+				found = i
+				break
+			}
+			last = i
+		}
+		if found == -1 {
+			found = last
+		}
+		if found != -1 {
+			break
+		}
+	}
+	if found == -1 {
+		return nil, fmt.Errorf("There is no recorded response for %s '%s' in cassette '%s'",
+			request.Method, request.URL, c.dir)
+	}
+	c.used[found] = true
+	c.logger.Debugf("Replaying %s '%s'", request.Method, request.URL)
+
+	recorded := c.exchanges[found].Response
+	responseBody := []byte(recorded.Body)
+	switch {
+	case recorded.JSON != nil:
+		responseBody = []byte(compactJSON(recorded.JSON))
+	case recorded.Encoding == base64Encoding:
+		decoded, err := base64.StdEncoding.DecodeString(recorded.Body)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to decode recorded response for %s '%s': %v",
+				request.Method, request.URL, err)
+		}
+		responseBody = decoded
+	}
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Del("Content-Length")
+	header.Del("Content-Encoding")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       request,
+	}, nil
+}
+
+// exchangeKey identifies the operation of a request: the method, the URL with the query parameters
+// sorted, and the operation of AWS APIs that use the same URL for all of them.
+func exchangeKey(method string, rawURL string, header http.Header) string {
+	key := method + " " + rawURL
+	parsed, err := url.Parse(rawURL)
+	if err == nil {
+		parsed.RawQuery = parsed.Query().Encode()
+		key = method + " " + parsed.String()
+	}
+	if target := header.Get("X-Amz-Target"); target != "" {
+		key += " " + target
+	}
+	return key
+}
+
+// load reads the exchanges of the cassette files, in the order they were recorded.
+func (c *Cassette) load() error {
+	names, err := c.files()
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("Cassette directory '%s' doesn't contain any recorded request", c.dir)
+	}
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(c.dir, name))
+		if err != nil {
+			return fmt.Errorf("Failed to read cassette file '%s': %v", name, err)
+		}
+		exchange := &Exchange{}
+		err = json.Unmarshal(data, exchange)
+		if err != nil {
+			return fmt.Errorf("Failed to parse cassette file '%s': %v", name, err)
+		}
+		c.exchanges = append(c.exchanges, exchange)
+	}
+	c.used = make([]bool, len(c.exchanges))
+	return nil
+}
+
+// files returns the names of the cassette files of the directory, in the order they were recorded.
+func (c *Cassette) files() ([]string, error) {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read cassette directory '%s': %v", c.dir, err)
+	}
+	names := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() && cassetteFileName.MatchString(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		var a, b int
+		fmt.Sscanf(names[i], "%d-", &a)
+		fmt.Sscanf(names[j], "%d-", &b)
+		return a < b
+	})
+	return names, nil
+}
+
+func (c *Cassette) redactHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	return c.redactor.Headers(header)
+}
+
+// redactBody replaces the sensitive values of a body. JSON documents are returned in the second
+// result, anything else in the first, together with the encoding used for it.
+func (c *Cassette) redactBody(header http.Header, body []byte) (string, json.RawMessage, string) {
+	if len(body) == 0 {
+		return "", nil, ""
+	}
+	if !utf8.Valid(body) {
+		return base64.StdEncoding.EncodeToString(body), nil, base64Encoding
+	}
+	contentType := header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if strings.HasSuffix(mediaType, "json") {
+		if redacted, ok := c.redactor.JSON(body); ok {
+			return "", redacted, ""
+		}
+	}
+	return string(c.redactor.Body(contentType, body)), nil, ""
+}
+
+// compactJSON removes the insignificant white space of a JSON document, so that documents can be
+// compared regardless of how they were indented.
+func compactJSON(data json.RawMessage) string {
+	if len(data) == 0 {
+		return ""
+	}
+	buffer := &bytes.Buffer{}
+	err := json.Compact(buffer, data)
+	if err != nil {
+		return string(data)
+	}
+	return buffer.String()
+}
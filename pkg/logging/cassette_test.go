@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Cassette", func() {
+	var (
+		dir    string
+		server *httptest.Server
+		hits   int
+		logger *logrus.Logger
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		hits = 0
+		logger = logrus.New()
+		logger.SetOutput(io.Discard)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits++
+			body, _ := io.ReadAll(r.Body)
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/token":
+				_, _ = w.Write([]byte(`{"access_token":"secret-access","expires_in":300}`))
+			case "/api/clusters":
+				_, _ = w.Write([]byte(`{"kind":"ClusterList","items":[{"id":"` + r.URL.Query().Get("id") + `"}]}`))
+			default:
+				_, _ = w.Write(body)
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	build := func(replay bool) *Cassette {
+		builder := NewCassette().Logger(logger).Redact("access_token").Redact("password")
+		if replay {
+			builder.Replay(dir)
+		} else {
+			builder.Record(dir)
+		}
+		cassette, err := builder.Build()
+		Expect(err).ToNot(HaveOccurred())
+		return cassette
+	}
+
+	send := func(client *http.Client, method string, path string, body string) string {
+		request, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Authorization", "Bearer secret-bearer")
+		if method == http.MethodPost {
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		response, err := client.Do(request)
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+		data, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return string(data)
+	}
+
+	It("Records exchanges without credentials and replays them", func() {
+		recorder := &http.Client{Transport: build(false).Wrap(http.DefaultTransport)}
+		Expect(send(recorder, http.MethodPost, "/token", "grant_type=password&password=hunter2")).To(
+			ContainSubstring("secret-access"))
+		Expect(send(recorder, http.MethodGet, "/api/clusters?id=123", "")).To(ContainSubstring(`"123"`))
+		Expect(send(recorder, http.MethodGet, "/api/clusters?id=456", "")).To(ContainSubstring(`"456"`))
+		Expect(hits).To(Equal(3))
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(3))
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).ToNot(ContainSubstring("secret-bearer"))
+			Expect(string(data)).ToNot(ContainSubstring("secret-access"))
+			Expect(string(data)).ToNot(ContainSubstring("hunter2"))
+		}
+
+		player := &http.Client{Transport: build(true).Wrap(http.DefaultTransport)}
+		Expect(send(player, http.MethodGet, "/api/clusters?id=456", "")).To(ContainSubstring(`"456"`))
+		Expect(send(player, http.MethodGet, "/api/clusters?id=123", "")).To(ContainSubstring(`"123"`))
+		Expect(send(player, http.MethodPost, "/token", "grant_type=password&password=other")).To(
+			ContainSubstring(`"access_token":"***"`))
+		Expect(hits).To(Equal(3))
+	})
+
+	It("Replays the last matching exchange again when polling", func() {
+		recorder := &http.Client{Transport: build(false).Wrap(http.DefaultTransport)}
+		send(recorder, http.MethodGet, "/api/clusters?id=123", "")
+
+		player := &http.Client{Transport: build(true).Wrap(http.DefaultTransport)}
+		for i := 0; i < 3; i++ {
+			Expect(send(player, http.MethodGet, "/api/clusters?id=123", "")).To(ContainSubstring(`"123"`))
+		}
+		Expect(hits).To(Equal(1))
+	})
+
+	It("Fails requests that weren't recorded", func() {
+		recorder := &http.Client{Transport: build(false).Wrap(http.DefaultTransport)}
+		send(recorder, http.MethodGet, "/api/clusters?id=123", "")
+
+		player := &http.Client{Transport: build(true).Wrap(http.DefaultTransport)}
+		_, err := player.Get(server.URL + "/api/versions")
+		Expect(err).To(MatchError(ContainSubstring("There is no recorded response")))
+	})
+
+	It("Keeps numbering the files of an existing cassette", func() {
+		first := &http.Client{Transport: build(false).Wrap(http.DefaultTransport)}
+		send(first, http.MethodGet, "/api/clusters?id=123", "")
+		second := &http.Client{Transport: build(false).Wrap(http.DefaultTransport)}
+		send(second, http.MethodGet, "/api/clusters?id=456", "")
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(2))
+		Expect(filepath.Base(files[0])).To(HavePrefix("0001-"))
+		Expect(filepath.Base(files[1])).To(HavePrefix("0002-"))
+	})
+})
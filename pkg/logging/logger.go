@@ -22,17 +22,22 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/openshift/rosa/pkg/debug"
+	"github.com/openshift/rosa/pkg/logformat"
 )
 
 // NewLogger creates a new logger with the default config for the project
 func NewLogger() (result *logrus.Logger) {
 	// Create the logger:
 	result = logrus.New()
-	result.SetFormatter(&logrus.TextFormatter{
-		DisableColors: true,
-		DisableQuote:  true,
-		FullTimestamp: true,
-	})
+	if logformat.UseJSON() {
+		result.SetFormatter(&logformat.Formatter{})
+	} else {
+		result.SetFormatter(&logrus.TextFormatter{
+			DisableColors: true,
+			DisableQuote:  true,
+			FullTimestamp: true,
+		})
+	}
 
 	// Enable the debug level if needed:
 	if debug.Enabled() {
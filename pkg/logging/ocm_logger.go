@@ -24,19 +24,23 @@ import (
 
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	"github.com/sirupsen/logrus"
+
+	"github.com/openshift/rosa/pkg/redact"
 )
 
 // OCMLoggerBuilder contains the information and logic needed to create an OCM logger that uses
 // the logging framework of the project. Don't create instances of this type directly; use the
 // NewOCMLogger function instead.
 type OCMLoggerBuilder struct {
-	logger *logrus.Logger
+	logger   *logrus.Logger
+	redactor *redact.Redactor
 }
 
 // OCMLogger is an implementation of the OCM logger interface that uses the logging framework of
 // the project. Don't create instances of this type directly; use the NewOCMLogger function instead.
 type OCMLogger struct {
-	logger *logrus.Logger
+	logger   *logrus.Logger
+	redactor *redact.Redactor
 }
 
 // Make sure that we implement the OCM logger interface.
@@ -55,6 +59,13 @@ func (b *OCMLoggerBuilder) Logger(value *logrus.Logger) *OCMLoggerBuilder {
 	return b
 }
 
+// Redactor sets the redactor applied to the messages, which contain the dumps of the requests and
+// responses in debug mode. The default is the redactor of the process.
+func (b *OCMLoggerBuilder) Redactor(value *redact.Redactor) *OCMLoggerBuilder {
+	b.redactor = value
+	return b
+}
+
 // Build uses the information stored in the builder to create a new OCM logger that uses the logging
 // framework of the project.
 func (b *OCMLoggerBuilder) Build() (result *OCMLogger, err error) {
@@ -64,9 +75,15 @@ func (b *OCMLoggerBuilder) Build() (result *OCMLogger, err error) {
 		return
 	}
 
+	redactor := b.redactor
+	if redactor == nil {
+		redactor = redact.Current()
+	}
+
 	// Create and populate the object:
 	result = &OCMLogger{
-		logger: b.logger,
+		logger:   b.logger,
+		redactor: redactor,
 	}
 
 	return
@@ -89,21 +106,29 @@ func (l *OCMLogger) ErrorEnabled() bool {
 }
 
 func (l *OCMLogger) Debug(ctx context.Context, format string, args ...interface{}) {
-	l.logger.Debugf(format, args...)
+	l.log(logrus.DebugLevel, format, args)
 }
 
 func (l *OCMLogger) Info(ctx context.Context, format string, args ...interface{}) {
-	l.logger.Debugf(format, args...)
+	l.log(logrus.DebugLevel, format, args)
 }
 
 func (l *OCMLogger) Warn(ctx context.Context, format string, args ...interface{}) {
-	l.logger.Debugf(format, args...)
+	l.log(logrus.DebugLevel, format, args)
 }
 
 func (l *OCMLogger) Error(ctx context.Context, format string, args ...interface{}) {
-	l.logger.Errorf(format, args...)
+	l.log(logrus.ErrorLevel, format, args)
 }
 
 func (l *OCMLogger) Fatal(ctx context.Context, format string, args ...interface{}) {
-	l.logger.Errorf(format, args...)
+	l.log(logrus.ErrorLevel, format, args)
+}
+
+// log sends a message to the log, with the sensitive values redacted, if the level is enabled.
+// Messages are only formatted and redacted if they are going to be written.
+func (l *OCMLogger) log(level logrus.Level, format string, args []interface{}) {
+	if l.logger.IsLevelEnabled(level) {
+		l.logger.Log(level, l.redactor.Text(fmt.Sprintf(format, args...)))
+	}
 }
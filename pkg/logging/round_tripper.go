@@ -28,28 +28,28 @@ import (
 	"net/http"
 	"net/url"
 	"sort"
-	"strings"
 
 	"github.com/sirupsen/logrus"
 
-	"gitlab.com/c0b/go-ordered-json"
+	"github.com/openshift/rosa/pkg/redact"
 )
 
 // RoundTripperBuilder contains the information an logic needed to build a new round tripper that
 // sends to the log the details of the requests sent and the responses received. Don't create
 // instances of this type directly; use the NewRoundTripper function instead.
 type RoundTripperBuilder struct {
-	logger *logrus.Logger
-	redact map[string]bool
-	next   http.RoundTripper
+	logger   *logrus.Logger
+	redactor *redact.Redactor
+	redact   []string
+	next     http.RoundTripper
 }
 
 // RoundTripper is a round tripper that dumps the details of the requests and the responses to
 // the log. Don't create instances of this type directly; use the NewRoundTripper function instead.
 type RoundTripper struct {
-	logger *logrus.Logger
-	redact map[string]bool
-	next   http.RoundTripper
+	logger   *logrus.Logger
+	redactor *redact.Redactor
+	next     http.RoundTripper
 }
 
 // Make sure that we implement the http.RoundTripper interface:
@@ -68,12 +68,17 @@ func (b *RoundTripperBuilder) Logger(value *logrus.Logger) *RoundTripperBuilder
 	return b
 }
 
-// Redact specifies a field whose value should be removed from the messages sent to the log.
+// Redactor sets the redactor applied to the requests and responses sent to the log. The default
+// is the redactor of the process.
+func (b *RoundTripperBuilder) Redactor(value *redact.Redactor) *RoundTripperBuilder {
+	b.redactor = value
+	return b
+}
+
+// Redact specifies a field whose value should be removed from the messages sent to the log, in
+// addition to the ones of the redactor.
 func (b *RoundTripperBuilder) Redact(value string) *RoundTripperBuilder {
-	if b.redact == nil {
-		b.redact = make(map[string]bool)
-	}
-	b.redact[value] = true
+	b.redact = append(b.redact, value)
 	return b
 }
 
@@ -97,17 +102,19 @@ func (b *RoundTripperBuilder) Build() (result *RoundTripper, err error) {
 		return
 	}
 
-	// Copy the set of redactedReplacement fields:
-	redact := make(map[string]bool)
-	for key, value := range b.redact {
-		redact[key] = value
+	redactor := b.redactor
+	if redactor == nil {
+		redactor = redact.Current()
+	}
+	if len(b.redact) > 0 {
+		redactor = redactor.With(b.redact...)
 	}
 
 	// Create and populate the object:
 	result = &RoundTripper{
-		logger: b.logger,
-		redact: redact,
-		next:   b.next,
+		logger:   b.logger,
+		redactor: redactor,
+		next:     b.next,
 	}
 
 	return
@@ -163,7 +170,7 @@ func (d *RoundTripper) RoundTrip(request *http.Request) (response *http.Response
 // dumpRequest dumps to the log, in debug level, the details of the given HTTP request.
 func (d *RoundTripper) dumpRequest(request *http.Request, body []byte) {
 	d.logger.Debugf("Request method is %s", request.Method)
-	d.logger.Debugf("Request URL is '%s'", request.URL)
+	d.logger.Debugf("Request URL is '%s'", d.redactor.Text(request.URL.String()))
 	header := request.Header
 	names := make([]string, len(header))
 	i := 0
@@ -175,10 +182,10 @@ func (d *RoundTripper) dumpRequest(request *http.Request, body []byte) {
 	for _, name := range names {
 		values := header[name]
 		for _, value := range values {
-			if strings.ToLower(name) == "authorization" {
+			if d.redactor.Header(name) {
 				d.logger.Debugf("Request header '%s' is omitted", name)
 			} else {
-				d.logger.Debugf("Request header '%s' is '%s'", name, value)
+				d.logger.Debugf("Request header '%s' is '%s'", name, d.redactor.Text(value))
 			}
 		}
 	}
@@ -201,7 +208,11 @@ func (d *RoundTripper) dumpResponse(response *http.Response, body []byte) {
 	for _, name := range names {
 		values := header[name]
 		for _, value := range values {
-			d.logger.Debugf("Response header '%s' is '%s'", name, value)
+			if d.redactor.Header(name) {
+				d.logger.Debugf("Response header '%s' is omitted", name)
+			} else {
+				d.logger.Debugf("Response header '%s' is '%s'", name, d.redactor.Text(value))
+			}
 		}
 	}
 	if body != nil {
@@ -232,7 +243,7 @@ func (d *RoundTripper) dumpBody(what string, header http.Header, body []byte) {
 	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
 		d.dumpJSON(what, body)
 	default:
-		d.dumpBytes(what, body)
+		d.dumpBytes(what, []byte(d.redactor.Text(string(body))))
 	}
 }
 
@@ -247,13 +258,7 @@ func (d *RoundTripper) dumpForm(what string, data []byte) {
 	}
 
 	// Redact values corresponding to security sensitive fields:
-	for name, values := range form {
-		if d.redact[name] {
-			for i := range values {
-				values[i] = redactedReplacement
-			}
-		}
-	}
+	d.redactor.Values(form)
 
 	// Get and sort the names of the fields of the form, so that the generated output will be
 	// predictable:
@@ -270,8 +275,8 @@ func (d *RoundTripper) dumpForm(what string, data []byte) {
 		values := form[name]
 		for _, value := range values {
 			var redacted string
-			if d.redact[name] {
-				redacted = redactedReplacement
+			if d.redactor.Field(name) {
+				redacted = redact.Replacement
 				d.logger.Debugf("%s field '%s' is redacted", what, name)
 			} else {
 				redacted = url.QueryEscape(value)
@@ -291,22 +296,19 @@ func (d *RoundTripper) dumpForm(what string, data []byte) {
 }
 
 // dumpJSON tries to parse the given data as a JSON document. If that works, then it dumps it
-// indented, otherwise dumps it as is.
+// indented and without the sensitive values, otherwise dumps it as text.
 func (d *RoundTripper) dumpJSON(what string, data []byte) {
-	parsed := ordered.NewOrderedMap()
-	err := json.Unmarshal(data, parsed)
+	redacted, ok := d.redactor.JSON(data)
+	if !ok {
+		d.dumpBytes(what, []byte(d.redactor.Text(string(data))))
+		return
+	}
+	indented := &bytes.Buffer{}
+	err := json.Indent(indented, redacted, "", "  ")
 	if err != nil {
-		d.logger.Debugf("%s", data)
+		d.dumpBytes(what, redacted)
 	} else {
-		// remove sensitive information
-		d.redactSensitive(parsed)
-
-		indented, err := json.MarshalIndent(parsed, "", "  ")
-		if err != nil {
-			d.dumpBytes(what, data)
-		} else {
-			d.dumpBytes(what, indented)
-		}
+		d.dumpBytes(what, indented.Bytes())
 	}
 }
 
@@ -322,20 +324,3 @@ func (d *RoundTripper) dumpBytes(what string, data []byte) {
 		}
 	}
 }
-
-// redactSensitive replaces sensitive fields within a response with redactionStr.
-func (d *RoundTripper) redactSensitive(body *ordered.OrderedMap) {
-	iterator := body.EntriesIter()
-	for {
-		pair, ok := iterator()
-		if !ok {
-			break
-		}
-		if d.redact[pair.Key] {
-			body.Set(pair.Key, redactedReplacement)
-		}
-	}
-}
-
-// String that replaces redactedReplacement fields in messages sent to the log:
-const redactedReplacement = "***"
@@ -2,7 +2,6 @@ package machinepool
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
@@ -12,6 +11,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/interactive"
 	interactiveSgs "github.com/openshift/rosa/pkg/interactive/securitygroups"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -40,7 +41,7 @@ func getSubnetFromUser(cmd *cobra.Command, r *rosa.Runtime, isSubnetSet bool,
 		})
 		if err != nil {
 			r.Reporter.Errorf(questionError)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 	} else {
 		subnet = args.Subnet
@@ -50,7 +51,7 @@ func getSubnetFromUser(cmd *cobra.Command, r *rosa.Runtime, isSubnetSet bool,
 		subnetOptions, err := getSubnetOptions(r, cluster)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 
 		subnetOption, err := interactive.GetOption(interactive.Input{
@@ -61,8 +62,7 @@ func getSubnetFromUser(cmd *cobra.Command, r *rosa.Runtime, isSubnetSet bool,
 			Required: true,
 		})
 		if err != nil {
-			r.Reporter.Errorf("Expected a valid AWS subnet: %s", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid AWS subnet")))
 		}
 		subnet = aws.ParseOption(subnetOption)
 	}
@@ -18,6 +18,8 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/helper/features"
 	"github.com/openshift/rosa/pkg/helper/machinepools"
@@ -1001,8 +1003,7 @@ func (m *machinePool) AddNodePool(cmd *cobra.Command, clusterKey string, cluster
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid value for max surge: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value for max surge")))
 			}
 		}
 
@@ -1018,8 +1019,7 @@ func (m *machinePool) AddNodePool(cmd *cobra.Command, clusterKey string, cluster
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid value for max unavailable: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value for max unavailable")))
 			}
 		}
 		if maxSurge != "" || maxUnavailable != "" {
@@ -1771,7 +1771,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 		err = ValidateKubeletConfig(inputKubeletConfig)
 		if err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		npBuilder.KubeletConfigs(inputKubeletConfig...)
 		isKubeletConfigSet = true
@@ -1829,8 +1829,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid value for max surge: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value for max surge")))
 			}
 
 			maxUnavailable, err = interactive.GetString(interactive.Input{
@@ -1843,8 +1842,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 				},
 			})
 			if err != nil {
-				r.Reporter.Errorf("Expected a valid value for max unavailable: %s", err)
-				os.Exit(1)
+				cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Expected a valid value for max unavailable")))
 			}
 		}
 
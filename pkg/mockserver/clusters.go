@@ -0,0 +1,353 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	stateInstalling   = "installing"
+	stateReady        = "ready"
+	stateUninstalling = "uninstalling"
+	stateHibernating  = "hibernating"
+	stateResuming     = "resuming"
+)
+
+var installLogs = []string{
+	"level=info msg=\"Consuming Install Config from target directory\"",
+	"level=info msg=\"Creating infrastructure resources...\"",
+	"level=info msg=\"Waiting up to 20m0s for the Kubernetes API at {api}...\"",
+	"level=info msg=\"API v1.29.7 up\"",
+	"level=info msg=\"Waiting up to 30m0s for bootstrapping to complete...\"",
+	"level=info msg=\"Destroying the bootstrap resources...\"",
+	"level=info msg=\"Waiting up to 40m0s for the cluster at {api} to initialize...\"",
+	"level=info msg=\"Install complete!\"",
+}
+
+var uninstallLogs = []string{
+	"level=info msg=\"Deleted instances\"",
+	"level=info msg=\"Deleted load balancers\"",
+	"level=info msg=\"Deleted network interfaces\"",
+	"level=info msg=\"Deleted security groups\"",
+	"level=info msg=\"Uninstallation complete!\"",
+}
+
+// serveCluster serves the requests that need more than storing and returning resources. It returns
+// false if the request isn't one of them.
+func (s *Server) serveCluster(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	path := strings.Join(segments, "/")
+	switch {
+	case path == "clusters_mgmt/v1/aws_inquiries/regions" && r.Method == http.MethodPost:
+		s.writeList(w, r, segments, s.store.list(regionsPath))
+		return true
+	case strings.HasPrefix(path, "service_logs/v1/clusters/") && len(segments) == 5 &&
+		segments[4] == "cluster_logs" && r.Method == http.MethodGet:
+		logs := []object{}
+		for _, entry := range s.store.list(clusterLogsPath) {
+			if entry["cluster_uuid"] == segments[3] || entry["cluster_id"] == segments[3] {
+				logs = append(logs, entry)
+			}
+		}
+		s.writeList(w, r, segments, logs)
+		return true
+	case !strings.HasPrefix(path, clustersPath+"/") || len(segments) < 5:
+		return false
+	}
+
+	cluster := s.store.get(clustersPath, segments[3])
+	if cluster == nil {
+		return false
+	}
+	state, _ := cluster["state"].(string)
+	switch {
+	case len(segments) == 5 && segments[4] == "status" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, cluster["status"])
+	case len(segments) == 6 && segments[4] == "logs" && r.Method == http.MethodGet:
+		content, ok := s.logs(cluster, segments[5])
+		if !ok {
+			writeError(w, segments, http.StatusNotFound, "Logs '%s' of cluster '%s' not found",
+				segments[5], segments[3])
+			return true
+		}
+		if tail, err := strconv.Atoi(r.URL.Query().Get("tail")); err == nil && tail > 0 && tail < len(content) {
+			content = content[len(content)-tail:]
+		}
+		writeJSON(w, http.StatusOK, object{
+			"kind":    "Log",
+			"id":      segments[5],
+			"href":    "/api/" + path,
+			"content": strings.Join(content, "\n"),
+		})
+	case len(segments) == 5 && segments[4] == "hibernate" && r.Method == http.MethodPost:
+		if state != stateReady {
+			writeError(w, segments, http.StatusBadRequest, "Cluster '%s' is in state '%s' and can't be hibernated",
+				segments[3], state)
+			return true
+		}
+		s.setState(cluster, stateHibernating, "Cluster is hibernating")
+		writeJSON(w, http.StatusOK, object{})
+	case len(segments) == 5 && segments[4] == "resume" && r.Method == http.MethodPost:
+		if state != stateHibernating {
+			writeError(w, segments, http.StatusBadRequest, "Cluster '%s' is in state '%s' and can't be resumed",
+				segments[3], state)
+			return true
+		}
+		s.setState(cluster, stateResuming, "Cluster is resuming from hibernation")
+		writeJSON(w, http.StatusOK, object{})
+	default:
+		return false
+	}
+	return true
+}
+
+// createCluster fills the attributes that the OCM API would generate for a new cluster, and adds
+// the subscription and the default machine or node pool that go with it.
+func (s *Server) createCluster(body object) (object, error) {
+	name, _ := body["name"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("Cluster name is required")
+	}
+	for _, existing := range s.store.list(clustersPath) {
+		if existing["name"] == name {
+			return nil, fmt.Errorf("Cluster '%s' already exists", name)
+		}
+	}
+
+	id := newID()
+	baseDomain := id[:4] + ".p1.openshiftapps.com"
+	hypershift, _ := lookup(body, "hypershift.enabled")
+	version := s.defaultVersion()
+	if requested, ok := lookup(body, "version.id"); ok {
+		version = s.store.get(versionsPath, requested)
+		if version == nil {
+			return nil, fmt.Errorf("Version '%s' not found", requested)
+		}
+	}
+	now := s.now().UTC().Format(timestampLayout)
+
+	defaults := object{
+		"product": object{"kind": "ProductLink", "id": "rosa", "href": "/api/clusters_mgmt/v1/products/rosa"},
+		"cloud_provider": object{
+			"kind": "CloudProviderLink",
+			"id":   "aws",
+			"href": "/api/clusters_mgmt/v1/cloud_providers/aws",
+		},
+		"region":        object{"kind": "CloudRegionLink", "id": "us-east-1"},
+		"managed":       true,
+		"multi_az":      false,
+		"ccs":           object{"enabled": true},
+		"billing_model": "standard",
+	}
+	merge(defaults, body)
+	cluster := defaults
+	merge(cluster, object{
+		"kind":               "Cluster",
+		"id":                 id,
+		"href":               "/api/" + clustersPath + "/" + id,
+		"external_id":        uuid.NewString(),
+		"domain_prefix":      name,
+		"creation_timestamp": now,
+		"version": object{
+			"kind":          "Version",
+			"id":            version["id"],
+			"href":          version["href"],
+			"raw_id":        version["raw_id"],
+			"channel_group": version["channel_group"],
+		},
+		"dns":     object{"base_domain": baseDomain},
+		"api":     object{"url": fmt.Sprintf("https://api.%s.%s:6443", name, baseDomain), "listening": "external"},
+		"console": object{"url": fmt.Sprintf("https://console-openshift-console.apps.%s.%s", name, baseDomain)},
+	})
+
+	subscriptionID := newID()
+	plan := "MOA"
+	if hypershift == "true" {
+		plan = "MOA-HostedControlPlane"
+	}
+	s.store.add(subscriptionsPath, object{
+		"kind":                "Subscription",
+		"id":                  subscriptionID,
+		"href":                "/api/" + subscriptionsPath + "/" + subscriptionID,
+		"cluster_id":          id,
+		"external_cluster_id": cluster["external_id"],
+		"display_name":        name,
+		"managed":             true,
+		"status":              "Reserved",
+		"plan":                object{"kind": "Plan", "id": plan},
+		"organization_id":     OrganizationID,
+		"creator":             object{"kind": "AccountLink", "id": AccountID},
+		"created_at":          now,
+	})
+	cluster["subscription"] = object{
+		"kind": "SubscriptionLink",
+		"id":   subscriptionID,
+		"href": "/api/" + subscriptionsPath + "/" + subscriptionID,
+	}
+
+	replicas := 2
+	if value, ok := lookup(cluster, "nodes.compute"); ok {
+		replicas, _ = strconv.Atoi(value)
+	}
+	instanceType, ok := lookup(cluster, "nodes.compute_machine_type.id")
+	if !ok {
+		instanceType = "m5.xlarge"
+	}
+	if hypershift == "true" {
+		s.store.add(clustersPath+"/"+id+"/node_pools", object{
+			"kind":               "NodePool",
+			"id":                 "workers",
+			"href":               "/api/" + clustersPath + "/" + id + "/node_pools/workers",
+			"replicas":           replicas,
+			"auto_repair":        true,
+			"aws_node_pool":      object{"instance_type": instanceType},
+			"version":            object{"kind": "VersionLink", "id": version["id"], "raw_id": version["raw_id"]},
+			"status":             object{"current_replicas": 0},
+			"management_upgrade": object{"type": "Replace", "max_surge": "1", "max_unavailable": "0"},
+		})
+	} else {
+		s.store.add(clustersPath+"/"+id+"/machine_pools", object{
+			"kind":          "MachinePool",
+			"id":            "worker",
+			"href":          "/api/" + clustersPath + "/" + id + "/machine_pools/worker",
+			"replicas":      replicas,
+			"instance_type": instanceType,
+		})
+	}
+
+	s.store.add(clustersPath, cluster)
+	s.setState(cluster, stateInstalling, "Cluster installation started")
+	return cluster, nil
+}
+
+// deleteCluster starts the uninstallation of a cluster, it is removed once the uninstall duration
+// has passed.
+func (s *Server) deleteCluster(cluster object) {
+	if cluster["state"] == stateUninstalling {
+		return
+	}
+	s.setState(cluster, stateUninstalling, "Cluster uninstallation started")
+}
+
+// advance moves the clusters to their next state when the time they spend in the current one has
+// passed.
+func (s *Server) advance() {
+	now := s.now()
+	for _, listed := range s.store.list(clustersPath) {
+		id := listed["id"].(string)
+		cluster := s.store.get(clustersPath, id)
+		elapsed := now.Sub(s.changed[id])
+		switch cluster["state"] {
+		case stateInstalling, stateResuming:
+			if elapsed < s.options.InstallDuration {
+				continue
+			}
+			s.setState(cluster, stateReady, "Cluster is ready")
+			for _, pool := range s.store.list(clustersPath + "/" + id + "/node_pools") {
+				poolItem := s.store.get(clustersPath+"/"+id+"/node_pools", pool["id"].(string))
+				poolItem["status"] = object{"current_replicas": pool["replicas"]}
+			}
+		case stateUninstalling:
+			if elapsed < s.options.UninstallDuration {
+				continue
+			}
+			s.addServiceLog(cluster, "Cluster uninstallation completed")
+			if subscription := s.subscription(cluster); subscription != nil {
+				subscription["status"] = "Deprovisioned"
+			}
+			s.store.remove(clustersPath, id)
+			delete(s.changed, id)
+		}
+	}
+}
+
+func (s *Server) setState(cluster object, state string, summary string) {
+	id := cluster["id"].(string)
+	cluster["state"] = state
+	cluster["status"] = object{
+		"kind":        "ClusterStatus",
+		"id":          id,
+		"href":        "/api/" + clustersPath + "/" + id + "/status",
+		"state":       state,
+		"dns_ready":   true,
+		"oidc_ready":  true,
+		"description": summary,
+	}
+	s.changed[id] = s.now()
+	if state == stateReady {
+		if subscription := s.subscription(cluster); subscription != nil {
+			subscription["status"] = "Active"
+		}
+	}
+	s.addServiceLog(cluster, summary)
+}
+
+func (s *Server) subscription(cluster object) object {
+	id, ok := lookup(cluster, "subscription.id")
+	if !ok {
+		return nil
+	}
+	return s.store.get(subscriptionsPath, id)
+}
+
+func (s *Server) addServiceLog(cluster object, summary string) {
+	_, _ = s.create(clusterLogsPath, object{
+		"cluster_id":    cluster["id"],
+		"cluster_uuid":  cluster["external_id"],
+		"service_name":  "Mock server",
+		"summary":       summary,
+		"description":   fmt.Sprintf("%s: cluster '%s'", summary, cluster["name"]),
+		"severity":      "Info",
+		"log_type":      "cluster-lifecycle",
+		"internal_only": false,
+	})
+}
+
+// logs returns the lines of the install or uninstall logs of a cluster that are available at this
+// point of its lifecycle.
+func (s *Server) logs(cluster object, kind string) ([]string, bool) {
+	var lines []string
+	var duration time.Duration
+	switch {
+	case kind == "install":
+		lines, duration = installLogs, s.options.InstallDuration
+	case kind == "uninstall" && cluster["state"] == stateUninstalling:
+		lines, duration = uninstallLogs, s.options.UninstallDuration
+	default:
+		return nil, false
+	}
+	count := len(lines)
+	if (kind == "install" && cluster["state"] == stateInstalling) || kind == "uninstall" {
+		elapsed := s.now().Sub(s.changed[cluster["id"].(string)])
+		count = 1 + int(float64(len(lines)-1)*float64(elapsed)/float64(duration))
+		if count > len(lines) {
+			count = len(lines)
+		}
+	}
+	api, _ := lookup(cluster, "api.url")
+	content := make([]string, count)
+	for i := range content {
+		content[i] = strings.ReplaceAll(lines[i], "{api}", api)
+	}
+	return content, true
+}
@@ -0,0 +1,371 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockserver
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// predicate decides if an object matches a search expression.
+type predicate func(object) bool
+
+// parseSearch parses the subset of the SQL like search language of the OCM API that the CLI uses:
+// comparisons with '=', '!=', '<>', 'LIKE', 'ILIKE', 'IN' and 'IS [NOT] NULL', combined with 'AND', 'OR',
+// 'NOT' and parentheses. Field names can contain dots to reach nested attributes.
+func parseSearch(search string) (predicate, error) {
+	if strings.TrimSpace(search) == "" {
+		return func(object) bool { return true }, nil
+	}
+	tokens, err := tokenize(search)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	result, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.position < len(p.tokens) {
+		return nil, fmt.Errorf("Unexpected '%s' in search '%s'", p.tokens[p.position].text, search)
+	}
+	return result, nil
+}
+
+type tokenKind int
+
+const (
+	identifierToken tokenKind = iota
+	stringToken
+	symbolToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func tokenize(search string) ([]token, error) {
+	var tokens []token
+	runes := []rune(search)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'':
+			var value strings.Builder
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("Unterminated string in search '%s'", search)
+				}
+				if runes[i] == '\'' {
+					// Two quotes in a row are an escaped quote:
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						value.WriteRune('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, token{kind: stringToken, text: value.String()})
+		case r == '(' || r == ')' || r == ',' || r == '=':
+			tokens = append(tokens, token{kind: symbolToken, text: string(r)})
+			i++
+		case r == '!' || r == '<':
+			if i+1 < len(runes) && (runes[i+1] == '=' || runes[i+1] == '>') {
+				tokens = append(tokens, token{kind: symbolToken, text: "!="})
+				i += 2
+				continue
+			}
+			return nil, fmt.Errorf("Unexpected '%c' in search '%s'", r, search)
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) ||
+				runes[i] == '_' || runes[i] == '.' || runes[i] == '-') {
+				i++
+			}
+			tokens = append(tokens, token{kind: identifierToken, text: string(runes[start:i])})
+		default:
+			return nil, fmt.Errorf("Unexpected '%c' in search '%s'", r, search)
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens   []token
+	position int
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.position >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.position], true
+}
+
+// keyword consumes the next token if it is the given keyword or symbol.
+func (p *parser) keyword(text string) bool {
+	next, ok := p.peek()
+	if !ok || next.kind == stringToken || !strings.EqualFold(next.text, text) {
+		return false
+	}
+	p.position++
+	return true
+}
+
+func (p *parser) next() (token, error) {
+	next, ok := p.peek()
+	if !ok {
+		return token{}, fmt.Errorf("Unexpected end of search")
+	}
+	p.position++
+	return next, nil
+}
+
+func (p *parser) or() (predicate, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("OR") {
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		first := left
+		left = func(o object) bool { return first(o) || right(o) }
+	}
+	return left, nil
+}
+
+func (p *parser) and() (predicate, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("AND") {
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		first := left
+		left = func(o object) bool { return first(o) && right(o) }
+	}
+	return left, nil
+}
+
+func (p *parser) unary() (predicate, error) {
+	if p.keyword("NOT") {
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(o object) bool { return !operand(o) }, nil
+	}
+	if p.keyword("(") {
+		result, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if !p.keyword(")") {
+			return nil, fmt.Errorf("Expected ')' in search")
+		}
+		return result, nil
+	}
+	return p.comparison()
+}
+
+func (p *parser) comparison() (predicate, error) {
+	field, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if field.kind != identifierToken {
+		return nil, fmt.Errorf("Expected a field name but found '%s'", field.text)
+	}
+	name := field.text
+
+	switch {
+	case p.keyword("="):
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		return func(o object) bool {
+			actual, ok := lookup(o, name)
+			return ok && actual == value
+		}, nil
+	case p.keyword("!="):
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		return func(o object) bool {
+			actual, _ := lookup(o, name)
+			return actual != value
+		}, nil
+	case p.keyword("LIKE"), p.keyword("ILIKE"):
+		insensitive := strings.EqualFold(p.tokens[p.position-1].text, "ILIKE")
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		pattern := likePattern(value, insensitive)
+		return func(o object) bool {
+			actual, ok := lookup(o, name)
+			return ok && pattern.MatchString(actual)
+		}, nil
+	case p.keyword("IN"):
+		if !p.keyword("(") {
+			return nil, fmt.Errorf("Expected '(' after 'IN' in search")
+		}
+		values := map[string]bool{}
+		for {
+			value, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			values[value] = true
+			if p.keyword(")") {
+				break
+			}
+			if !p.keyword(",") {
+				return nil, fmt.Errorf("Expected ',' or ')' in the values of 'IN' in search")
+			}
+		}
+		return func(o object) bool {
+			actual, ok := lookup(o, name)
+			return ok && values[actual]
+		}, nil
+	case p.keyword("IS"):
+		negated := p.keyword("NOT")
+		if !p.keyword("NULL") {
+			return nil, fmt.Errorf("Expected 'NULL' after 'IS' in search")
+		}
+		return func(o object) bool {
+			_, ok := lookup(o, name)
+			return ok == negated
+		}, nil
+	}
+	return nil, fmt.Errorf("Expected an operator after '%s' in search", name)
+}
+
+func (p *parser) value() (string, error) {
+	value, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if value.kind == symbolToken {
+		return "", fmt.Errorf("Expected a value but found '%s'", value.text)
+	}
+	return value.text, nil
+}
+
+func likePattern(value string, insensitive bool) *regexp.Regexp {
+	var pattern strings.Builder
+	if insensitive {
+		pattern.WriteString("(?i)")
+	}
+	pattern.WriteString("^")
+	for _, r := range value {
+		switch r {
+		case '%':
+			pattern.WriteString(".*")
+		case '_':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	pattern.WriteString("$")
+	return regexp.MustCompile(pattern.String())
+}
+
+// lookup returns the value of a possibly nested attribute as a string, and false if the object doesn't
+// have it.
+func lookup(o object, name string) (string, bool) {
+	var current interface{} = o
+	for _, part := range strings.Split(name, ".") {
+		attributes, ok := current.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		current, ok = attributes[part]
+		if !ok || current == nil {
+			return "", false
+		}
+	}
+	switch value := current.(type) {
+	case string:
+		return value, true
+	case bool:
+		return strconv.FormatBool(value), true
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(value), true
+	default:
+		return fmt.Sprintf("%v", value), true
+	}
+}
+
+// sortObjects sorts the objects using an order like 'default desc, id desc'.
+func sortObjects(objects []object, order string) {
+	if strings.TrimSpace(order) == "" {
+		return
+	}
+	type criterion struct {
+		name       string
+		descending bool
+	}
+	var criteria []criterion
+	for _, part := range strings.Split(order, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+		criteria = append(criteria, criterion{
+			name:       fields[0],
+			descending: len(fields) > 1 && strings.EqualFold(fields[1], "desc"),
+		})
+	}
+	sort.SliceStable(objects, func(i, j int) bool {
+		for _, c := range criteria {
+			a, _ := lookup(objects[i], c.name)
+			b, _ := lookup(objects[j], c.name)
+			if a == b {
+				continue
+			}
+			if c.descending {
+				return a > b
+			}
+			return a < b
+		}
+		return false
+	})
+}
@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockserver
+
+import (
+	"fmt"
+)
+
+const (
+	// Username is the user that the tokens of the server are issued to
+	Username = "mock-user"
+	// AccountID is the OCM account of Username
+	AccountID = "mockaccount000000000000000000000"
+	// OrganizationID is the OCM organization of Username
+	OrganizationID = "mockorganization0000000000000000"
+)
+
+const (
+	clustersPath      = "clusters_mgmt/v1/clusters"
+	versionsPath      = "clusters_mgmt/v1/versions"
+	regionsPath       = "clusters_mgmt/v1/cloud_providers/aws/regions"
+	subscriptionsPath = "accounts_mgmt/v1/subscriptions"
+	clusterLogsPath   = "service_logs/v1/cluster_logs"
+)
+
+// seedVersions are the OpenShift versions known by the server, from oldest to newest. The newest
+// is the default one.
+var seedVersions = []string{"4.14.35", "4.15.28", "4.16.8"}
+
+var seedRegions = []struct {
+	id          string
+	displayName string
+}{
+	{"us-east-1", "US East, N. Virginia"},
+	{"us-east-2", "US East, Ohio"},
+	{"us-west-2", "US West, Oregon"},
+	{"eu-west-1", "EU, Ireland"},
+	{"eu-central-1", "EU, Frankfurt"},
+	{"ap-southeast-1", "Asia Pacific, Singapore"},
+}
+
+// seed fills the store with the resources that exist before any request is made: the versions,
+// the AWS regions and the account and organization of the user.
+func (s *Server) seed() {
+	for i, raw := range seedVersions {
+		id := versionID(raw)
+		s.store.add(versionsPath, object{
+			"kind":                         "Version",
+			"id":                           id,
+			"href":                         "/api/" + versionsPath + "/" + id,
+			"raw_id":                       raw,
+			"channel_group":                "stable",
+			"enabled":                      true,
+			"rosa_enabled":                 true,
+			"hosted_control_plane_enabled": true,
+			"default":                      i == len(seedVersions)-1,
+			"hosted_control_plane_default": i == len(seedVersions)-1,
+			"available_upgrades":           seedVersions[i+1:],
+			"release_image":                fmt.Sprintf("quay.io/openshift-release-dev/ocp-release:%s-x86_64", raw),
+			"end_of_life_timestamp":        s.now().AddDate(1, 0, 0).UTC().Format(timestampLayout),
+		})
+	}
+
+	s.store.add("clusters_mgmt/v1/cloud_providers", object{
+		"kind":         "CloudProvider",
+		"id":           "aws",
+		"href":         "/api/clusters_mgmt/v1/cloud_providers/aws",
+		"name":         "aws",
+		"display_name": "AWS",
+	})
+	for _, region := range seedRegions {
+		s.store.add(regionsPath, object{
+			"kind":                "CloudRegion",
+			"id":                  region.id,
+			"href":                "/api/" + regionsPath + "/" + region.id,
+			"display_name":        region.displayName,
+			"name":                region.id,
+			"enabled":             true,
+			"ccs_only":            true,
+			"supports_multi_az":   true,
+			"supports_hypershift": true,
+			"cloud_provider": object{
+				"kind": "CloudProviderLink",
+				"id":   "aws",
+				"href": "/api/clusters_mgmt/v1/cloud_providers/aws",
+			},
+		})
+	}
+
+	organization := object{
+		"kind":        "Organization",
+		"id":          OrganizationID,
+		"href":        "/api/accounts_mgmt/v1/organizations/" + OrganizationID,
+		"name":        "Mock organization",
+		"external_id": "12345678",
+		"capabilities": []object{
+			{"kind": "Capability", "name": "capability.organization.hibernate_cluster", "value": "true"},
+		},
+	}
+	account := object{
+		"kind":         "Account",
+		"id":           AccountID,
+		"href":         "/api/accounts_mgmt/v1/accounts/" + AccountID,
+		"username":     Username,
+		"email":        Username + "@example.com",
+		"first_name":   "Mock",
+		"last_name":    "User",
+		"organization": organization,
+	}
+	s.store.add("accounts_mgmt/v1/organizations", organization)
+	s.store.add("accounts_mgmt/v1/accounts", account)
+	s.store.singletons["accounts_mgmt/v1/current_account"] = account
+}
+
+func versionID(raw string) string {
+	return "openshift-v" + raw
+}
+
+// defaultVersion returns the version used by clusters created without one.
+func (s *Server) defaultVersion() object {
+	for _, version := range s.store.list(versionsPath) {
+		if version["default"] == true {
+			return version
+		}
+	}
+	return nil
+}
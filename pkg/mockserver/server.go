@@ -0,0 +1,412 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mockserver contains an in-memory stand-in for the clusters_mgmt, accounts_mgmt and
+// service_logs APIs of OCM, so that the CLI can be used without a real OCM environment. Resources
+// are kept in memory and lost when the server stops. Clusters go from 'installing' to 'ready', and
+// from 'uninstalling' to removed, after the durations given in the options.
+package mockserver
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultInstallDuration is the time it takes to install a cluster when no duration is given
+	DefaultInstallDuration = 30 * time.Second
+	// DefaultUninstallDuration is the time it takes to uninstall a cluster when no duration is given
+	DefaultUninstallDuration = 15 * time.Second
+
+	tokenLifetime   = 30 * 24 * time.Hour
+	defaultPageSize = 100
+	timestampLayout = time.RFC3339
+)
+
+// singletons are the names of the resources that aren't items of collections.
+var singletons = map[string]bool{
+	"autoscaler":           true,
+	"credentials":          true,
+	"current_account":      true,
+	"external_auth_config": true,
+	"kubelet_config":       true,
+	"status":               true,
+}
+
+// kinds are the kinds of the items of the collections whose name doesn't follow the usual rules.
+var kinds = map[string]string{
+	"addons":           "AddOnInstallation",
+	"cluster_logs":     "ClusterLog",
+	"htpasswd_users":   "HTPasswdUser",
+	"ingresses":        "Ingress",
+	"oidc_configs":     "OidcConfig",
+	"quota_cost":       "QuotaCost",
+	"regions":          "CloudRegion",
+	"upgrade_policies": "UpgradePolicy",
+}
+
+// Options are the settings of a server.
+type Options struct {
+	// InstallDuration is the time that clusters stay in the 'installing' state
+	InstallDuration time.Duration
+	// UninstallDuration is the time that clusters stay in the 'uninstalling' state
+	UninstallDuration time.Duration
+	// Logger receives a debug message for each request, it is optional
+	Logger *logrus.Logger
+}
+
+// Server is an http.Handler that serves the mock APIs. Don't create instances of this type
+// directly; use the New function instead.
+type Server struct {
+	lock    sync.Mutex
+	options Options
+	store   *store
+	key     []byte
+	now     func() time.Time
+
+	// changed is the time when the state of each cluster changed for the last time
+	changed map[string]time.Time
+}
+
+// New creates a server with the seeded versions, regions and account.
+func New(options Options) *Server {
+	if options.InstallDuration == 0 {
+		options.InstallDuration = DefaultInstallDuration
+	}
+	if options.UninstallDuration == 0 {
+		options.UninstallDuration = DefaultUninstallDuration
+	}
+	s := &Server{
+		options: options,
+		store:   newStore(),
+		key:     make([]byte, 32),
+		now:     time.Now,
+		changed: map[string]time.Time{},
+	}
+	_, _ = rand.Read(s.key)
+	s.seed()
+	return s
+}
+
+// Token returns an access token for Username that can be used to log in to the server with
+// 'rosa login --url ... --token ...'. The server doesn't check the tokens it receives, any token
+// works, but the CLI needs one that it can parse.
+func (s *Server) Token() (string, error) {
+	now := s.now()
+	claims := jwt.MapClaims{
+		"typ":                "Bearer",
+		"iat":                now.Unix(),
+		"exp":                now.Add(tokenLifetime).Unix(),
+		"sub":                AccountID,
+		"username":           Username,
+		"preferred_username": Username,
+		"email":              Username + "@example.com",
+		"first_name":         "Mock",
+		"last_name":          "User",
+		"org_id":             OrganizationID,
+		"account_id":         AccountID,
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.key)
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	s.serve(recorder, r)
+	if s.options.Logger != nil {
+		s.options.Logger.Debugf("%s %s %d", r.Method, r.URL.RequestURI(), recorder.status)
+	}
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/"), "/")
+	segments := strings.Split(path, "/")
+	if !strings.HasPrefix(r.URL.Path, "/api/") || len(segments) < 3 {
+		writeError(w, segments, http.StatusNotFound, "Path '%s' doesn't exist", r.URL.Path)
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, segments, http.StatusUnauthorized, "Request doesn't contain an access token")
+		return
+	}
+
+	s.advance()
+
+	if s.serveCluster(w, r, segments) {
+		return
+	}
+
+	// Nested resources can only be used when the resource that contains them exists:
+	last := segments[len(segments)-1]
+	parent := segments[:len(segments)-1]
+	if len(segments)%2 == 0 {
+		parent = segments[:len(segments)-2]
+	}
+	if len(parent) > 2 && !s.store.exists(parent) {
+		writeError(w, segments, http.StatusNotFound, "Resource '%s' doesn't exist", strings.Join(parent[2:], "/"))
+		return
+	}
+
+	switch {
+	case len(segments)%2 == 1 && singletons[last]:
+		s.serveSingleton(w, r, segments)
+	case len(segments)%2 == 1:
+		s.serveCollection(w, r, segments)
+	default:
+		s.serveItem(w, r, segments)
+	}
+}
+
+func (s *Server) serveSingleton(w http.ResponseWriter, r *http.Request, segments []string) {
+	path := strings.Join(segments, "/")
+	existing, ok := s.store.singletons[path]
+	switch r.Method {
+	case http.MethodGet:
+		if !ok {
+			writeError(w, segments, http.StatusNotFound, "Resource '%s' doesn't exist", path)
+			return
+		}
+		writeJSON(w, http.StatusOK, existing)
+	case http.MethodPost, http.MethodPatch:
+		body, err := readBody(r)
+		if err != nil {
+			writeError(w, segments, http.StatusBadRequest, "%v", err)
+			return
+		}
+		if !ok || r.Method == http.MethodPost {
+			existing = object{"kind": kindOf(segments[len(segments)-1]), "href": "/api/" + path}
+		}
+		merge(existing, body)
+		s.store.singletons[path] = existing
+		status := http.StatusOK
+		if !ok {
+			status = http.StatusCreated
+		}
+		writeJSON(w, status, existing)
+	case http.MethodDelete:
+		delete(s.store.singletons, path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, segments, http.StatusMethodNotAllowed, "Method '%s' isn't supported", r.Method)
+	}
+}
+
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, segments []string) {
+	path := strings.Join(segments, "/")
+	switch r.Method {
+	case http.MethodGet:
+		s.writeList(w, r, segments, s.store.list(path))
+	case http.MethodPost:
+		body, err := readBody(r)
+		if err != nil {
+			writeError(w, segments, http.StatusBadRequest, "%v", err)
+			return
+		}
+		var item object
+		switch path {
+		case clustersPath:
+			item, err = s.createCluster(body)
+		default:
+			item, err = s.create(path, body)
+		}
+		if err != nil {
+			writeError(w, segments, http.StatusBadRequest, "%v", err)
+			return
+		}
+		writeJSON(w, http.StatusCreated, item)
+	default:
+		writeError(w, segments, http.StatusMethodNotAllowed, "Method '%s' isn't supported", r.Method)
+	}
+}
+
+func (s *Server) serveItem(w http.ResponseWriter, r *http.Request, segments []string) {
+	path := strings.Join(segments[:len(segments)-1], "/")
+	id := segments[len(segments)-1]
+	item := s.store.get(path, id)
+	if item == nil {
+		writeError(w, segments, http.StatusNotFound, "%s '%s' not found",
+			kindOf(segments[len(segments)-2]), id)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, item)
+	case http.MethodPatch:
+		body, err := readBody(r)
+		if err != nil {
+			writeError(w, segments, http.StatusBadRequest, "%v", err)
+			return
+		}
+		delete(body, "id")
+		merge(item, body)
+		writeJSON(w, http.StatusOK, item)
+	case http.MethodDelete:
+		if path == clustersPath {
+			s.deleteCluster(item)
+		} else {
+			s.store.remove(path, id)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, segments, http.StatusMethodNotAllowed, "Method '%s' isn't supported", r.Method)
+	}
+}
+
+// create adds an item to a collection, generating its identifier if the body doesn't have one.
+func (s *Server) create(path string, body object) (object, error) {
+	id, _ := body["id"].(string)
+	if id == "" {
+		id = newID()
+	}
+	if s.store.get(path, id) != nil {
+		return nil, fmt.Errorf("%s '%s' already exists", kindOf(path[strings.LastIndex(path, "/")+1:]), id)
+	}
+	body["id"] = id
+	body["kind"] = kindOf(path[strings.LastIndex(path, "/")+1:])
+	body["href"] = "/api/" + path + "/" + id
+	if path == clusterLogsPath {
+		if _, ok := body["timestamp"]; !ok {
+			body["timestamp"] = s.now().UTC().Format(timestampLayout)
+		}
+		body["created_at"] = s.now().UTC().Format(timestampLayout)
+	}
+	s.store.add(path, body)
+	return body, nil
+}
+
+// writeList writes the page of the items selected by the 'search', 'order', 'page' and 'size'
+// parameters of the request.
+func (s *Server) writeList(w http.ResponseWriter, r *http.Request, segments []string, items []object) {
+	query := r.URL.Query()
+	if r.Method == http.MethodPost && r.ContentLength > 0 {
+		// Some collections, like the regions of the AWS inquiries, are searched with a POST. The body
+		// is ignored.
+		_, _ = readBody(r)
+	}
+	match, err := parseSearch(query.Get("search"))
+	if err != nil {
+		writeError(w, segments, http.StatusBadRequest, "%v", err)
+		return
+	}
+	selected := []object{}
+	for _, item := range items {
+		if match(item) {
+			selected = append(selected, item)
+		}
+	}
+	sortObjects(selected, query.Get("order"))
+
+	page, size := 1, defaultPageSize
+	if value, err := strconv.Atoi(query.Get("page")); err == nil && value > 0 {
+		page = value
+	}
+	if value, err := strconv.Atoi(query.Get("size")); err == nil && value >= 0 {
+		size = value
+	}
+	start := (page - 1) * size
+	if start > len(selected) {
+		start = len(selected)
+	}
+	end := start + size
+	if end > len(selected) {
+		end = len(selected)
+	}
+	writeJSON(w, http.StatusOK, object{
+		"kind":  kindOf(segments[len(segments)-1]) + "List",
+		"page":  page,
+		"size":  end - start,
+		"total": len(selected),
+		"items": selected[start:end],
+	})
+}
+
+// kindOf converts the name of a collection, like 'node_pools', into the kind of its items, like
+// 'NodePool'.
+func kindOf(name string) string {
+	if kind, ok := kinds[name]; ok {
+		return kind
+	}
+	name = strings.TrimSuffix(name, "s")
+	var kind strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		kind.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return kind.String()
+}
+
+func readBody(r *http.Request) (object, error) {
+	body := object{}
+	if r.Body == nil {
+		return body, nil
+	}
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse request body: %v", err)
+	}
+	return body, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// writeError writes an error in the format of the OCM API, with the code prefix of the service
+// the request was sent to.
+func writeError(w http.ResponseWriter, segments []string, status int, format string, args ...interface{}) {
+	prefix := "OCM"
+	switch segments[0] {
+	case "clusters_mgmt":
+		prefix = "CLUSTERS-MGMT"
+	case "accounts_mgmt":
+		prefix = "ACCOUNT-MGMT"
+	case "service_logs":
+		prefix = "OCM-SERVICE-LOGS"
+	}
+	writeJSON(w, status, object{
+		"kind":   "Error",
+		"id":     strconv.Itoa(status),
+		"href":   fmt.Sprintf("/api/%s/v1/errors/%d", segments[0], status),
+		"code":   fmt.Sprintf("%s-%d", prefix, status),
+		"reason": fmt.Sprintf(format, args...),
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
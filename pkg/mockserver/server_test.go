@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+var _ = Describe("Mock server", func() {
+	var (
+		server     *Server
+		httpServer *httptest.Server
+		connection *sdk.Connection
+		client     *ocm.Client
+		now        time.Time
+		creator    = &aws.Creator{AccountID: "123456789012"}
+	)
+
+	BeforeEach(func() {
+		server = New(Options{InstallDuration: time.Minute, UninstallDuration: 30 * time.Second})
+		httpServer = httptest.NewServer(server)
+		DeferCleanup(httpServer.Close)
+
+		// The token is checked by the SDK against the real clock, the rest uses the fake one:
+		token, err := server.Token()
+		Expect(err).NotTo(HaveOccurred())
+		now = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		server.now = func() time.Time { return now }
+		logger, err := logging.NewGoLoggerBuilder().Build()
+		Expect(err).NotTo(HaveOccurred())
+		connection, err = sdk.NewConnectionBuilder().
+			Logger(logger).
+			URL(httpServer.URL).
+			Tokens(token).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		client = ocm.NewClientWithConnection(connection)
+		DeferCleanup(client.Close)
+	})
+
+	createCluster := func(name string) *cmv1.Cluster {
+		spec, err := cmv1.NewCluster().
+			Name(name).
+			Product(cmv1.NewProduct().ID("rosa")).
+			AWS(cmv1.NewAWS().STS(cmv1.NewSTS().RoleARN("arn:aws:iam::123456789012:role/Installer-Role"))).
+			Nodes(cmv1.NewClusterNodes().Compute(3)).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		response, err := connection.ClustersMgmt().V1().Clusters().Add().Body(spec).Send()
+		Expect(err).NotTo(HaveOccurred())
+		return response.Body()
+	}
+
+	It("Serves the seeded versions and account", func() {
+		versions, err := client.GetVersionsWithProduct("", "", true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(versions).To(HaveLen(len(seedVersions)))
+		Expect(versions[0].RawID()).To(Equal("4.16.8"))
+		Expect(versions[0].Default()).To(BeTrue())
+
+		account, err := client.GetCurrentAccount()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(account.Username()).To(Equal(Username))
+		Expect(account.Organization().ID()).To(Equal(OrganizationID))
+	})
+
+	It("Takes clusters from installing to ready", func() {
+		created := createCluster("demo")
+		Expect(created.State()).To(Equal(cmv1.ClusterStateInstalling))
+		Expect(created.Version().RawID()).To(Equal("4.16.8"))
+
+		cluster, err := client.GetCluster("demo", creator)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.ID()).To(Equal(created.ID()))
+		_, err = client.GetCluster("demo", &aws.Creator{AccountID: "000000000000"})
+		Expect(err).To(MatchError(ContainSubstring("There is no cluster")))
+
+		machinePools, err := client.GetMachinePools(cluster.ID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(machinePools).To(HaveLen(1))
+		Expect(machinePools[0].Replicas()).To(Equal(3))
+
+		logs, err := client.GetInstallLogs(cluster.ID(), 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(logs.Content()).NotTo(ContainSubstring("Install complete!"))
+
+		now = now.Add(time.Minute)
+		cluster, err = client.GetCluster(cluster.ID(), creator)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.State()).To(Equal(cmv1.ClusterStateReady))
+		Expect(cluster.Status().State()).To(Equal(cmv1.ClusterStateReady))
+		logs, err = client.GetInstallLogs(cluster.ID(), 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(logs.Content()).To(ContainSubstring("Install complete!"))
+
+		subscription, found, err := client.GetSubscriptionBySubscriptionID(cluster.Subscription().ID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(subscription.Status()).To(Equal("Active"))
+	})
+
+	It("Removes clusters once they are uninstalled", func() {
+		created := createCluster("demo")
+		now = now.Add(time.Minute)
+
+		_, err := client.DeleteCluster("demo", false, creator)
+		Expect(err).NotTo(HaveOccurred())
+		cluster, err := client.GetCluster("demo", creator)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.State()).To(Equal(cmv1.ClusterStateUninstalling))
+
+		now = now.Add(30 * time.Second)
+		_, err = client.GetCluster("demo", creator)
+		Expect(err).To(MatchError(ContainSubstring("There is no cluster")))
+		response, err := connection.Get().
+			Path("/api/service_logs/v1/clusters/" + created.ExternalID() + "/cluster_logs").
+			Send()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.String()).To(ContainSubstring("Cluster installation started"))
+		Expect(response.String()).To(ContainSubstring("Cluster uninstallation completed"))
+	})
+
+	It("Stores the resources of clusters", func() {
+		cluster := createCluster("demo")
+		idp, err := cmv1.NewIdentityProvider().
+			Name("htpasswd").
+			Type(cmv1.IdentityProviderTypeHtpasswd).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		created, err := client.CreateIdentityProvider(cluster.ID(), idp)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created.ID()).NotTo(BeEmpty())
+
+		idps, err := client.GetIdentityProviders(cluster.ID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(idps).To(HaveLen(1))
+		Expect(idps[0].Name()).To(Equal("htpasswd"))
+
+		_, err = client.GetIdentityProviders("unknown")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Rejects duplicated cluster names", func() {
+		createCluster("demo")
+		spec, err := cmv1.NewCluster().Name("demo").Build()
+		Expect(err).NotTo(HaveOccurred())
+		response, err := connection.ClustersMgmt().V1().Clusters().Add().Body(spec).Send()
+		Expect(err).To(HaveOccurred())
+		Expect(response.Status()).To(Equal(http.StatusBadRequest))
+		Expect(response.Error().Reason()).To(Equal("Cluster 'demo' already exists"))
+	})
+
+	It("Requires an access token", func() {
+		response, err := http.Get(httpServer.URL + "/api/clusters_mgmt/v1/clusters")
+		Expect(err).NotTo(HaveOccurred())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+})
+
+var _ = Describe("Search", func() {
+	cluster := object{
+		"id":    "123",
+		"name":  "my-cluster",
+		"state": "ready",
+		"aws":   object{"sts": object{"role_arn": "arn:aws:iam::123456789012:role/Installer-Role"}},
+		"ccs":   object{"enabled": true},
+		"nodes": object{"compute": float64(3)},
+	}
+
+	DescribeTable("Matches objects",
+		func(search string, expected bool) {
+			match, err := parseSearch(search)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match(cluster)).To(Equal(expected))
+		},
+		Entry("empty", "", true),
+		Entry("equal", "name = 'my-cluster'", true),
+		Entry("not equal", "name != 'my-cluster'", false),
+		Entry("nested", "aws.sts.role_arn LIKE '%:123456789012:%'", true),
+		Entry("missing", "properties.rosa_creator_arn LIKE '%'", false),
+		Entry("bool", "ccs.enabled = 'true'", true),
+		Entry("number", "nodes.compute = 3", true),
+		Entry("or", "id = 'x' OR name = 'my-cluster'", true),
+		Entry("and", "id = '123' AND state = 'installing'", false),
+		Entry("not", "NOT state = 'installing'", true),
+		Entry("parentheses", "state = 'ready' AND (id = 'x' OR id = '123')", true),
+		Entry("in", "state IN ('installing', 'ready')", true),
+		Entry("ilike", "name ILIKE 'MY-%'", true),
+		Entry("is null", "properties IS NULL", true),
+		Entry("is not null", "aws IS NOT NULL", true),
+	)
+
+	It("Rejects invalid searches", func() {
+		_, err := parseSearch("name = 'unterminated")
+		Expect(err).To(HaveOccurred())
+		_, err = parseSearch("name 'my-cluster'")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockserver
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"strings"
+)
+
+// object is the JSON representation of a resource of the API.
+type object = map[string]interface{}
+
+// store keeps the resources in memory, indexed by the path of their collection. Singletons, like the
+// status of a cluster, are indexed by their own path. It isn't safe for concurrent use, the server
+// serializes the requests.
+type store struct {
+	collections map[string]*collection
+	singletons  map[string]object
+}
+
+type collection struct {
+	ids   []string
+	items map[string]object
+}
+
+func newStore() *store {
+	return &store{
+		collections: map[string]*collection{},
+		singletons:  map[string]object{},
+	}
+}
+
+// list returns copies of the items of a collection, in the order they were added.
+func (s *store) list(path string) []object {
+	c, ok := s.collections[path]
+	if !ok {
+		return []object{}
+	}
+	items := make([]object, 0, len(c.ids))
+	for _, id := range c.ids {
+		items = append(items, clone(c.items[id]))
+	}
+	return items
+}
+
+// get returns the item of the collection with the given identifier, or nil if it doesn't exist. The
+// returned object is the stored one, callers that change it change the store.
+func (s *store) get(path string, id string) object {
+	c, ok := s.collections[path]
+	if !ok {
+		return nil
+	}
+	return c.items[id]
+}
+
+// add adds an item to the collection, replacing the item with the same identifier if there is one.
+func (s *store) add(path string, item object) {
+	c, ok := s.collections[path]
+	if !ok {
+		c = &collection{items: map[string]object{}}
+		s.collections[path] = c
+	}
+	id, _ := item["id"].(string)
+	if _, exists := c.items[id]; !exists {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = item
+}
+
+// remove removes an item from its collection, together with the collections and singletons nested
+// below it.
+func (s *store) remove(path string, id string) {
+	c, ok := s.collections[path]
+	if !ok {
+		return
+	}
+	delete(c.items, id)
+	for i, existing := range c.ids {
+		if existing == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	prefix := path + "/" + id + "/"
+	for nested := range s.collections {
+		if strings.HasPrefix(nested, prefix) {
+			delete(s.collections, nested)
+		}
+	}
+	for nested := range s.singletons {
+		if strings.HasPrefix(nested, prefix) {
+			delete(s.singletons, nested)
+		}
+	}
+}
+
+// exists checks if the item that a path points to exists. Paths with an even number of segments
+// point to items of collections, the rest to singletons.
+func (s *store) exists(segments []string) bool {
+	if len(segments)%2 == 0 {
+		return s.get(strings.Join(segments[:len(segments)-1], "/"), segments[len(segments)-1]) != nil
+	}
+	_, ok := s.singletons[strings.Join(segments, "/")]
+	return ok
+}
+
+// merge applies a patch to an object, replacing the attributes that aren't objects themselves and
+// merging those that are.
+func merge(target object, patch object) {
+	for key, value := range patch {
+		nested, ok := value.(map[string]interface{})
+		existing, exists := target[key].(map[string]interface{})
+		if ok && exists {
+			merge(existing, nested)
+			continue
+		}
+		target[key] = value
+	}
+}
+
+func clone(o object) object {
+	data, _ := json.Marshal(o)
+	result := object{}
+	_ = json.Unmarshal(data, &result)
+	return result
+}
+
+const idAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// newID generates a random identifier that looks like the ones generated by the OCM API.
+func newID() string {
+	data := make([]byte, 32)
+	_, _ = rand.Read(data)
+	for i := range data {
+		data[i] = idAlphabet[int(data[i])%len(idAlphabet)]
+	}
+	return string(data)
+}
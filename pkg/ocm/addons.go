@@ -22,8 +22,15 @@ import (
 
 	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalAddOn)
+	output.Register(cmv1.MarshalAddOnInstallation)
+}
+
 type AddOnBilling struct {
 	BillingModel     string
 	BillingAccountID string
@@ -109,7 +116,7 @@ func (c *Client) InstallAddOn(clusterID, addOnID string, params []AddOnParam, bi
 		Addons().
 		Add().
 		Body(addOnInstallation).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -124,7 +131,7 @@ func (c *Client) UninstallAddOn(clusterID, addOnID string) error {
 		Addons().
 		Addoninstallation(addOnID).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -139,7 +146,7 @@ func (c *Client) GetAddOnInstallation(clusterID, addOnID string) (*cmv1.AddOnIns
 		Addons().
 		Addoninstallation(addOnID).
 		Get().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -167,7 +174,7 @@ func (c *Client) UpdateAddOnInstallation(clusterID, addOnID string, params []Add
 
 	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).
 		Addons().Addoninstallation(addOnID).
-		Update().Body(addOnInstallation).Send()
+		Update().Body(addOnInstallation).SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -177,7 +184,7 @@ func (c *Client) UpdateAddOnInstallation(clusterID, addOnID string, params []Add
 
 func (c *Client) GetAddOnParameters(clusterID, addOnID string) (*cmv1.AddOnParameterList, error) {
 	response, err := c.ocm.ClustersMgmt().V1().Clusters().
-		Cluster(clusterID).AddonInquiries().AddonInquiry(addOnID).Get().Send()
+		Cluster(clusterID).AddonInquiries().AddonInquiry(addOnID).Get().SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -189,7 +196,7 @@ func (c *Client) GetAvailableAddOns() ([]*AddOnResource, error) {
 	// Get organization ID (used to get add-on quotas)
 	acctResponse, err := c.ocm.AccountsMgmt().V1().CurrentAccount().
 		Get().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(acctResponse.Error(), err)
 	}
@@ -204,7 +211,7 @@ func (c *Client) GetAvailableAddOns() ([]*AddOnResource, error) {
 		Parameter("fetchRelatedResources", true).
 		Page(1).
 		Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(quotaCostResponse.Error(), err)
 	}
@@ -216,7 +223,7 @@ func (c *Client) GetAvailableAddOns() ([]*AddOnResource, error) {
 		Search("enabled='t'").
 		Page(1).
 		Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(addOnsResponse.Error(), err)
 	}
@@ -263,7 +270,7 @@ func (c *Client) GetAvailableAddOns() ([]*AddOnResource, error) {
 }
 
 func (c *Client) GetAddOn(id string) (*cmv1.AddOn, error) {
-	response, err := c.ocm.ClustersMgmt().V1().Addons().Addon(id).Get().Send()
+	response, err := c.ocm.ClustersMgmt().V1().Addons().Addon(id).Get().SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -284,7 +291,7 @@ func (c *Client) GetClusterAddOns(cluster *cmv1.Cluster) ([]*ClusterAddOn, error
 		List().
 		Page(1).
 		Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(addOnInstallationsResponse.Error(), err)
 	}
@@ -343,7 +350,7 @@ func (c *Client) AddClusterOperatorRole(cluster *cmv1.Cluster, role *cmv1.Operat
 		STSOperatorRoles().
 		Add().
 		Body(role).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -18,7 +18,7 @@ var awsAccountRegexp = regexp.MustCompile(`^[0-9]{12}$`)
 func (c *Client) GetBillingAccounts() ([]*v1.CloudAccount, error) {
 	acctResponse, err := c.ocm.AccountsMgmt().V1().CurrentAccount().
 		Get().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(acctResponse.Error(), err)
 	}
@@ -32,7 +32,7 @@ func (c *Client) GetBillingAccounts() ([]*v1.CloudAccount, error) {
 		Parameter("search", search).
 		Page(1).
 		Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(quotaCostResponse.Error(), err)
 	}
@@ -8,8 +8,14 @@ import (
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalBreakGlassCredential)
+}
+
 const (
 	DefaultKubeConfigPollInterval = 200 * time.Second
 	DefaultKubeConfigTimeout      = time.Hour
@@ -19,7 +25,7 @@ func (c *Client) CreateBreakGlassCredential(clusterID string,
 	breakGlassCredential *cmv1.BreakGlassCredential) (*cmv1.BreakGlassCredential, error) {
 	response, err := c.ocm.ClustersMgmt().V1().
 		Clusters().Cluster(clusterID).BreakGlassCredentials().
-		Add().Body(breakGlassCredential).Send()
+		Add().Body(breakGlassCredential).SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -31,7 +37,7 @@ func (c *Client) GetBreakGlassCredentials(clusterID string) ([]*cmv1.BreakGlassC
 		Clusters().Cluster(clusterID).
 		BreakGlassCredentials().
 		List().Page(1).Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -44,7 +50,7 @@ func (c *Client) GetBreakGlassCredential(clusterID string,
 		Clusters().Cluster(clusterID).BreakGlassCredentials().
 		BreakGlassCredential(breakGlassCredentialID).
 		Get().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -53,7 +59,7 @@ func (c *Client) GetBreakGlassCredential(clusterID string,
 
 func (c *Client) DeleteBreakGlassCredentials(clusterID string) error {
 
-	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).BreakGlassCredentials().Delete().Send()
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).BreakGlassCredentials().Delete().SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -66,7 +72,7 @@ func (c *Client) PollKubeconfig(
 	pollInterval time.Duration,
 	timeout time.Duration) (kubeconfig string, err error) {
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(c.context(), timeout)
 	defer func() {
 		cancel()
 	}()
@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/config"
+)
+
+// Lookups of OCM data that is slow to fetch and rarely changes, kept in the cache. The values are
+// stored with the JSON encoding of the SDK, and the keys are scoped to the account of the user and
+// the URL of the OCM server.
+var (
+	versionsLookup = listLookup("versions", time.Hour,
+		cmv1.MarshalVersionList, cmv1.UnmarshalVersionList)
+	regionsLookup = listLookup("regions", 24*time.Hour,
+		cmv1.MarshalStringList, cmv1.UnmarshalStringList)
+	availableRegionsLookup = listLookup("available_regions", time.Hour,
+		cmv1.MarshalCloudRegionList, cmv1.UnmarshalCloudRegionList)
+	machineTypesLookup = listLookup("machine_types", 24*time.Hour,
+		cmv1.MarshalMachineTypeList, cmv1.UnmarshalMachineTypeList)
+	stsPoliciesLookup = listLookup("sts_policies", time.Hour,
+		cmv1.MarshalAWSSTSPolicyList, cmv1.UnmarshalAWSSTSPolicyList)
+	credentialRequestsLookup = listLookup("credential_requests", time.Hour,
+		cmv1.MarshalSTSCredentialRequestList, cmv1.UnmarshalSTSCredentialRequestList)
+	versionGatesLookup = listLookup("version_gates", time.Hour,
+		cmv1.MarshalVersionGateList, cmv1.UnmarshalVersionGateList)
+)
+
+// listLookup creates a cache lookup for a list of values that are encoded using the given SDK
+// functions.
+func listLookup[T any](name string, ttl time.Duration, marshal func([]T, io.Writer) error,
+	unmarshal func(interface{}) ([]T, error)) cache.Lookup[[]T] {
+	return cache.Lookup[[]T]{
+		Name: name,
+		TTL:  ttl,
+		Encode: func(list []T) ([]byte, error) {
+			var buffer bytes.Buffer
+			err := marshal(list, &buffer)
+			return buffer.Bytes(), err
+		},
+		Decode: func(data []byte) ([]T, error) {
+			return unmarshal(data)
+		},
+	}
+}
+
+// fetchList returns the list stored in the cache for the given lookup and key parts, or fetches and
+// stores it if it isn't there. Clients created without a cache always fetch.
+func fetchList[T any](c *Client, lookup cache.Lookup[[]T], parts []string, fetch func() ([]T, error)) ([]T, error) {
+	if c.cache == nil {
+		return fetch()
+	}
+	return cache.Fetch(c.cache, lookup, lookup.Key(c.cacheScope(), parts...), fetch)
+}
+
+// cacheScope returns the scope of the cache keys, for example
+// '1a2b3c@https://api.openshift.com'. What OCM returns, like the versions or the regions, may
+// depend on the account, so the values cached for one account are never used for another.
+func (c *Client) cacheScope() string {
+	if c.account == "" {
+		return c.ocm.URL()
+	}
+	return c.account + "@" + c.ocm.URL()
+}
+
+// tokenAccount returns the identifier of the account that the access token was issued for, or
+// else the subject of the token. It returns an empty string if the token can't be parsed.
+func tokenAccount(accessToken string) string {
+	cfg := &config.Config{AccessToken: accessToken}
+	for _, claim := range []string{"account_id", "sub"} {
+		value, err := cfg.GetData(claim)
+		if err == nil && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func hypershiftKey(isHypershift bool) string {
+	if isHypershift {
+		return "hosted_cp"
+	}
+	return "classic"
+}
@@ -17,23 +17,35 @@ limitations under the License.
 package ocm
 
 import (
+	"context"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	"github.com/sirupsen/logrus"
 
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/cassette"
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/config"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/info"
 	"github.com/openshift/rosa/pkg/logging"
+	"github.com/openshift/rosa/pkg/offline"
 	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/timings"
+	"github.com/openshift/rosa/pkg/tracing"
 )
 
 type Client struct {
-	ocm *sdk.Connection
+	ocm   *sdk.Connection
+	ctx   context.Context
+	cache cache.RosaCacheService
+	// account identifies the account of the user, so that the cached lookups of different
+	// accounts are kept apart
+	account string
 }
 
 // ClientBuilder contains the information and logic needed to build a connection to OCM. Don't
@@ -41,6 +53,7 @@ type Client struct {
 type ClientBuilder struct {
 	logger *logrus.Logger
 	cfg    *config.Config
+	ctx    context.Context
 }
 
 // NewClient creates a builder that can then be used to configure and build an OCM connection.
@@ -60,8 +73,7 @@ func CreateNewClientOrExit(logger *logrus.Logger, reporter *reporter.Object) *Cl
 		Logger(logger).
 		Build()
 	if err != nil {
-		reporter.Errorf("Failed to create OCM connection: %v", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(reporter, rosaerrors.Wrapf(err, "Failed to create OCM connection")))
 	}
 
 	return client
@@ -80,8 +92,26 @@ func (b *ClientBuilder) Config(value *config.Config) *ClientBuilder {
 	return b
 }
 
+// Context sets the context used for the requests sent to OCM. The context of the command is used
+// by default, so the requests are cancelled when the command is interrupted or times out.
+func (b *ClientBuilder) Context(value context.Context) *ClientBuilder {
+	b.ctx = value
+	return b
+}
+
 // Build uses the information stored in the builder to create a new OCM connection.
 func (b *ClientBuilder) Build() (result *Client, err error) {
+	if b.ctx == nil {
+		b.ctx = cmdcontext.Current()
+	}
+	if b.cfg == nil && cassette.Replaying() {
+		// Replayed requests are answered from the cassette, so the local configuration and
+		// its tokens aren't needed:
+		b.cfg, err = replayConfig()
+		if err != nil {
+			return nil, err
+		}
+	}
 	if b.cfg == nil {
 		// Load the configuration file:
 		b.cfg, err = config.Load()
@@ -90,7 +120,7 @@ func (b *ClientBuilder) Build() (result *Client, err error) {
 			return nil, err
 		}
 		if b.cfg == nil {
-			err = fmt.Errorf("Not logged in, run the 'rosa login' command")
+			err = rosaerrors.New(rosaerrors.CategoryUnauthorized, "Not logged in, run the 'rosa login' command")
 			return nil, err
 		}
 	}
@@ -138,33 +168,102 @@ func (b *ClientBuilder) Build() (result *Client, err error) {
 	if b.cfg.RefreshToken != "" {
 		tokens = append(tokens, b.cfg.RefreshToken)
 	}
+	if offline.Enabled() {
+		// Requests are answered with saved responses, so the tokens of the configuration aren't
+		// needed, and refreshing them would require contacting the authentication service:
+		token, err := offline.Token()
+		if err != nil {
+			return nil, err
+		}
+		tokens = []string{token}
+	}
 	if len(tokens) > 0 {
 		builder.Tokens(tokens...)
 	}
 	builder.Insecure(b.cfg.Insecure)
+	if timings.Enabled() {
+		// This must be the first wrapper, see the documentation of the function:
+		builder.TransportWrapper(timings.Wrap)
+	}
+	if cassette.Enabled() {
+		builder.TransportWrapper(cassette.Wrap)
+	}
+	if tracing.Enabled() {
+		builder.TransportWrapper(tracing.Wrap)
+	}
+	if !cassette.Enabled() {
+		// This must be the last wrapper, see the documentation of the function. The token used in
+		// offline mode doesn't belong to any account, so the account is taken from the configuration:
+		builder.TransportWrapper(offline.Wrapper(tokenAccount(b.cfg.AccessToken)))
+	}
 
 	// Create the connection:
 	conn, err := builder.Build()
 	if err != nil {
 		return
 	}
-	accessToken, refreshToken, err := conn.Tokens(10 * time.Minute)
+	if offline.Enabled() {
+		return &Client{
+			ocm:     conn,
+			ctx:     b.ctx,
+			cache:   newLookupCache(),
+			account: tokenAccount(b.cfg.AccessToken),
+		}, nil
+	}
+	accessToken, refreshToken, err := conn.TokensContext(b.ctx, 10*time.Minute)
 	if err != nil {
 		if strings.Contains(err.Error(), "invalid_grant") {
-			return nil, fmt.Errorf("your authorization token needs to be updated. " +
-				"Please login again using rosa login")
+			return nil, rosaerrors.New(rosaerrors.CategoryUnauthorized,
+				"your authorization token needs to be updated. Please login again using rosa login")
 		}
 		return nil, fmt.Errorf("error creating connection. Not able to get authentication token: %s", err)
 	}
 
 	// Persist tokens in the configuration file, the SDK may have refreshed them
-	err = config.PersistTokens(b.cfg, accessToken, refreshToken)
-	if err != nil {
-		return nil, fmt.Errorf("error creating connection. Can't persist tokens to config: %s", err)
+	if !cassette.Replaying() {
+		err = config.PersistTokens(b.cfg, accessToken, refreshToken)
+		if err != nil {
+			return nil, fmt.Errorf("error creating connection. Can't persist tokens to config: %s", err)
+		}
 	}
 
 	return &Client{
-		ocm: conn,
+		ocm:     conn,
+		ctx:     b.ctx,
+		cache:   newLookupCache(),
+		account: tokenAccount(accessToken),
+	}, nil
+}
+
+// newLookupCache returns the cache used for OCM lookups. Cached lookups wouldn't be recorded or
+// replayed, so the cache isn't used with cassettes. A cache file that can't be loaded is replaced
+// the next time that a value is stored.
+func newLookupCache() cache.RosaCacheService {
+	if cassette.Enabled() {
+		return nil
+	}
+	lookups, _ := cache.NewRosaCacheService()
+	return lookups
+}
+
+// replayConfig returns the configuration used to replay requests: the URL they were recorded
+// against and a token that is never sent anywhere.
+func replayConfig() (*config.Config, error) {
+	url := cassette.OCMURL()
+	if url == "" {
+		_, err := cassette.Current()
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("There are no recorded OCM requests to replay")
+	}
+	token, err := cassette.Token()
+	if err != nil {
+		return nil, err
+	}
+	return &config.Config{
+		URL:         url,
+		AccessToken: token,
 	}, nil
 }
 
@@ -177,7 +276,15 @@ func (c *Client) GetConnectionURL() string {
 }
 
 func (c *Client) GetConnectionTokens(expiresIn ...time.Duration) (string, string, error) {
-	return c.ocm.Tokens(expiresIn...)
+	return c.ocm.TokensContext(c.context(), expiresIn...)
+}
+
+// context returns the context used for the requests sent to OCM.
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
 }
 
 func (c *Client) KeepTokensAlive() error {
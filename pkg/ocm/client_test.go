@@ -112,4 +112,13 @@ var _ = Describe("OCM Client", Ordered, func() {
 			Expect(myconf.RefreshToken).To(Equal(newRefreshToken))
 		})
 	})
+	It("Scopes the cache to the account of the token", func() {
+		claims := MakeClaims()
+		claims["account_id"] = "1a2b3c"
+		Expect(tokenAccount(MakeTokenObject(claims).Raw)).To(Equal("1a2b3c"))
+		delete(claims, "account_id")
+		claims["sub"] = "f4e5d6"
+		Expect(tokenAccount(MakeTokenObject(claims).Raw)).To(Equal("f4e5d6"))
+		Expect(tokenAccount("")).To(BeEmpty())
+	})
 })
@@ -21,8 +21,14 @@ import (
 	"net/http"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalClusterAutoscaler)
+}
+
 type AutoscalerConfig struct {
 	BalanceSimilarNodeGroups    bool
 	SkipNodesWithLocalStorage   bool
@@ -107,7 +113,7 @@ func BuildClusterAutoscaler(config *AutoscalerConfig) *cmv1.ClusterAutoscalerBui
 }
 
 func (c *Client) GetClusterAutoscaler(clusterID string) (*cmv1.ClusterAutoscaler, error) {
-	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).Autoscaler().Get().Send()
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).Autoscaler().Get().SendContext(c.context())
 
 	if response.Status() == http.StatusNotFound {
 		return nil, nil
@@ -127,7 +133,7 @@ func (c *Client) CreateClusterAutoscaler(clusterId string, config *AutoscalerCon
 		return nil, err
 	}
 
-	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterId).Autoscaler().Post().Request(object).Send()
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterId).Autoscaler().Post().Request(object).SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -141,7 +147,7 @@ func (c *Client) UpdateClusterAutoscaler(clusterId string, config *AutoscalerCon
 		return nil, err
 	}
 
-	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterId).Autoscaler().Update().Body(object).Send()
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterId).Autoscaler().Update().Body(object).SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -153,7 +159,7 @@ func (c *Client) DeleteClusterAutoscaler(clusterID string) error {
 		Clusters().Cluster(clusterID).
 		Autoscaler().
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
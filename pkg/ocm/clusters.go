@@ -19,7 +19,6 @@ package ocm
 import (
 	"fmt"
 	"net"
-	"os"
 	"reflect"
 	"strconv"
 	"time"
@@ -32,13 +31,19 @@ import (
 	errors "github.com/zgalor/weberr"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/info"
 	"github.com/openshift/rosa/pkg/interactive/consts"
+	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/properties"
 	rprtr "github.com/openshift/rosa/pkg/reporter"
 )
 
+func init() {
+	output.Register(cmv1.MarshalCluster)
+}
+
 const (
 	legacyIngressSupportLabel = "ext-managed.openshift.io/legacy-ingress-support"
 )
@@ -225,7 +230,7 @@ func (c *Client) HasClusters(creator *aws.Creator) (bool, error) {
 		Search(query).
 		Page(1).
 		Size(1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return false, handleErr(response.Error(), err)
 	}
@@ -243,7 +248,7 @@ func (c *Client) CreateCluster(config Spec) (*cmv1.Cluster, error) {
 		Add().
 		Parameter("dryRun", *config.DryRun).
 		Body(spec).
-		Send()
+		SendContext(c.context())
 	if config.DryRun != nil && *config.DryRun {
 		if cluster.Error() != nil {
 			return nil, handleErr(cluster.Error(), err)
@@ -291,34 +296,96 @@ func (c *Client) GetClustersUsingAccountRole(aws *aws.Creator, role aws.Role, co
 }
 
 func (c *Client) queryClusters(query string, count int) (clusters []*cmv1.Cluster, err error) {
+	err = c.eachClusterPage(query, "", count, 0, func(page []*cmv1.Cluster) error {
+		clusters = append(clusters, page...)
+		return nil
+	})
+	return clusters, err
+}
 
-	if count < 0 {
-		err = errors.Errorf("Invalid Cluster count")
-		return
+// eachClusterPage sends the query page by page and calls the given function with the clusters of
+// every page. A size of 0 fetches a single page of the default size, and a limit of 0 doesn't
+// limit the number of clusters.
+func (c *Client) eachClusterPage(query string, order string, size int, limit int,
+	fn func([]*cmv1.Cluster) error) error {
+	if size < 0 || limit < 0 {
+		return errors.Errorf("Invalid Cluster count")
+	}
+	if limit > 0 && (size == 0 || limit < size) {
+		size = limit
 	}
 
 	request := c.ocm.ClustersMgmt().V1().Clusters().List().Search(query)
-	page := 1
-	for {
+	if order != "" {
+		request = request.Order(order)
+	}
+	fetched := 0
+	for page := 1; ; page++ {
 		clusterRequestList := request.Page(page)
-		if count > 0 {
-			clusterRequestList = clusterRequestList.Size(count)
+		if size > 0 {
+			clusterRequestList = clusterRequestList.Size(size)
 		}
-		response, err := clusterRequestList.Send()
+		response, err := clusterRequestList.SendContext(c.context())
 		if err != nil {
-			return clusters, err
+			return err
 		}
 
-		response.Items().Each(func(cluster *cmv1.Cluster) bool {
-			clusters = append(clusters, cluster)
-			return true
-		})
-		if response.Size() != count {
-			break
+		clusters := response.Items().Slice()
+		if limit > 0 && fetched+len(clusters) > limit {
+			clusters = clusters[:limit-fetched]
+		}
+		fetched += len(clusters)
+		err = fn(clusters)
+		if err != nil {
+			return err
+		}
+		if response.Size() != size || (limit > 0 && fetched >= limit) {
+			return nil
 		}
-		page++
 	}
-	return clusters, nil
+}
+
+// ClusterListOptions selects the clusters returned by ListClusters.
+type ClusterListOptions struct {
+	// Creator restricts the clusters to the ones of its AWS account, nil returns the clusters of
+	// every AWS account of the organization
+	Creator *aws.Creator
+	// AccountRole restricts the clusters to the ones using the account role
+	AccountRole *aws.Role
+	// Search is an additional OCM search expression, for example "state = 'ready'"
+	Search string
+	// Order is an OCM order expression, for example "name asc"
+	Order string
+	// PageSize is the number of clusters fetched by each request
+	PageSize int
+	// Limit is the maximum number of clusters, 0 for no limit
+	Limit int
+}
+
+// ListClusters calls the given function with every page of clusters matching the options, so that
+// callers can process the first clusters before the following pages are fetched. The filtering and
+// the sorting happen on the server side.
+func (c *Client) ListClusters(options ClusterListOptions, fn func([]*cmv1.Cluster) error) error {
+	query, err := getClusterListFilter(options)
+	if err != nil {
+		return err
+	}
+	return c.eachClusterPage(query, options.Order, options.PageSize, options.Limit, fn)
+}
+
+func getClusterListFilter(options ClusterListOptions) (string, error) {
+	query := getClusterFilter(options.Creator)
+	if options.AccountRole != nil {
+		var err error
+		query, err = getAccountRoleClusterFilter(options.Creator, *options.AccountRole)
+		if err != nil {
+			return "", err
+		}
+	}
+	if options.Search != "" {
+		query = fmt.Sprintf("%s AND (%s)", query, options.Search)
+	}
+	return query, nil
 }
 
 // Pass 0 to get all clusters
@@ -329,7 +396,7 @@ func (c *Client) GetClusters(creator *aws.Creator, count int) (clusters []*cmv1.
 func (c *Client) GetAllClusters(creator *aws.Creator) (clusters []*cmv1.Cluster, err error) {
 	query := getClusterFilter(creator)
 	request := c.ocm.ClustersMgmt().V1().Clusters().List().Search(query)
-	response, err := request.Send()
+	response, err := request.SendContext(c.context())
 
 	if err != nil {
 		return clusters, err
@@ -347,7 +414,7 @@ func (c *Client) GetCluster(clusterKey string, creator *aws.Creator) (*cmv1.Clus
 		Search(query).
 		Page(1).
 		Size(1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -365,7 +432,7 @@ func (c *Client) GetCluster(clusterKey string, creator *aws.Creator) (*cmv1.Clus
 func (c *Client) GetSubscriptionBySubscriptionID(id string) (*amv1.Subscription, bool, error) {
 	response, err := c.ocm.AccountsMgmt().V1().Subscriptions().Subscription(id).
 		Get().
-		Send()
+		SendContext(c.context())
 
 	if err != nil {
 		return nil, false, err
@@ -386,7 +453,7 @@ func (c *Client) GetClusterByID(clusterKey string, creator *aws.Creator) (*cmv1.
 		Search(query).
 		Page(1).
 		Size(1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -408,7 +475,7 @@ func (c *Client) GetClusterUsingSubscription(clusterKey string, creator *aws.Cre
 		Search(query).
 		Page(1).
 		Size(1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -433,7 +500,7 @@ func (c *Client) GetPendingClusterForARN(creator *aws.Creator) (cluster *cmv1.Cl
 	)
 	request := c.ocm.ClustersMgmt().V1().Clusters().List().Search(query)
 
-	response, err := request.Send()
+	response, err := request.SendContext(c.context())
 	if err != nil {
 		return cluster, err
 	}
@@ -446,7 +513,7 @@ func (c *Client) HasAClusterUsingOperatorRolesPrefix(prefix string) (bool, error
 	)
 	request := c.ocm.ClustersMgmt().V1().Clusters().List().Search(query)
 	page := 1
-	response, err := request.Page(page).Send()
+	response, err := request.Page(page).SendContext(c.context())
 	if err != nil {
 		return false, err
 	}
@@ -464,7 +531,7 @@ func (c *Client) HasAClusterUsingOidcProvider(
 	)
 	request := c.ocm.ClustersMgmt().V1().Clusters().List().Search(query)
 	page := 1
-	response, err := request.Page(page).Send()
+	response, err := request.Page(page).SendContext(c.context())
 	if err != nil {
 		return false, err
 	}
@@ -480,7 +547,7 @@ func (c *Client) HasAClusterUsingOidcEndpointUrl(issuerUrl string) (bool, error)
 	)
 	request := c.ocm.ClustersMgmt().V1().Clusters().List().Search(query)
 	page := 1
-	response, err := request.Page(page).Send()
+	response, err := request.Page(page).SendContext(c.context())
 	if err != nil {
 		return false, err
 	}
@@ -511,7 +578,7 @@ func (c *Client) IsSTSClusterExists(creator *aws.Creator, count int, roleARN str
 	)
 	request := c.ocm.ClustersMgmt().V1().Clusters().List().Search(query)
 	page := 1
-	response, err := request.Page(page).Size(count).Send()
+	response, err := request.Page(page).Size(count).SendContext(c.context())
 	if err != nil {
 		return false, err
 	}
@@ -526,13 +593,27 @@ func (c *Client) GetClusterState(clusterID string) (cmv1.ClusterState, error) {
 		Cluster(clusterID).
 		Status().
 		Get().
-		Send()
+		SendContext(c.context())
 	if err != nil || response.Body() == nil {
 		return cmv1.ClusterState(""), err
 	}
 	return response.Body().State(), nil
 }
 
+// GetClusterStatus returns the status of the cluster, which includes the number of compute nodes
+// that are running.
+func (c *Client) GetClusterStatus(clusterID string) (*cmv1.ClusterStatus, error) {
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().
+		Cluster(clusterID).
+		Status().
+		Get().
+		SendContext(c.context())
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
 func (c *Client) getClusterNodesBuilder(config Spec) (clusterNodesBuilder *cmv1.ClusterNodesBuilder, updateNodes bool) {
 
 	clusterNodesBuilder = cmv1.NewClusterNodes()
@@ -651,7 +732,7 @@ func (c *Client) UpdateCluster(clusterKey string, creator *aws.Creator, config S
 		Cluster(cluster.ID()).
 		Update().
 		Body(clusterSpec).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -670,7 +751,7 @@ func (c *Client) DeleteCluster(clusterKey string, bestEffort bool,
 		Cluster(cluster.ID()).
 		Delete().
 		BestEffort(bestEffort).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -684,7 +765,7 @@ func (c *Client) UpdateClusterDeletionProtection(clusterId string, deleteProtect
 		DeleteProtection().
 		Update().
 		Body(deleteProtection).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -707,19 +788,22 @@ func (c *Client) EnsureNoPendingClusters(awsCreator *aws.Creator) error {
 		pendingCluster, err := c.GetPendingClusterForARN(awsCreator)
 		if err != nil {
 			reporter.Errorf("Error getting cluster using ARN '%s'", awsCreator.ARN)
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if time.Now().After(deadline) {
 			reporter.Errorf("Timeout waiting for the cluster '%s' installation. Try again in a few minutes",
 				pendingCluster.ID())
-			os.Exit(1)
+			cmdcontext.Exit(1)
 		}
 		if pendingCluster == nil {
 			break
 		} else {
 			reporter.Infof("Waiting for cluster '%s' with the same creator ARN to start installing",
 				pendingCluster.ID())
-			time.Sleep(30 * time.Second)
+			err = cmdcontext.Sleep(c.context(), 30*time.Second)
+			if err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -1081,7 +1165,7 @@ func (c *Client) HibernateCluster(clusterID string) error {
 	if !enabled {
 		return fmt.Errorf("The '%s' capability is not set for current org", HibernateCapability)
 	}
-	_, err = c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).Hibernate().Send()
+	_, err = c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).Hibernate().SendContext(c.context())
 	if err != nil {
 		return fmt.Errorf("Failed to hibernate the cluster: %v", err)
 	}
@@ -1097,7 +1181,7 @@ func (c *Client) ResumeCluster(clusterID string) error {
 	if !enabled {
 		return fmt.Errorf("The '%s' capability is not set for current org", HibernateCapability)
 	}
-	_, err = c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).Resume().Send()
+	_, err = c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).Resume().SendContext(c.context())
 	if err != nil {
 		return fmt.Errorf("Failed to resume the cluster: %v", err)
 	}
@@ -1124,7 +1208,7 @@ func IsSts(cluster *cmv1.Cluster) bool {
 
 func (c *Client) HasLegacyIngressSupport(cluster *cmv1.Cluster) (bool, error) {
 	labelList, err := c.ocm.ClustersMgmt().V1().Clusters().
-		Cluster(cluster.ID()).ExternalConfiguration().Labels().List().Send()
+		Cluster(cluster.ID()).ExternalConfiguration().Labels().List().SendContext(c.context())
 	if err != nil {
 		return true, fmt.Errorf("Failed to retrieve external configuration label list: %v", err)
 	}
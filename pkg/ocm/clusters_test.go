@@ -1,11 +1,21 @@
 package ocm
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
 
 	"github.com/openshift/rosa/pkg/aws"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 )
 
 var _ = Describe("New Operator Iam Role From Cmv1", func() {
@@ -93,3 +103,122 @@ var _ = Context("List Clusters", func() {
 
 	})
 })
+
+func clusterPage(page int, ids ...string) string {
+	items := make([]string, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, fmt.Sprintf(`{"kind": "Cluster", "id": "%s"}`, id))
+	}
+	return fmt.Sprintf(`{"kind": "ClusterList", "page": %d, "size": %d, "items": [%s]}`,
+		page, len(ids), strings.Join(items, ", "))
+}
+
+var _ = Describe("List Clusters page by page", func() {
+	var ssoServer, apiServer *ghttp.Server
+	var ocmClient *Client
+
+	BeforeEach(func() {
+		ssoServer = MakeTCPServer()
+		apiServer = MakeTCPServer()
+		accessToken := MakeTokenString("Bearer", 15*time.Minute)
+		ssoServer.AppendHandlers(RespondWithAccessToken(accessToken))
+		connection, err := sdk.NewConnectionBuilder().
+			Tokens(accessToken).
+			URL(apiServer.URL()).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		ocmClient = &Client{ocm: connection}
+	})
+
+	AfterEach(func() {
+		ssoServer.Close()
+		apiServer.Close()
+		Expect(ocmClient.Close()).To(Succeed())
+	})
+
+	collect := func(options ClusterListOptions) ([][]string, error) {
+		pages := [][]string{}
+		err := ocmClient.ListClusters(options, func(clusters []*cmv1.Cluster) error {
+			ids := []string{}
+			for _, cluster := range clusters {
+				ids = append(ids, cluster.ID())
+			}
+			pages = append(pages, ids)
+			return nil
+		})
+		return pages, err
+	}
+
+	It("Sends the search and the order to the server and fetches every page", func() {
+		apiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("search", "product.id = 'rosa' AND (state = 'ready')"),
+				ghttp.VerifyFormKV("order", "name asc"),
+				ghttp.VerifyFormKV("page", "1"),
+				ghttp.VerifyFormKV("size", "2"),
+				RespondWithJSON(http.StatusOK, clusterPage(1, "a", "b")),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("page", "2"),
+				RespondWithJSON(http.StatusOK, clusterPage(2, "c")),
+			),
+		)
+		pages, err := collect(ClusterListOptions{Search: "state = 'ready'", Order: "name asc", PageSize: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pages).To(Equal([][]string{{"a", "b"}, {"c"}}))
+	})
+
+	It("Stops once the limit is reached", func() {
+		apiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("size", "2"),
+				RespondWithJSON(http.StatusOK, clusterPage(1, "a", "b")),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("page", "2"),
+				RespondWithJSON(http.StatusOK, clusterPage(2, "c", "d")),
+			),
+		)
+		pages, err := collect(ClusterListOptions{PageSize: 2, Limit: 3})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pages).To(Equal([][]string{{"a", "b"}, {"c"}}))
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Uses the limit as page size when it is smaller", func() {
+		apiServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("size", "1"),
+				RespondWithJSON(http.StatusOK, clusterPage(1, "a")),
+			),
+		)
+		pages, err := collect(ClusterListOptions{PageSize: 100, Limit: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pages).To(Equal([][]string{{"a"}}))
+	})
+
+	It("Stops the requests when the context of the client is cancelled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ocmClient.ctx = ctx
+		_, err := collect(ClusterListOptions{PageSize: 10})
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(apiServer.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Categorizes requests stopped by the context as timeouts or interrupts", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+		ocmClient.ctx = ctx
+		_, err := ocmClient.GetCluster("my-cluster", &aws.Creator{})
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(rosaerrors.Classify(err).Category).To(Equal(rosaerrors.CategoryTimeout))
+
+		ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+		ocmClient.ctx = ctx
+		_, err = ocmClient.GetCluster("my-cluster", &aws.Creator{})
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(rosaerrors.Classify(err).Category).To(Equal(rosaerrors.CategoryInterrupted))
+	})
+})
@@ -16,12 +16,20 @@ limitations under the License.
 
 package ocm
 
-import cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+import (
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+func init() {
+	output.Register(cmv1.MarshalControlPlaneUpgradePolicy)
+}
 
 func (c *Client) CancelControlPlaneUpgrade(clusterID, upgradeID string) (bool, error) {
 	response, err := c.ocm.ClustersMgmt().V1().
 		Clusters().Cluster(clusterID).ControlPlane().UpgradePolicies().
-		ControlPlaneUpgradePolicy(upgradeID).Delete().Send()
+		ControlPlaneUpgradePolicy(upgradeID).Delete().SendContext(c.context())
 	if err != nil {
 		return false, handleErr(response.Error(), err)
 	}
@@ -56,7 +64,7 @@ func (c *Client) GetControlPlaneUpgradePolicies(clusterID string) (
 		response, err := collection.List().
 			Page(page).
 			Size(size).
-			Send()
+			SendContext(c.context())
 		if err != nil {
 			return nil, handleErr(response.Error(), err)
 		}
@@ -75,7 +83,7 @@ func (c *Client) ScheduleHypershiftControlPlaneUpgrade(clusterID string,
 		Clusters().Cluster(clusterID).ControlPlane().
 		UpgradePolicies().
 		Add().Body(upgradePolicy).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
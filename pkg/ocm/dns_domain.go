@@ -15,15 +15,21 @@ package ocm
 
 import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalDNSDomain)
+}
+
 func (c *Client) ListDNSDomains(search string) ([]*cmv1.DNSDomain, error) {
 	response, err := c.ocm.ClustersMgmt().V1().
 		DNSDomains().
 		List().
 		Parameter("search", search).
 		Page(1).Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -35,7 +41,7 @@ func (c *Client) DeleteDNSDomain(id string) error {
 	response, err := c.ocm.ClustersMgmt().V1().
 		DNSDomains().DNSDomain(id).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -48,7 +54,7 @@ func (c *Client) CreateDNSDomain() (*cmv1.DNSDomain, error) {
 		DNSDomains().
 		Add().
 		Body(&cmv1.DNSDomain{}).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
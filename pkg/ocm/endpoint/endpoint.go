@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package endpoint extracts from the paths of OCM requests the endpoint that they call and the
+// resources that they use.
+package endpoint
+
+import "strings"
+
+// Endpoint is the result of parsing the path of an OCM request like
+// '/api/clusters_mgmt/v1/clusters/123/addons/abc'.
+type Endpoint struct {
+	// Template is the path with the identifiers replaced by '{id}', so that calls to the same
+	// endpoint for different objects can be grouped.
+	Template string
+
+	// Service is the name of the OCM service, like 'clusters_mgmt'.
+	Service string
+
+	// Resource is the name of the last collection of the path, and ResourceID the identifier of
+	// the item of that collection, if any.
+	Resource   string
+	ResourceID string
+
+	// ClusterID is the identifier that follows the 'clusters' collection, if any.
+	ClusterID string
+}
+
+// Parse parses the given path. Paths that aren't OCM API paths are used as the template as is.
+func Parse(path string) Endpoint {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 3 || segments[0] != "api" {
+		return Endpoint{Template: path}
+	}
+	result := Endpoint{Service: segments[1]}
+	// After the service and its version the segments alternate between collections and the
+	// identifiers of their items:
+	for i := 3; i < len(segments); i++ {
+		if (i-3)%2 == 0 {
+			result.Resource = segments[i]
+			result.ResourceID = ""
+			continue
+		}
+		result.ResourceID = segments[i]
+		if segments[i-1] == "clusters" {
+			result.ClusterID = segments[i]
+		}
+		segments[i] = "{id}"
+	}
+	result.Template = "/" + strings.Join(segments, "/")
+	return result
+}
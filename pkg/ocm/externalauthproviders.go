@@ -1,11 +1,19 @@
 package ocm
 
-import cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+import (
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+func init() {
+	output.Register(cmv1.MarshalExternalAuth)
+}
 
 func (c *Client) CreateExternalAuth(clusterID string, ExternalAuth *cmv1.ExternalAuth) (*cmv1.ExternalAuth, error) {
 	response, err := c.ocm.ClustersMgmt().V1().
 		Clusters().Cluster(clusterID).
-		ExternalAuthConfig().ExternalAuths().Add().Body(ExternalAuth).Send()
+		ExternalAuthConfig().ExternalAuths().Add().Body(ExternalAuth).SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -17,7 +25,7 @@ func (c *Client) GetExternalAuth(clusterID string, externalAuthId string) (*cmv1
 		Clusters().Cluster(clusterID).ExternalAuthConfig().
 		ExternalAuths().ExternalAuth(externalAuthId).
 		Get().
-		Send()
+		SendContext(c.context())
 	if response.Status() == 404 {
 		return nil, false, nil
 	}
@@ -33,7 +41,7 @@ func (c *Client) GetExternalAuths(clusterID string) ([]*cmv1.ExternalAuth, error
 		ExternalAuthConfig().
 		ExternalAuths().
 		List().Page(1).Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -46,7 +54,7 @@ func (c *Client) DeleteExternalAuth(clusterID string, externalAuthId string) err
 		ExternalAuthConfig().ExternalAuths().
 		ExternalAuth(externalAuthId).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
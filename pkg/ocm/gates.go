@@ -20,8 +20,14 @@ import (
 	"fmt"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalVersionGate)
+}
+
 func (c *Client) ListStsGates(version string) (stsVersionGates []*cmv1.VersionGate, err error) {
 	versionGates, err := c.ListAllOcpGates(version)
 	if err != nil {
@@ -52,7 +58,13 @@ func (c *Client) ListOcpGates(version string) (stsVersionGates []*cmv1.VersionGa
 	return
 }
 
-func (c *Client) ListAllOcpGates(version string) (versionGates []*cmv1.VersionGate, err error) {
+func (c *Client) ListAllOcpGates(version string) ([]*cmv1.VersionGate, error) {
+	return fetchList(c, versionGatesLookup, []string{version}, func() ([]*cmv1.VersionGate, error) {
+		return c.listAllOcpGates(version)
+	})
+}
+
+func (c *Client) listAllOcpGates(version string) (versionGates []*cmv1.VersionGate, err error) {
 	versionGatesRequest := c.ocm.ClustersMgmt().V1().VersionGates()
 
 	page := 1
@@ -65,7 +77,7 @@ func (c *Client) ListAllOcpGates(version string) (versionGates []*cmv1.VersionGa
 			Page(page).
 			Size(size).
 			Search(query).
-			Send()
+			SendContext(c.context())
 
 		if err != nil {
 			return nil, handleErr(response.Error(), err)
@@ -42,6 +42,7 @@ import (
 	errors "github.com/zgalor/weberr"
 
 	"github.com/openshift/rosa/pkg/aws"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/reporter"
@@ -204,15 +205,21 @@ func handleErr(res *ocmerrors.Error, err error) error {
 			"Go to https://www.redhat.com/wapps/tnc/ackrequired?site=ocm&event=register\n" +
 			"Once you accept the terms, you will need to retry the action that was blocked."
 	}
-	errType := errors.ErrorType(res.Status())
-	return errType.Set(errors.Errorf("%s", msg))
+	ocmErr := rosaerrors.NewOCMError(res.Status(), res.Code(), msg).(*rosaerrors.Error)
+	ocmErr.Err = err
+	if res.Status() == 0 {
+		// No response was received, so the category is the one of the transport error, for
+		// example a timeout or an interrupt
+		ocmErr.Category = rosaerrors.Classify(err).Category
+	}
+	return ocmErr
 }
 
 func (c *Client) GetDefaultClusterFlavors(flavour string) (dMachinecidr *net.IPNet, dPodcidr *net.IPNet,
 	dServicecidr *net.IPNet, dhostPrefix, defaultMachineRootVolumeSize int, computeInstanceType string) {
-	flavourGetResponse, err := c.ocm.ClustersMgmt().V1().Flavours().Flavour(flavour).Get().Send()
+	flavourGetResponse, err := c.ocm.ClustersMgmt().V1().Flavours().Flavour(flavour).Get().SendContext(c.context())
 	if err != nil {
-		flavourGetResponse, _ = c.ocm.ClustersMgmt().V1().Flavours().Flavour("osd-4").Get().Send()
+		flavourGetResponse, _ = c.ocm.ClustersMgmt().V1().Flavours().Flavour("osd-4").Get().SendContext(c.context())
 	}
 	aws, ok := flavourGetResponse.Body().GetAWS()
 	if !ok {
@@ -250,7 +257,7 @@ func (c *Client) LogEvent(key string, body map[string]string) {
 			Events().
 			Add().
 			Body(event).
-			Send()
+			SendContext(c.context())
 	}
 }
 
@@ -258,7 +265,7 @@ func (c *Client) GetCurrentAccount() (*amsv1.Account, error) {
 	response, err := c.ocm.AccountsMgmt().V1().
 		CurrentAccount().
 		Get().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		if response.Status() == http.StatusNotFound {
 			return nil, nil
@@ -298,7 +305,7 @@ func (c *Client) IsCapabilityEnabled(capability string) (enabled bool, err error
 
 func (c *Client) isCapabilityEnabled(capabilityName string, orgID string) (bool, error) {
 	capabilityResponse, err := c.ocm.AccountsMgmt().V1().Organizations().
-		Organization(orgID).Get().Parameter("fetchCapabilities", true).Send()
+		Organization(orgID).Get().Parameter("fetchCapabilities", true).SendContext(c.context())
 
 	if err != nil {
 		return false, handleErr(capabilityResponse.Error(), err)
@@ -330,13 +337,13 @@ func (c *Client) UnlinkUserRoleFromAccount(accountID string, roleARN string) err
 			}
 
 			resp, err := c.ocm.AccountsMgmt().V1().Accounts().Account(accountID).Labels().
-				Labels(USERRoleLabel).Update().Body(label).Send()
+				Labels(USERRoleLabel).Update().Body(label).SendContext(c.context())
 			if err != nil {
 				return handleErr(resp.Error(), err)
 			}
 		} else {
 			resp, err := c.ocm.AccountsMgmt().V1().Accounts().Account(accountID).Labels().
-				Labels(USERRoleLabel).Delete().Send()
+				Labels(USERRoleLabel).Delete().SendContext(c.context())
 			if err != nil {
 				return handleErr(resp.Error(), err)
 			}
@@ -350,7 +357,7 @@ func (c *Client) UnlinkUserRoleFromAccount(accountID string, roleARN string) err
 
 func (c *Client) LinkAccountRole(accountID string, roleARN string) error {
 	resp, err := c.ocm.AccountsMgmt().V1().Accounts().Account(accountID).
-		Labels().Labels("sts_user_role").Get().Send()
+		Labels().Labels("sts_user_role").Get().SendContext(c.context())
 	if err != nil && resp.Status() != 404 {
 		if resp.Status() == 403 {
 			return errors.Forbidden.UserErrorf("%v", err)
@@ -381,7 +388,7 @@ func (c *Client) LinkAccountRole(accountID string, roleARN string) error {
 		return err
 	}
 	_, err = c.ocm.AccountsMgmt().V1().Accounts().Account(accountID).
-		Labels().Add().Body(labelBuilder).Send()
+		Labels().Add().Body(labelBuilder).SendContext(c.context())
 	if err != nil {
 		return handleErr(resp.Error(), err)
 	}
@@ -405,13 +412,13 @@ func (c *Client) UnlinkOCMRoleFromOrg(orgID string, roleARN string) error {
 			}
 
 			resp, err := c.ocm.AccountsMgmt().V1().Organizations().Organization(orgID).Labels().
-				Labels(OCMRoleLabel).Update().Body(label).Send()
+				Labels(OCMRoleLabel).Update().Body(label).SendContext(c.context())
 			if err != nil {
 				return handleErr(resp.Error(), err)
 			}
 		} else {
 			resp, err := c.ocm.AccountsMgmt().V1().Organizations().Organization(orgID).Labels().
-				Labels(OCMRoleLabel).Delete().Send()
+				Labels(OCMRoleLabel).Delete().SendContext(c.context())
 			if err != nil {
 				return handleErr(resp.Error(), err)
 			}
@@ -448,7 +455,7 @@ func (c *Client) LinkOrgToRole(orgID string, roleARN string) (bool, error) {
 	}
 
 	resp, err := c.ocm.AccountsMgmt().V1().Organizations().Organization(orgID).
-		Labels().Add().Body(labelBuilder).Send()
+		Labels().Add().Body(labelBuilder).SendContext(c.context())
 	if err != nil {
 		return false, handleErr(resp.Error(), err)
 	}
@@ -457,7 +464,7 @@ func (c *Client) LinkOrgToRole(orgID string, roleARN string) (bool, error) {
 
 func (c *Client) GetAccountLinkedUserRoles(accountID string) ([]string, error) {
 	resp, err := c.ocm.AccountsMgmt().V1().Accounts().Account(accountID).
-		Labels().Labels(USERRoleLabel).Get().Send()
+		Labels().Labels(USERRoleLabel).Get().SendContext(c.context())
 	if err != nil && resp.Status() != http.StatusNotFound {
 		return nil, handleErr(resp.Error(), err)
 	}
@@ -467,7 +474,7 @@ func (c *Client) GetAccountLinkedUserRoles(accountID string) ([]string, error) {
 
 func (c *Client) GetOrganizationLinkedOCMRoles(orgID string) ([]string, error) {
 	resp, err := c.ocm.AccountsMgmt().V1().Organizations().Organization(orgID).
-		Labels().Labels(OCMRoleLabel).Get().Send()
+		Labels().Labels(OCMRoleLabel).Get().SendContext(c.context())
 	if err != nil && resp.Status() != http.StatusNotFound {
 		return nil, err
 	}
@@ -477,7 +484,7 @@ func (c *Client) GetOrganizationLinkedOCMRoles(orgID string) ([]string, error) {
 
 func (c *Client) CheckIfAWSAccountExists(orgID string, awsAccountID string) (bool, string, string, error) {
 	resp, err := c.ocm.AccountsMgmt().V1().Organizations().Organization(orgID).
-		Labels().Labels(OCMRoleLabel).Get().Send()
+		Labels().Labels(OCMRoleLabel).Get().SendContext(c.context())
 	if err != nil && resp.Status() != 404 {
 		if resp.Status() == 403 {
 			return false, "", "", errors.Forbidden.UserErrorf("%v", err)
@@ -552,23 +559,24 @@ func CheckSupportedVersion(clusterVersion string, operatorVersion string) (bool,
 }
 
 func (c *Client) GetPolicies(policyType string) (map[string]*cmv1.AWSSTSPolicy, error) {
-
-	query := fmt.Sprintf("policy_type = '%s'", policyType)
-
 	m := make(map[string]*cmv1.AWSSTSPolicy)
-
-	stmt := c.ocm.ClustersMgmt().V1().AWSInquiries().STSPolicies().List()
-	if policyType != "" {
-		stmt = stmt.Search(query)
-	}
-	accountRolePoliciesResponse, err := stmt.Send()
+	policies, err := fetchList(c, stsPoliciesLookup, []string{policyType}, func() ([]*cmv1.AWSSTSPolicy, error) {
+		stmt := c.ocm.ClustersMgmt().V1().AWSInquiries().STSPolicies().List()
+		if policyType != "" {
+			stmt = stmt.Search(fmt.Sprintf("policy_type = '%s'", policyType))
+		}
+		accountRolePoliciesResponse, err := stmt.SendContext(c.context())
+		if err != nil {
+			return nil, handleErr(accountRolePoliciesResponse.Error(), err)
+		}
+		return accountRolePoliciesResponse.Items().Slice(), nil
+	})
 	if err != nil {
-		return m, handleErr(accountRolePoliciesResponse.Error(), err)
+		return m, err
 	}
-	accountRolePoliciesResponse.Items().Each(func(awsPolicy *cmv1.AWSSTSPolicy) bool {
+	for _, awsPolicy := range policies {
 		m[awsPolicy.ID()] = awsPolicy
-		return true
-	})
+	}
 	return m, nil
 }
 
@@ -595,21 +603,27 @@ func (c *Client) GetAllCredRequests() (map[string]*cmv1.STSOperator, error) {
 
 func (c *Client) GetCredRequests(isHypershift bool) (map[string]*cmv1.STSOperator, error) {
 	m := make(map[string]*cmv1.STSOperator)
-	stsCredentialResponse, err := c.ocm.ClustersMgmt().
-		V1().
-		AWSInquiries().
-		STSCredentialRequests().
-		List().
-		Parameter("is_hypershift", isHypershift).
-		Send()
+	credRequests, err := fetchList(c, credentialRequestsLookup, []string{hypershiftKey(isHypershift)},
+		func() ([]*cmv1.STSCredentialRequest, error) {
+			stsCredentialResponse, err := c.ocm.ClustersMgmt().
+				V1().
+				AWSInquiries().
+				STSCredentialRequests().
+				List().
+				Parameter("is_hypershift", isHypershift).
+				SendContext(c.context())
+			if err != nil {
+				return nil, handleErr(stsCredentialResponse.Error(), err)
+			}
+			return stsCredentialResponse.Items().Slice(), nil
+		})
 	if err != nil {
-		return m, handleErr(stsCredentialResponse.Error(), err)
+		return m, err
 	}
 
-	stsCredentialResponse.Items().Each(func(stsCredentialRequest *cmv1.STSCredentialRequest) bool {
+	for _, stsCredentialRequest := range credRequests {
 		m[stsCredentialRequest.Name()] = stsCredentialRequest.Operator()
-		return true
-	})
+	}
 	return m, nil
 }
 
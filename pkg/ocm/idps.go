@@ -27,8 +27,14 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalHTPasswdUser)
+	output.Register(cmv1.MarshalIdentityProvider)
+}
+
 const (
 	HTPasswdIDPType = "HTPasswd"
 	GithubIDPType   = "GitHub"
@@ -43,7 +49,7 @@ func (c *Client) GetIdentityProviders(clusterID string) ([]*cmv1.IdentityProvide
 		Clusters().Cluster(clusterID).
 		IdentityProviders().
 		List().Page(1).Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -56,7 +62,7 @@ func (c *Client) CreateIdentityProvider(clusterID string, idp *cmv1.IdentityProv
 		Clusters().Cluster(clusterID).
 		IdentityProviders().
 		Add().Body(idp).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -65,7 +71,7 @@ func (c *Client) CreateIdentityProvider(clusterID string, idp *cmv1.IdentityProv
 
 func (c *Client) GetHTPasswdUserList(clusterID, htpasswdIDPId string) (*cmv1.HTPasswdUserList, error) {
 	listResponse, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).
-		IdentityProviders().IdentityProvider(htpasswdIDPId).HtpasswdUsers().List().Send()
+		IdentityProviders().IdentityProvider(htpasswdIDPId).HtpasswdUsers().List().SendContext(c.context())
 	if err != nil {
 		if listResponse.Error().Status() == http.StatusNotFound {
 			return nil, nil
@@ -82,7 +88,7 @@ func (c *Client) AddHTPasswdUser(username, password, clusterID, idpID string) er
 	}
 	htpasswdUser, _ := cmv1.NewHTPasswdUser().Username(username).HashedPassword(hashedPwd).Build()
 	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).
-		IdentityProviders().IdentityProvider(idpID).HtpasswdUsers().Add().Body(htpasswdUser).Send()
+		IdentityProviders().IdentityProvider(idpID).HtpasswdUsers().Add().Body(htpasswdUser).SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -91,7 +97,7 @@ func (c *Client) AddHTPasswdUser(username, password, clusterID, idpID string) er
 
 func (c *Client) AddHTPasswdUsers(userList *cmv1.HTPasswdUserList, clusterID, idpID string) error {
 	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).
-		IdentityProviders().IdentityProvider(idpID).HtpasswdUsers().Import().Items(userList.Slice()).Send()
+		IdentityProviders().IdentityProvider(idpID).HtpasswdUsers().Import().Items(userList.Slice()).SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -102,7 +108,7 @@ func (c *Client) DeleteHTPasswdUser(username, clusterID string, htpasswdIDP *cmv
 	var userID string
 
 	listResponse, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).
-		IdentityProviders().IdentityProvider(htpasswdIDP.ID()).HtpasswdUsers().List().Send()
+		IdentityProviders().IdentityProvider(htpasswdIDP.ID()).HtpasswdUsers().List().SendContext(c.context())
 	if err != nil {
 		if listResponse.Error().Status() == http.StatusNotFound {
 			return nil
@@ -120,7 +126,7 @@ func (c *Client) DeleteHTPasswdUser(username, clusterID string, htpasswdIDP *cmv
 	}
 	deleteResponse, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).
 		IdentityProviders().IdentityProvider(htpasswdIDP.ID()).HtpasswdUsers().
-		HtpasswdUser(userID).Delete().Send()
+		HtpasswdUser(userID).Delete().SendContext(c.context())
 	if err != nil {
 		return handleErr(deleteResponse.Error(), err)
 	}
@@ -132,7 +138,7 @@ func (c *Client) DeleteIdentityProvider(clusterID string, idpID string) error {
 		Clusters().Cluster(clusterID).
 		IdentityProviders().IdentityProvider(idpID).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -16,7 +16,7 @@ func (c *Client) GetInflightChecks(clusterID string) (
 		response, err := collection.List().
 			Page(page).
 			Size(size).
-			Send()
+			SendContext(c.context())
 		if err != nil {
 			return nil, handleErr(response.Error(), err)
 		}
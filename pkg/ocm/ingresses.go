@@ -20,8 +20,14 @@ import (
 	"fmt"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalIngress)
+}
+
 func (c *Client) GetIngress(clusterId string, ingressKey string) (*cmv1.Ingress, error) {
 	ingresses, err := c.GetIngresses(clusterId)
 	if err != nil {
@@ -51,7 +57,7 @@ func (c *Client) GetIngresses(clusterID string) ([]*cmv1.Ingress, error) {
 		Clusters().Cluster(clusterID).
 		Ingresses().
 		List().Page(1).Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -64,7 +70,7 @@ func (c *Client) UpdateIngress(clusterID string, ingress *cmv1.Ingress) (*cmv1.I
 		Clusters().Cluster(clusterID).
 		Ingresses().Ingress(ingress.ID()).
 		Update().Body(ingress).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -76,7 +82,7 @@ func (c *Client) DeleteIngress(clusterID string, ingressID string) error {
 		Clusters().Cluster(clusterID).
 		Ingresses().Ingress(ingressID).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -6,15 +6,21 @@ import (
 	"net/http"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalKubeletConfig)
+}
+
 type KubeletConfigArgs struct {
 	PodPidsLimit int
 	Name         string
 }
 
 func (c *Client) GetClusterKubeletConfig(clusterID string) (*cmv1.KubeletConfig, bool, error) {
-	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).KubeletConfig().Get().Send()
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).KubeletConfig().Get().SendContext(c.context())
 
 	if response.Status() == http.StatusNotFound {
 		return nil, false, nil
@@ -81,7 +87,7 @@ func toOCMKubeletConfig(args KubeletConfigArgs) (*cmv1.KubeletConfig, error) {
 }
 
 func (c *Client) ListKubeletConfigNames(clusterId string) ([]string, error) {
-	configs, err := c.ListKubeletConfigs(context.Background(), clusterId)
+	configs, err := c.ListKubeletConfigs(c.context(), clusterId)
 	if err != nil {
 		return make([]string, 0), err
 	}
@@ -104,7 +110,7 @@ func (c *Client) CreateKubeletConfig(clusterID string, args KubeletConfigArgs) (
 	}
 
 	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).
-		KubeletConfig().Post().Body(kubeletConfig).Send()
+		KubeletConfig().Post().Body(kubeletConfig).SendContext(c.context())
 
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
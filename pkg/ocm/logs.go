@@ -35,7 +35,7 @@ func (c *Client) GetInstallLogs(clusterID string, tail int) (logs *cmv1.Log, err
 		Install()
 	response, err := logsClient.Get().
 		Parameter("tail", tail).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		err = handleErr(response.Error(), err)
 		if response.Status() == http.StatusNotFound {
@@ -54,7 +54,7 @@ func (c *Client) GetUninstallLogs(clusterID string, tail int) (logs *cmv1.Log, e
 		Uninstall()
 	response, err := logsClient.Get().
 		Parameter("tail", tail).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		err = handleErr(response.Error(), err)
 		if response.Status() == http.StatusNotFound {
@@ -67,7 +67,7 @@ func (c *Client) GetUninstallLogs(clusterID string, tail int) (logs *cmv1.Log, e
 }
 
 func (c *Client) PollInstallLogs(clusterID string, cb func(*cmv1.LogGetResponse) bool) (logs *cmv1.Log, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	ctx, cancel := context.WithTimeout(c.context(), time.Hour)
 	defer func() {
 		cancel()
 	}()
@@ -94,7 +94,7 @@ func (c *Client) PollInstallLogs(clusterID string, cb func(*cmv1.LogGetResponse)
 
 func (c *Client) PollUninstallLogs(clusterID string,
 	cb func(*cmv1.LogGetResponse) bool) (logs *cmv1.Log, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	ctx, cancel := context.WithTimeout(c.context(), time.Hour)
 	defer func() {
 		cancel()
 	}()
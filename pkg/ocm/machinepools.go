@@ -20,14 +20,20 @@ import (
 	"net/http"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalMachinePool)
+}
+
 func (c *Client) GetMachinePools(clusterID string) ([]*cmv1.MachinePool, error) {
 	response, err := c.ocm.ClustersMgmt().V1().
 		Clusters().Cluster(clusterID).
 		MachinePools().
 		List().Page(1).Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -40,7 +46,7 @@ func (c *Client) GetMachinePool(clusterID string, machinePoolID string) (*cmv1.M
 		MachinePools().
 		MachinePool(machinePoolID).
 		Get().
-		Send()
+		SendContext(c.context())
 	if response.Status() == http.StatusNotFound {
 		return nil, false, nil
 	}
@@ -55,7 +61,7 @@ func (c *Client) CreateMachinePool(clusterID string, machinePool *cmv1.MachinePo
 		Clusters().Cluster(clusterID).
 		MachinePools().
 		Add().Body(machinePool).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -66,7 +72,7 @@ func (c *Client) UpdateMachinePool(clusterID string, machinePool *cmv1.MachinePo
 		Clusters().Cluster(clusterID).
 		MachinePools().MachinePool(machinePool.ID()).
 		Update().Body(machinePool).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -78,7 +84,7 @@ func (c *Client) DeleteMachinePool(clusterID string, machinePoolID string) error
 		Clusters().Cluster(clusterID).
 		MachinePools().MachinePool(machinePoolID).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -25,8 +25,13 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalMachineType)
+}
+
 const AcceleratedComputing = "accelerated_computing"
 
 func (c *Client) GetMachineTypesInRegion(cloudProviderData *cmv1.CloudProviderData) (MachineTypeList, error) {
@@ -41,7 +46,7 @@ func (c *Client) GetMachineTypesInRegion(cloudProviderData *cmv1.CloudProviderDa
 			Body(cloudProviderData).
 			Page(page).
 			Size(size).
-			Send()
+			SendContext(c.context())
 		if err != nil {
 			return MachineTypeList{}, err
 		}
@@ -62,7 +67,21 @@ func (c *Client) GetMachineTypesInRegion(cloudProviderData *cmv1.CloudProviderDa
 	return machineTypes, nil
 }
 
-func (c *Client) GetMachineTypes() (machineTypes MachineTypeList, err error) {
+func (c *Client) GetMachineTypes() (MachineTypeList, error) {
+	items, err := fetchList(c, machineTypesLookup, nil, c.listMachineTypes)
+	if err != nil {
+		return MachineTypeList{}, err
+	}
+	machineTypes := MachineTypeList{}
+	for _, item := range items {
+		machineTypes.Items = append(machineTypes.Items, &MachineType{
+			MachineType: item,
+		})
+	}
+	return machineTypes, nil
+}
+
+func (c *Client) listMachineTypes() (machineTypes []*cmv1.MachineType, err error) {
 	collection := c.ocm.ClustersMgmt().V1().MachineTypes()
 	page := 1
 	size := 100
@@ -73,21 +92,16 @@ func (c *Client) GetMachineTypes() (machineTypes MachineTypeList, err error) {
 			Order("category asc").
 			Page(page).
 			Size(size).
-			Send()
+			SendContext(c.context())
 		if err != nil {
 			errMsg := response.Error().Reason()
 			if errMsg == "" {
 				errMsg = err.Error()
 			}
-			return MachineTypeList{}, errors.New(errMsg)
+			return nil, errors.New(errMsg)
 		}
 
-		response.Items().Each(func(item *cmv1.MachineType) bool {
-			machineTypes.Items = append(machineTypes.Items, &MachineType{
-				MachineType: item,
-			})
-			return true
-		})
+		machineTypes = append(machineTypes, response.Items().Slice()...)
 
 		if response.Size() < size {
 			break
@@ -168,7 +182,7 @@ func (c *Client) GetAvailableMachineTypes() (MachineTypeList, error) {
 func (c *Client) getQuotaCosts() (*amsv1.QuotaCostList, error) {
 	acctResponse, err := c.ocm.AccountsMgmt().V1().CurrentAccount().
 		Get().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(acctResponse.Error(), err)
 	}
@@ -181,7 +195,7 @@ func (c *Client) getQuotaCosts() (*amsv1.QuotaCostList, error) {
 		Parameter("search", "quota_id~='gpu'").
 		Page(1).
 		Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(quotaCostResponse.Error(), err)
 	}
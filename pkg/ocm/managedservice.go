@@ -7,8 +7,13 @@ import (
 	msv1 "github.com/openshift-online/ocm-sdk-go/servicemgmt/v1"
 
 	"github.com/openshift/rosa/pkg/fedramp"
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(msv1.MarshalManagedService)
+}
+
 var fedrampError = fmt.Errorf("managed services are not supported for FedRAMP clusters")
 
 type CreateManagedServiceArgs struct {
@@ -127,7 +132,7 @@ func (c *Client) CreateManagedService(args CreateManagedServiceArgs) (*msv1.Mana
 	serviceCall, err := c.ocm.ServiceMgmt().V1().Services().
 		Add().
 		Body(service).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(serviceCall.Error(), err)
 	}
@@ -144,7 +149,7 @@ func (c *Client) ListManagedServices(count int) (*msv1.ManagedServiceList, error
 		return nil, fmt.Errorf("invalid services count")
 	}
 
-	response, err := c.ocm.ServiceMgmt().V1().Services().List().Send()
+	response, err := c.ocm.ServiceMgmt().V1().Services().List().SendContext(c.context())
 	if err != nil {
 		return nil, fmt.Errorf("cannot retrieve services list: %w", err)
 	}
@@ -161,7 +166,7 @@ func (c *Client) GetManagedService(args DescribeManagedServiceArgs) (*msv1.Manag
 		return nil, fedrampError
 	}
 
-	response, err := c.ocm.ServiceMgmt().V1().Services().Service(args.ID).Get().Send()
+	response, err := c.ocm.ServiceMgmt().V1().Services().Service(args.ID).Get().SendContext(c.context())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get managed service with id %s: %w", args.ID, err)
 	}
@@ -180,7 +185,7 @@ func (c *Client) DeleteManagedService(args DeleteManagedServiceArgs) (*msv1.Mana
 	deleteResponse, err := c.ocm.ServiceMgmt().V1().Services().
 		Service(args.ID).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(deleteResponse.Error(), err)
 	}
@@ -215,7 +220,7 @@ func (c *Client) UpdateManagedService(args UpdateManagedServiceArgs) error {
 	serviceCall, err := c.ocm.ServiceMgmt().V1().Services().Service(args.ID).
 		Update().
 		Body(serviceSpec).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(serviceCall.Error(), err)
 	}
@@ -4,14 +4,20 @@ import (
 	"slices"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalNodePool)
+}
+
 func (c *Client) CreateNodePool(clusterID string, nodePool *cmv1.NodePool) (*cmv1.NodePool, error) {
 	response, err := c.ocm.ClustersMgmt().V1().
 		Clusters().Cluster(clusterID).
 		NodePools().
 		Add().Body(nodePool).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -45,7 +51,7 @@ func (c *Client) GetNodePools(clusterID string) ([]*cmv1.NodePool, error) {
 		Clusters().Cluster(clusterID).
 		NodePools().
 		List().Page(1).Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -58,7 +64,7 @@ func (c *Client) GetNodePool(clusterID string, nodePoolID string) (*cmv1.NodePoo
 		NodePools().
 		NodePool(nodePoolID).
 		Get().
-		Send()
+		SendContext(c.context())
 	if response.Status() == 404 {
 		return nil, false, nil
 	}
@@ -73,7 +79,7 @@ func (c *Client) UpdateNodePool(clusterID string, nodePool *cmv1.NodePool) (*cmv
 		Clusters().Cluster(clusterID).
 		NodePools().NodePool(nodePool.ID()).
 		Update().Body(nodePool).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -85,7 +91,7 @@ func (c *Client) DeleteNodePool(clusterID string, nodePoolID string) error {
 		Clusters().Cluster(clusterID).
 		NodePools().NodePool(nodePoolID).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -20,8 +20,14 @@ import (
 	"fmt"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalNodePoolUpgradePolicy)
+}
+
 func (c *Client) ScheduleNodePoolUpgrade(clusterID string, nodePoolId string,
 	upgradePolicy *cmv1.NodePoolUpgradePolicy) (*cmv1.NodePoolUpgradePolicy, error) {
 	if upgradePolicy == nil {
@@ -31,7 +37,7 @@ func (c *Client) ScheduleNodePoolUpgrade(clusterID string, nodePoolId string,
 		Clusters().Cluster(clusterID).NodePools().NodePool(nodePoolId).
 		UpgradePolicies().
 		Add().Body(upgradePolicy).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -53,7 +59,7 @@ func (c *Client) BuildNodeUpgradePolicy(version string, machinePoolID string,
 func (c *Client) CancelNodePoolUpgrade(clusterID, nodePoolID string, upgradeID string) (bool, error) {
 	response, err := c.ocm.ClustersMgmt().V1().
 		Clusters().Cluster(clusterID).NodePools().NodePool(nodePoolID).UpgradePolicies().
-		NodePoolUpgradePolicy(upgradeID).Delete().Send()
+		NodePoolUpgradePolicy(upgradeID).Delete().SendContext(c.context())
 	if err != nil {
 		return false, handleErr(response.Error(), err)
 	}
@@ -72,7 +78,7 @@ func (c *Client) getNodePoolUpgradePolicies(clusterID string, nodePoolID string)
 		response, err := collection.List().
 			Page(page).
 			Size(size).
-			Send()
+			SendContext(c.context())
 		if err != nil {
 			return nil, handleErr(response.Error(), err)
 		}
@@ -17,12 +17,18 @@ import (
 	"fmt"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalOidcConfig)
+}
+
 func (c *Client) GetOidcConfig(id string) (*cmv1.OidcConfig, error) {
 	response, err := c.ocm.ClustersMgmt().V1().
 		OidcConfigs().OidcConfig(id).Get().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -35,7 +41,7 @@ func (c *Client) ListOidcConfigs(awsAccountId string) ([]*cmv1.OidcConfig, error
 		OidcConfigs().
 		List().Page(1).Size(-1).
 		Parameter("search", fmt.Sprintf("aws.account_id='%s' or aws.account_id=''", awsAccountId)).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -47,7 +53,7 @@ func (c *Client) CreateOidcConfig(oidcConfig *cmv1.OidcConfig) (*cmv1.OidcConfig
 	response, err := c.ocm.ClustersMgmt().V1().
 		OidcConfigs().
 		Add().Body(oidcConfig).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -58,7 +64,7 @@ func (c *Client) DeleteOidcConfig(id string) error {
 	response, err := c.ocm.ClustersMgmt().V1().
 		OidcConfigs().OidcConfig(id).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -66,7 +72,7 @@ func (c *Client) DeleteOidcConfig(id string) error {
 }
 
 func (c *Client) FetchOidcThumbprint(oidcConfigInput *cmv1.OidcThumbprintInput) (*cmv1.OidcThumbprint, error) {
-	response, err := c.ocm.ClustersMgmt().V1().AWSInquiries().OidcThumbprint().Post().Body(oidcConfigInput).Send()
+	response, err := c.ocm.ClustersMgmt().V1().AWSInquiries().OidcThumbprint().Post().Body(oidcConfigInput).SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
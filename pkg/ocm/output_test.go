@@ -0,0 +1,26 @@
+package ocm
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
+)
+
+var _ = Describe("Output", func() {
+	It("Registers the marshallers of the OCM types", func() {
+		for _, resource := range []interface{}{
+			&cmv1.Cluster{},
+			[]*cmv1.KubeletConfig{},
+			[]*cmv1.TuningConfig{},
+			&cmv1.ClusterAutoscaler{},
+			[]*cmv1.UpgradePolicy{},
+			[]*cmv1.NodePoolUpgradePolicy{},
+			[]*cmv1.ControlPlaneUpgradePolicy{},
+			[]*cmv1.BreakGlassCredential{},
+		} {
+			Expect(output.IsRegistered(resource)).To(BeTrue(), "%T", resource)
+		}
+	})
+})
@@ -13,7 +13,7 @@ func (c *Client) GetTechnologyPreview(id string) (*cmv1.ProductTechnologyPreview
 		Products().Product(RosaProductId).
 		TechnologyPreviews().TechnologyPreview(id).
 		Get().
-		Send()
+		SendContext(c.context())
 	if response.Status() == 404 {
 		return nil, false, nil
 	}
@@ -27,8 +27,13 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/logging"
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalCloudRegion)
+}
+
 // GetFilteredRegionsByVersion fetches a list of regions. The 'version' argument is optional for filtering.
 func (c *Client) GetFilteredRegionsByVersion(roleARN string, version string,
 	awsClient aws.Client, externalID string) (regions []*cmv1.CloudRegion, err error) {
@@ -59,7 +64,7 @@ func (c *Client) getFilteredRegions(cloudProviderData *cmv1.CloudProviderData) (
 			Body(cloudProviderData).
 			Page(page).
 			Size(size).
-			Send()
+			SendContext(c.context())
 		if err != nil {
 			return []*cmv1.CloudRegion{}, err
 		}
@@ -116,6 +121,16 @@ func (c *Client) GetRegions(roleARN string, externalID string) (regions []*cmv1.
 		return nil, fmt.Errorf("Failed to build AWS credentials for user '%s': %v", aws.AdminUserName, err)
 	}
 
+	// Only the regions available to a role are cached, as the key can't contain access keys:
+	if roleARN == "" {
+		return c.searchAvailableRegions(awsCredentials)
+	}
+	return fetchList(c, availableRegionsLookup, []string{roleARN, externalID}, func() ([]*cmv1.CloudRegion, error) {
+		return c.searchAvailableRegions(awsCredentials)
+	})
+}
+
+func (c *Client) searchAvailableRegions(awsCredentials *cmv1.AWS) (regions []*cmv1.CloudRegion, err error) {
 	collection := c.ocm.ClustersMgmt().V1().
 		CloudProviders().
 		CloudProvider("aws").
@@ -128,7 +143,7 @@ func (c *Client) GetRegions(roleARN string, externalID string) (regions []*cmv1.
 			Page(page).
 			Size(size).
 			Body(awsCredentials).
-			Send()
+			SendContext(c.context())
 		if err != nil {
 			errMsg := response.Error().Reason()
 			if errMsg == "" {
@@ -176,9 +191,17 @@ func (c *Client) GetRegionList(multiAZ bool, roleARN string,
 }
 
 func (c *Client) GetDatabaseRegionList() ([]string, error) {
-	response, err := c.ocm.ClustersMgmt().V1().CloudProviders().CloudProvider("aws").Regions().List().Send()
+	supportedRegions, err := fetchList(c, regionsLookup, nil, c.listDatabaseRegions)
+	if err != nil {
+		return []string{}, err
+	}
+	return supportedRegions, nil
+}
+
+func (c *Client) listDatabaseRegions() ([]string, error) {
+	response, err := c.ocm.ClustersMgmt().V1().CloudProviders().CloudProvider("aws").Regions().List().SendContext(c.context())
 	if err != nil {
-		return []string{}, weberr.Errorf("Failed to get regions listing: %v", err)
+		return nil, weberr.Errorf("Failed to get regions listing: %v", err)
 	}
 	supportedRegions := []string{}
 	response.Items().Range(func(index int, item *cmv1.CloudRegion) bool {
@@ -26,7 +26,7 @@ func (c *Client) ListRolePolicyBindings(clusterID string, fetchCurrent bool) (*c
 		AWS().RolePolicyBindings().
 		List().FetchCurrent(fetchCurrent).
 		Page(1).Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
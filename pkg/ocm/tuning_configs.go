@@ -20,14 +20,20 @@ import (
 	"fmt"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalTuningConfig)
+}
+
 func (c *Client) GetTuningConfigs(clusterID string) ([]*cmv1.TuningConfig, error) {
 	response, err := c.ocm.ClustersMgmt().V1().
 		Clusters().Cluster(clusterID).
 		TuningConfigs().
 		List().Page(1).Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -40,7 +46,7 @@ func (c *Client) CreateTuningConfig(clusterID string, tuningConfig *cmv1.TuningC
 		Clusters().Cluster(clusterID).
 		TuningConfigs().
 		Add().Body(tuningConfig).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -52,7 +58,7 @@ func (c *Client) UpdateTuningConfig(clusterID string, tuningConfig *cmv1.TuningC
 		Clusters().Cluster(clusterID).
 		TuningConfigs().TuningConfig(tuningConfig.ID()).
 		Update().Body(tuningConfig).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -64,7 +70,7 @@ func (c *Client) DeleteTuningConfig(clusterID string, tuningConfigID string) err
 		Clusters().Cluster(clusterID).
 		TuningConfigs().TuningConfig(tuningConfigID).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -20,9 +20,15 @@ import (
 	"encoding/json"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
-	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalUpgradePolicy)
+	output.Register(cmv1.MarshalUpgradePolicyState)
+}
+
 func (c *Client) GetUpgradePolicies(clusterID string) (upgradePolicies []*cmv1.UpgradePolicy, err error) {
 	collection := c.ocm.ClustersMgmt().V1().
 		Clusters().
@@ -34,7 +40,7 @@ func (c *Client) GetUpgradePolicies(clusterID string) (upgradePolicies []*cmv1.U
 		response, err := collection.List().
 			Page(page).
 			Size(size).
-			Send()
+			SendContext(c.context())
 		if err != nil {
 			return nil, handleErr(response.Error(), err)
 		}
@@ -59,7 +65,7 @@ func (c *Client) GetScheduledUpgrade(clusterID string) (*cmv1.UpgradePolicy, *cm
 				UpgradePolicies().UpgradePolicy(upgradePolicy.ID()).
 				State().
 				Get().
-				Send()
+				SendContext(c.context())
 			if err != nil {
 				return nil, nil, err
 			}
@@ -76,7 +82,7 @@ func (c *Client) ScheduleUpgrade(clusterID string, upgradePolicy *cmv1.UpgradePo
 		Clusters().Cluster(clusterID).
 		UpgradePolicies().
 		Add().Body(upgradePolicy).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -92,7 +98,7 @@ func (c *Client) CancelUpgrade(clusterID string) (bool, error) {
 		Clusters().Cluster(clusterID).
 		UpgradePolicies().UpgradePolicy(scheduledUpgrade.ID()).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return false, handleErr(response.Error(), err)
 	}
@@ -103,7 +109,7 @@ func (c *Client) GetMissingGateAgreementsHypershift(
 	clusterID string,
 	upgradePolicy *cmv1.ControlPlaneUpgradePolicy) ([]*cmv1.VersionGate, error) {
 	response, err := c.ocm.ClustersMgmt().V1().Clusters().
-		Cluster(clusterID).ControlPlane().UpgradePolicies().Add().Parameter("dryRun", true).Body(upgradePolicy).Send()
+		Cluster(clusterID).ControlPlane().UpgradePolicies().Add().Parameter("dryRun", true).Body(upgradePolicy).SendContext(c.context())
 
 	if err != nil {
 		if response.Error() != nil {
@@ -122,8 +128,7 @@ func (c *Client) GetMissingGateAgreementsHypershift(
 			}
 			// return original error if invaild version gate detected
 			if len(gates) > 0 && gates[0].ID() == "" {
-				errType := errors.ErrorType(response.Error().Status())
-				return []*cmv1.VersionGate{}, errType.Set(errors.Errorf(response.Error().Reason()))
+				return []*cmv1.VersionGate{}, handleErr(response.Error(), err)
 			}
 			return gates, nil
 		}
@@ -135,7 +140,7 @@ func (c *Client) GetMissingGateAgreementsClassic(
 	clusterID string,
 	upgradePolicy *cmv1.UpgradePolicy) ([]*cmv1.VersionGate, error) {
 	response, err := c.ocm.ClustersMgmt().V1().Clusters().
-		Cluster(clusterID).UpgradePolicies().Add().Parameter("dryRun", true).Body(upgradePolicy).Send()
+		Cluster(clusterID).UpgradePolicies().Add().Parameter("dryRun", true).Body(upgradePolicy).SendContext(c.context())
 
 	if err != nil {
 		if response.Error() != nil {
@@ -154,8 +159,7 @@ func (c *Client) GetMissingGateAgreementsClassic(
 			}
 			// return original error if invaild version gate detected
 			if len(gates) > 0 && gates[0].ID() == "" {
-				errType := errors.ErrorType(response.Error().Status())
-				return []*cmv1.VersionGate{}, errType.Set(errors.Errorf(response.Error().Reason()))
+				return []*cmv1.VersionGate{}, handleErr(response.Error(), err)
 			}
 			return gates, nil
 		}
@@ -177,7 +181,7 @@ func (c *Client) AckVersionGate(
 		GateAgreements().
 		Add().
 		Body(agreement).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -20,15 +20,21 @@ import (
 	"net/http"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalUser)
+}
+
 func (c *Client) GetUser(clusterID string, group string, username string) (*cmv1.User, error) {
 	response, err := c.ocm.ClustersMgmt().V1().
 		Clusters().Cluster(clusterID).
 		Groups().Group(group).
 		Users().User(username).
 		Get().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		if response.Status() == http.StatusNotFound {
 			return nil, nil
@@ -45,7 +51,7 @@ func (c *Client) GetUsers(clusterID string, group string) ([]*cmv1.User, error)
 		Groups().Group(group).
 		Users().
 		List().Page(1).Size(-1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -59,7 +65,7 @@ func (c *Client) CreateUser(clusterID string, group string, user *cmv1.User) (*c
 		Groups().Group(group).
 		Users().
 		Add().Body(user).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -72,7 +78,7 @@ func (c *Client) DeleteUser(clusterID string, group string, username string) err
 		Groups().Group(group).
 		Users().User(username).
 		Delete().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
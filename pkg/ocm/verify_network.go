@@ -15,11 +15,17 @@ package ocm
 
 import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalSubnetNetworkVerification)
+}
+
 func (c *Client) GetVerifyNetworkSubnet(id string) (*cmv1.SubnetNetworkVerification, error) {
 	response, err := c.ocm.ClustersMgmt().V1().NetworkVerifications().
-		NetworkVerification(id).Get().Send()
+		NetworkVerification(id).Get().SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -37,7 +43,7 @@ func (c *Client) VerifyNetworkSubnets(awsAccountId string, region string,
 		Build()
 	response, err := c.ocm.ClustersMgmt().V1().NetworkVerifications().Add().
 		Body(body).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -51,7 +57,7 @@ func (c *Client) VerifyNetworkSubnetsByCluster(clusterId string, tags map[string
 		cmv1.NewCloudProviderData().AWS(cmv1.NewAWS().Tags(tags))).Build()
 	response, err := c.ocm.ClustersMgmt().V1().NetworkVerifications().Add().
 		Body(body).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -25,8 +25,14 @@ import (
 	ver "github.com/hashicorp/go-version"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	msv1 "github.com/openshift-online/ocm-sdk-go/servicemgmt/v1"
+
+	"github.com/openshift/rosa/pkg/output"
 )
 
+func init() {
+	output.Register(cmv1.MarshalVersion)
+}
+
 const (
 	CloseToEolDays                  = 60
 	OneDayHourDuration              = 24
@@ -53,7 +59,7 @@ func (c *Client) ManagedServiceVersionInquiry(serviceType string) (string, error
 	}
 	versionInquiryResponse, err := c.ocm.ServiceMgmt().V1().Services().VersionInquiry().Post().Body(
 		versionInquiryRequest,
-	).Send()
+	).SendContext(c.context())
 	if err != nil {
 		return "", fmt.Errorf("version inquiry call failed: %v", err)
 	}
@@ -69,6 +75,33 @@ func (c *Client) GetVersions(channelGroup string, defaultFirst bool) (versions [
 
 func (c *Client) GetVersionsWithProduct(product string, channelGroup string,
 	defaultFirst bool) (versions []*cmv1.Version, err error) {
+	versions, err = fetchList(c, versionsLookup, []string{product, channelGroup}, func() ([]*cmv1.Version, error) {
+		return c.listVersions(product, channelGroup)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort list in descending order
+	sort.Slice(versions, func(i, j int) bool {
+		if defaultFirst && versions[i].Default() {
+			return true
+		}
+		if defaultFirst && versions[j].Default() {
+			return false
+		}
+		a, erra := ver.NewVersion(versions[i].RawID())
+		b, errb := ver.NewVersion(versions[j].RawID())
+		if erra != nil || errb != nil {
+			return false
+		}
+		return a.GreaterThan(b)
+	})
+
+	return
+}
+
+func (c *Client) listVersions(product string, channelGroup string) (versions []*cmv1.Version, err error) {
 	collection := c.ocm.ClustersMgmt().V1().Versions()
 	page := 1
 	size := 100
@@ -87,7 +120,7 @@ func (c *Client) GetVersionsWithProduct(product string, channelGroup string,
 		if product != "" {
 			request.Parameter("product", product)
 		}
-		response, err = request.Send()
+		response, err = request.SendContext(c.context())
 		if err != nil {
 			return nil, handleErr(response.Error(), err)
 		}
@@ -97,23 +130,6 @@ func (c *Client) GetVersionsWithProduct(product string, channelGroup string,
 		}
 		page++
 	}
-
-	// Sort list in descending order
-	sort.Slice(versions, func(i, j int) bool {
-		if defaultFirst && versions[i].Default() {
-			return true
-		}
-		if defaultFirst && versions[j].Default() {
-			return false
-		}
-		a, erra := ver.NewVersion(versions[i].RawID())
-		b, errb := ver.NewVersion(versions[j].RawID())
-		if erra != nil || errb != nil {
-			return false
-		}
-		return a.GreaterThan(b)
-	})
-
 	return
 }
 
@@ -187,7 +203,7 @@ func (c *Client) GetAvailableUpgrades(versionID string) ([]string, error) {
 		Versions().
 		Version(versionID).
 		Get().
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return nil, handleErr(response.Error(), err)
 	}
@@ -201,7 +217,7 @@ func (c *Client) GetAvailableUpgrades(versionID string) ([]string, error) {
 			Versions().
 			Version(id).
 			Get().
-			Send()
+			SendContext(c.context())
 		if err != nil {
 			return nil, handleErr(response.Error(), err)
 		}
@@ -425,7 +441,7 @@ func (c *Client) IsVersionCloseToEol(daysAwayToCheck int, version string, channe
 		Search(filter).
 		Page(1).
 		Size(1).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return handleErr(response.Error(), err)
 	}
@@ -496,7 +512,7 @@ func (c *Client) ValidateHypershiftVersion(versionRawID, channelGroup string) (b
 		Page(1).
 		Size(1).
 		Parameter("product", HcpProduct).
-		Send()
+		SendContext(c.context())
 	if err != nil {
 		return false, handleErr(response.Error(), err)
 	}
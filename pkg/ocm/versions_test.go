@@ -14,6 +14,9 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/openshift-online/ocm-sdk-go/logging"
 	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/constants"
 )
 
 // nolint
@@ -114,6 +117,54 @@ var _ = Describe("Get version list", func() {
 			Expect(len(vs)).To(Equal(1))
 		})
 
+		It("Reads the version list through the cache", func() {
+			GinkgoT().Setenv(constants.OcmConfig, GinkgoT().TempDir())
+			service, err := cache.NewRosaCacheService()
+			Expect(err).ToNot(HaveOccurred())
+			ocmClient.cache = service
+			apiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK,
+					VersionsListResponse,
+				),
+			)
+
+			vs, err := ocmClient.GetVersionsWithProduct("", DefaultChannelGroup, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vs).To(HaveLen(1))
+
+			// The second call would fail if it was sent, as there are no more handlers:
+			vs, err = ocmClient.GetVersionsWithProduct("", DefaultChannelGroup, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vs).To(HaveLen(1))
+			Expect(vs[0].RawID()).To(Equal("4.14.9"))
+			Expect(apiServer.ReceivedRequests()).To(HaveLen(1))
+			Expect(service.Items()).To(HaveKey("versions/-/stable@" + apiServer.URL()))
+		})
+
+		It("Doesn't share the cached version list between accounts", func() {
+			GinkgoT().Setenv(constants.OcmConfig, GinkgoT().TempDir())
+			service, err := cache.NewRosaCacheService()
+			Expect(err).ToNot(HaveOccurred())
+			ocmClient.cache = service
+			apiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, VersionsListResponse),
+				RespondWithJSON(http.StatusOK, VersionsListResponse),
+			)
+
+			ocmClient.account = "first"
+			_, err = ocmClient.GetVersionsWithProduct("", DefaultChannelGroup, true)
+			Expect(err).ToNot(HaveOccurred())
+			ocmClient.account = "second"
+			_, err = ocmClient.GetVersionsWithProduct("", DefaultChannelGroup, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+			Expect(service.Items()).To(SatisfyAll(
+				HaveKey("versions/-/stable@first@"+apiServer.URL()),
+				HaveKey("versions/-/stable@second@"+apiServer.URL()),
+			))
+		})
+
 		It("Expects a valid Hypershift Version", func() {
 			apiServer.AppendHandlers(
 				RespondWithJSON(
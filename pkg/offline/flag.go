@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to implement the '--offline' command line option.
+
+package offline
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/cassette"
+)
+
+var enabled bool
+
+// readOnlyCommands are the commands, or groups of commands, that don't change data in OCM and that
+// can run in offline mode.
+var readOnlyCommands = []string{
+	"rosa cache clear",
+	"rosa cache list",
+	"rosa completion",
+	"rosa config",
+	"rosa describe",
+	"rosa docs",
+	"rosa help",
+	"rosa list",
+	"rosa logs",
+	"rosa version",
+	"rosa whoami",
+}
+
+// AddFlag adds the offline flag to the given set of command line flags.
+func AddFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&enabled,
+		"offline",
+		false,
+		"Answer the OCM requests of read-only commands with the responses saved by previous commands, "+
+			"without contacting OCM. Commands that change data refuse to run. The responses of 'rosa "+
+			"list clusters', 'rosa describe cluster', 'rosa list machinepools' and 'rosa list versions' "+
+			"are saved for seven days, and are also used, with a warning, when OCM can't be reached.",
+	)
+}
+
+// Enabled returns true if the OCM requests are answered with saved responses.
+func Enabled() bool {
+	return enabled
+}
+
+// SetEnabled sets the value of the offline flag.
+func SetEnabled(value bool) {
+	enabled = value
+}
+
+// Validate checks that the offline flag isn't combined with the recording flags, and that the
+// command doesn't change data in OCM.
+func Validate(command *cobra.Command) error {
+	if !enabled {
+		return nil
+	}
+	if cassette.Enabled() {
+		return fmt.Errorf("The '--offline' flag can't be used together with '--record' or '--replay'")
+	}
+	if !readOnly(command.CommandPath()) {
+		return fmt.Errorf("The '%s' command can change data in OCM, so it can't run with '--offline'",
+			command.CommandPath())
+	}
+	return nil
+}
+
+func readOnly(path string) bool {
+	for _, prefix := range readOnlyCommands {
+		if path == prefix || strings.HasPrefix(path, prefix+" ") {
+			return true
+		}
+	}
+	return false
+}
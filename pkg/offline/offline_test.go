@@ -0,0 +1,288 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package offline
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	"github.com/openshift/rosa/pkg/constants"
+)
+
+const clustersBody = `{"kind":"ClusterList","items":[{"kind":"Cluster","id":"123"}]}`
+
+var _ = Describe("Transport", func() {
+	var (
+		server   *httptest.Server
+		status   int
+		requests int
+		client   *http.Client
+	)
+
+	get := func(path string) (*http.Response, string, error) {
+		response, err := client.Get(server.URL + path)
+		if err != nil {
+			return nil, "", err
+		}
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return response, string(body), nil
+	}
+
+	BeforeEach(func() {
+		GinkgoT().Setenv(constants.OcmConfig, GinkgoT().TempDir())
+		reset()
+		SetEnabled(false)
+		status = http.StatusOK
+		requests = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(clustersBody))
+		}))
+		client = &http.Client{
+			Transport: Wrapper("1a2b3c")(http.DefaultTransport),
+		}
+	})
+
+	// finish simulates the end of the command, after which the next requests belong to a new one.
+	finish := func() {
+		cmdcontext.Finish(0)
+		reset()
+	}
+
+	AfterEach(func() {
+		server.Close()
+		SetEnabled(false)
+		finish()
+	})
+
+	It("Answers GET requests with saved responses in offline mode", func() {
+		_, _, err := get("/api/clusters_mgmt/v1/clusters?search=name+%3D+%27a%27")
+		Expect(err).NotTo(HaveOccurred())
+		finish()
+		SetEnabled(true)
+
+		response, body, err := get("/api/clusters_mgmt/v1/clusters?search=name+%3D+%27a%27")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(body).To(Equal(clustersBody))
+		Expect(requests).To(Equal(1))
+	})
+
+	It("Saves the responses when the command finishes", func() {
+		_, _, err := get("/api/clusters_mgmt/v1/versions")
+		Expect(err).NotTo(HaveOccurred())
+		reset()
+		SetEnabled(true)
+
+		_, _, err = get("/api/clusters_mgmt/v1/versions")
+		Expect(err).To(MatchError(ContainSubstring("There is no saved response")))
+	})
+
+	It("Doesn't answer with the saved responses of another account", func() {
+		_, _, err := get("/api/clusters_mgmt/v1/clusters?search=name+%3D+%27a%27")
+		Expect(err).NotTo(HaveOccurred())
+		finish()
+		SetEnabled(true)
+		client = &http.Client{
+			Transport: Wrapper("4d5e6f")(http.DefaultTransport),
+		}
+
+		_, _, err = get("/api/clusters_mgmt/v1/clusters?search=name+%3D+%27a%27")
+		Expect(err).To(MatchError(ContainSubstring("There is no saved response")))
+		Expect(requests).To(Equal(1))
+	})
+
+	It("Fails in offline mode if there is no saved response", func() {
+		SetEnabled(true)
+
+		_, _, err := get("/api/clusters_mgmt/v1/clusters?search=name+%3D+%27b%27")
+		Expect(err).To(MatchError(ContainSubstring(
+			"There is no saved response for '/api/clusters_mgmt/v1/clusters' to use in offline mode")))
+		Expect(requests).To(Equal(0))
+	})
+
+	It("Refuses to send requests that change data in offline mode", func() {
+		SetEnabled(true)
+
+		_, err := client.Post(server.URL+"/api/clusters_mgmt/v1/clusters", "application/json",
+			strings.NewReader("{}"))
+		Expect(err).To(MatchError(ContainSubstring(
+			"Can't send 'POST /api/clusters_mgmt/v1/clusters' in offline mode")))
+		Expect(requests).To(Equal(0))
+	})
+
+	It("Answers with the saved response when OCM is unavailable", func() {
+		_, _, err := get("/api/clusters_mgmt/v1/versions")
+		Expect(err).NotTo(HaveOccurred())
+		status = http.StatusServiceUnavailable
+
+		response, body, err := get("/api/clusters_mgmt/v1/versions")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(body).To(Equal(clustersBody))
+		Expect(requests).To(Equal(2))
+	})
+
+	It("Answers with the saved response when OCM can't be reached", func() {
+		_, _, err := get("/api/clusters_mgmt/v1/versions")
+		Expect(err).NotTo(HaveOccurred())
+		url := server.URL
+		server.Close()
+
+		response, err := client.Get(url + "/api/clusters_mgmt/v1/versions")
+		Expect(err).NotTo(HaveOccurred())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("Returns the error of OCM if there is no saved response", func() {
+		status = http.StatusServiceUnavailable
+
+		response, _, err := get("/api/clusters_mgmt/v1/versions")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+	})
+
+	It("Doesn't use saved responses for errors that aren't caused by OCM being unavailable", func() {
+		_, _, err := get("/api/clusters_mgmt/v1/clusters/123")
+		Expect(err).NotTo(HaveOccurred())
+		status = http.StatusNotFound
+
+		response, _, err := get("/api/clusters_mgmt/v1/clusters/123")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It("Doesn't save responses that aren't successful", func() {
+		status = http.StatusNotFound
+		_, _, err := get("/api/clusters_mgmt/v1/clusters/456")
+		Expect(err).NotTo(HaveOccurred())
+		finish()
+		SetEnabled(true)
+
+		_, _, err = get("/api/clusters_mgmt/v1/clusters/456")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Doesn't save credentials", func() {
+		_, _, err := get("/api/clusters_mgmt/v1/clusters/123/credentials")
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = get("/api/clusters_mgmt/v1/clusters/123/break_glass_credentials/456")
+		Expect(err).NotTo(HaveOccurred())
+		finish()
+		SetEnabled(true)
+
+		_, _, err = get("/api/clusters_mgmt/v1/clusters/123/credentials")
+		Expect(err).To(MatchError(ContainSubstring(
+			"Responses to '/api/clusters_mgmt/v1/clusters/123/credentials' aren't saved")))
+		_, _, err = get("/api/clusters_mgmt/v1/clusters/123/break_glass_credentials/456")
+		Expect(err).To(MatchError(ContainSubstring("aren't saved")))
+		Expect(requests).To(Equal(2))
+	})
+})
+
+var _ = Describe("Store", func() {
+	It("Writes the saved responses to the cache at once", func() {
+		service := cache.NewMockRosaCacheService(gomock.NewController(GinkgoT()))
+		store := &store{
+			service: service,
+			pending: map[string]snapshot{},
+		}
+		store.save("snapshot/a", snapshot{Body: []byte("1")})
+		store.save("snapshot/a", snapshot{Body: []byte("2")})
+		store.save("snapshot/b", snapshot{Body: []byte("3")})
+		value, ok := store.load("snapshot/a")
+		Expect(ok).To(BeTrue())
+		Expect(value.Body).To(Equal([]byte("2")))
+
+		service.EXPECT().SetAllWithTTL(gomock.Len(2), snapshotTTL).Return(nil).Times(1)
+		store.flush()
+		store.flush()
+	})
+
+	It("Reports the oldest saved response that was used", func() {
+		store := &store{}
+		now := time.Now()
+		store.used(snapshot{SavedAt: now.Add(-time.Hour)}, "OCM can't be reached")
+		Expect(store.oldest).To(Equal(now.Add(-time.Hour)))
+		store.used(snapshot{SavedAt: now.Add(-time.Minute)}, "OCM can't be reached")
+		Expect(store.oldest).To(Equal(now.Add(-time.Hour)))
+		store.used(snapshot{SavedAt: now.Add(-24 * time.Hour)}, "OCM can't be reached")
+		Expect(store.oldest).To(Equal(now.Add(-24 * time.Hour)))
+	})
+})
+
+var _ = Describe("Notice", func() {
+	It("Shows the age and the time of the saved data", func() {
+		savedAt := time.Now().Add(-90 * time.Minute)
+		Expect(notice(snapshot{SavedAt: savedAt}, "the '--offline' flag is set")).To(Equal(
+			"Showing OCM data saved 1h30m0s ago, at " + savedAt.UTC().Format(time.RFC3339) +
+				", because the '--offline' flag is set"))
+	})
+})
+
+var _ = Describe("Validate", func() {
+	command := func(path ...string) *cobra.Command {
+		parent := &cobra.Command{Use: "rosa"}
+		for _, name := range path {
+			child := &cobra.Command{Use: name}
+			parent.AddCommand(child)
+			parent = child
+		}
+		return parent
+	}
+
+	AfterEach(func() {
+		SetEnabled(false)
+	})
+
+	It("Accepts any command without the flag", func() {
+		Expect(Validate(command("create", "cluster"))).To(Succeed())
+	})
+
+	It("Accepts read-only commands", func() {
+		SetEnabled(true)
+		Expect(Validate(command("describe", "cluster"))).To(Succeed())
+		Expect(Validate(command("list", "clusters"))).To(Succeed())
+		Expect(Validate(command("list", "machinepools"))).To(Succeed())
+		Expect(Validate(command("list", "versions"))).To(Succeed())
+	})
+
+	It("Rejects commands that change data", func() {
+		SetEnabled(true)
+		Expect(Validate(command("create", "cluster"))).To(MatchError(
+			"The 'rosa create cluster' command can change data in OCM, so it can't run with '--offline'"))
+		Expect(Validate(command("cache", "warm"))).To(HaveOccurred())
+		Expect(Validate(command("describe-something"))).To(HaveOccurred())
+	})
+})
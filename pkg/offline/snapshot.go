@@ -0,0 +1,226 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package offline
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/openshift/rosa/pkg/cache"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+const (
+	// snapshotTTL is how long the responses are kept in the cache.
+	snapshotTTL = 7 * 24 * time.Hour
+
+	// maxSnapshotSize is the size of the largest response body that is saved, so that large
+	// downloads like logs don't make the cache file slow to read.
+	maxSnapshotSize = 1 << 20
+)
+
+// snapshotPaths are the paths of the responses that are saved: the ones read by 'rosa list
+// clusters', 'rosa describe cluster', 'rosa list machinepools' and 'rosa list versions'. Other
+// responses, like credentials, kubeconfigs and logs, are never saved.
+var snapshotPaths = []*regexp.Regexp{
+	regexp.MustCompile(`^/api/clusters_mgmt/v1/clusters(/[^/]+)?$`),
+	regexp.MustCompile(`^/api/clusters_mgmt/v1/clusters/[^/]+/(machine_pools|node_pools)(/[^/]+)?$`),
+	regexp.MustCompile(`^/api/clusters_mgmt/v1/clusters/[^/]+/(control_plane/)?upgrade_policies(/[^/]+(/state)?)?$`),
+	regexp.MustCompile(`^/api/clusters_mgmt/v1/clusters/[^/]+/(inflight_checks|limited_support_reasons)$`),
+	regexp.MustCompile(`^/api/clusters_mgmt/v1/clusters/[^/]+/aws/role_policy_bindings$`),
+	regexp.MustCompile(`^/api/clusters_mgmt/v1/versions(/[^/]+)?$`),
+	regexp.MustCompile(`^/api/accounts_mgmt/v1/subscriptions/[^/]+$`),
+}
+
+// saved returns true if the responses for the given path are saved.
+func saved(path string) bool {
+	for _, pattern := range snapshotPaths {
+		if pattern.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshot is a response to an OCM GET request saved in the cache.
+type snapshot struct {
+	SavedAt     time.Time `json:"saved_at"`
+	ContentType string    `json:"content_type,omitempty"`
+	Body        []byte    `json:"body"`
+}
+
+var snapshots = cache.Lookup[snapshot]{
+	Name: "snapshot",
+	TTL:  snapshotTTL,
+	Encode: func(value snapshot) ([]byte, error) {
+		return json.Marshal(value)
+	},
+	Decode: func(data []byte) (value snapshot, err error) {
+		err = json.Unmarshal(data, &value)
+		return
+	},
+}
+
+// store saves the responses to OCM GET requests in the cache when the command finishes, and warns
+// when a saved response is used instead of contacting OCM.
+type store struct {
+	service cache.RosaCacheService
+	pending map[string]snapshot
+
+	// oldest is the time when the oldest saved response used by the command was saved, or zero if
+	// none was used.
+	oldest time.Time
+}
+
+var (
+	lock   sync.Mutex
+	loaded bool
+	active *store
+)
+
+// current returns the store of the command, loading the cache the first time that it is needed. It
+// returns nil if the cache can't be loaded.
+func current() *store {
+	lock.Lock()
+	defer lock.Unlock()
+	if !loaded {
+		loaded = true
+		service, err := cache.NewRosaCacheService()
+		if err == nil {
+			active = &store{
+				service: service,
+				pending: map[string]snapshot{},
+			}
+			store := active
+			cmdcontext.OnExit(func(int) {
+				store.flush()
+			})
+		}
+	}
+	return active
+}
+
+func reset() {
+	lock.Lock()
+	defer lock.Unlock()
+	loaded = false
+	active = nil
+}
+
+// snapshotKey returns the cache key of the responses to GET requests of the given account for the
+// given URL, for example 'snapshot/api/clusters_mgmt/v1/clusters?search=...@1a2b3c@https://api.openshift.com'.
+// Like the other cached values, the responses of one account are never used for another.
+func snapshotKey(account string, address *url.URL) string {
+	path := strings.TrimPrefix(address.Path, "/")
+	if address.RawQuery != "" {
+		path += "?" + address.RawQuery
+	}
+	scope := address.Scheme + "://" + address.Host
+	if account != "" {
+		scope = account + "@" + scope
+	}
+	return snapshots.Key(scope, path)
+}
+
+func (s *store) load(key string) (snapshot, bool) {
+	lock.Lock()
+	value, ok := s.pending[key]
+	lock.Unlock()
+	if ok {
+		return value, true
+	}
+	data, ok := s.service.Get(key)
+	if !ok {
+		return snapshot{}, false
+	}
+	bytes, ok := data.([]byte)
+	if !ok {
+		return snapshot{}, false
+	}
+	value, err := snapshots.Decode(bytes)
+	return value, err == nil
+}
+
+// save adds the response to the ones that are stored in the cache when the command finishes, so
+// that commands that poll or read many pages write the cache file only once.
+func (s *store) save(key string, value snapshot) {
+	lock.Lock()
+	defer lock.Unlock()
+	s.pending[key] = value
+}
+
+// flush stores the saved responses in the cache. The saved responses are only a fallback, so failing
+// to store them isn't an error.
+func (s *store) flush() {
+	lock.Lock()
+	pending := s.pending
+	s.pending = map[string]snapshot{}
+	lock.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+	values := make(map[string]interface{}, len(pending))
+	for key, value := range pending {
+		data, err := snapshots.Encode(value)
+		if err != nil {
+			continue
+		}
+		values[key] = data
+	}
+	_ = s.service.SetAllWithTTL(values, snapshots.TTL)
+}
+
+// used warns about how old the data shown by the command is and why, when a saved response is used
+// instead of a response from OCM. Responses that aren't older than the ones already reported don't
+// repeat the warning, so the last warning always shows the age of the oldest data.
+func (s *store) used(value snapshot, reason string) {
+	lock.Lock()
+	defer lock.Unlock()
+	if !s.oldest.IsZero() && !value.SavedAt.Before(s.oldest) {
+		return
+	}
+	s.oldest = value.SavedAt
+	reporter.CreateReporter().Warnf("%s", notice(value, reason))
+}
+
+func notice(value snapshot, reason string) string {
+	return fmt.Sprintf("Showing OCM data saved %s ago, at %s, because %s",
+		time.Since(value.SavedAt).Round(time.Second), value.SavedAt.UTC().Format(time.RFC3339), reason)
+}
+
+// Token returns the access token used by the OCM connection in offline mode. It is never sent to
+// OCM, so it doesn't need to be signed by it, but it doesn't expire during the command, so that the
+// connection doesn't try to refresh it.
+func Token() (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"typ":                "Bearer",
+		"iat":                now.Unix(),
+		"exp":                now.Add(time.Hour).Unix(),
+		"preferred_username": "offline",
+		"username":           "offline",
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("offline"))
+}
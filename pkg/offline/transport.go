@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package offline
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Wrapper returns a transport wrapper that saves the responses to the OCM GET requests of the
+// read-only commands, and that answers them with the saved responses when the offline flag is set or
+// when OCM can't be reached. In offline mode the other requests fail without being sent. The saved
+// responses belong to the given account, so that users sharing the cache never see each other's
+// data. It should be the last transport wrapper, so that the others see the requests answered with
+// saved responses too.
+func Wrapper(account string) func(http.RoundTripper) http.RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &transport{
+			next:    next,
+			account: account,
+		}
+	}
+}
+
+type transport struct {
+	next    http.RoundTripper
+	account string
+}
+
+func (t *transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Method != http.MethodGet {
+		if enabled {
+			return nil, fmt.Errorf("Can't send '%s %s' in offline mode, as it would change data in OCM",
+				request.Method, request.URL.Path)
+		}
+		return t.next.RoundTrip(request)
+	}
+	if !saved(request.URL.Path) {
+		if enabled {
+			return nil, fmt.Errorf("Responses to '%s' aren't saved, so it can't be read in offline mode",
+				request.URL.Path)
+		}
+		return t.next.RoundTrip(request)
+	}
+	store := current()
+	if store == nil {
+		if enabled {
+			return nil, fmt.Errorf("The saved OCM responses can't be read in offline mode")
+		}
+		return t.next.RoundTrip(request)
+	}
+	key := snapshotKey(t.account, request.URL)
+
+	if enabled {
+		saved, ok := store.load(key)
+		if !ok {
+			return nil, fmt.Errorf("There is no saved response for '%s' to use in offline mode, run the "+
+				"command without '--offline' while OCM can be reached to save it", request.URL.Path)
+		}
+		store.used(saved, "the '--offline' flag is set")
+		return saved.response(request), nil
+	}
+
+	response, err := t.next.RoundTrip(request)
+	if reason := unreachable(request, response, err); reason != "" {
+		saved, ok := store.load(key)
+		if !ok {
+			return response, err
+		}
+		if response != nil {
+			response.Body.Close()
+		}
+		store.used(saved, reason)
+		return saved.response(request), nil
+	}
+	if err == nil && response.StatusCode == http.StatusOK {
+		response.Body, err = keep(store, key, response)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// unreachable returns the reason why OCM couldn't answer the request, or an empty string if it
+// answered it or if the command itself stopped it.
+func unreachable(request *http.Request, response *http.Response, err error) string {
+	if request.Context().Err() != nil {
+		return ""
+	}
+	if err != nil {
+		return fmt.Sprintf("OCM can't be reached: %v", err)
+	}
+	switch response.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return fmt.Sprintf("OCM responded with status %d", response.StatusCode)
+	}
+	return ""
+}
+
+// keep reads the body of the response, saves it in the store if it isn't too large, and returns a
+// replacement for the body that was read.
+func keep(store *store, key string, response *http.Response) (io.ReadCloser, error) {
+	defer response.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxSnapshotSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxSnapshotSize {
+		rest, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+		return io.NopCloser(bytes.NewReader(append(body, rest...))), nil
+	}
+	store.save(key, snapshot{
+		SavedAt:     time.Now(),
+		ContentType: response.Header.Get("Content-Type"),
+		Body:        body,
+	})
+	return io.NopCloser(bytes.NewReader(body)), nil
+}
+
+func (s snapshot) response(request *http.Request) *http.Response {
+	header := http.Header{}
+	if s.ContentType != "" {
+		header.Set("Content-Type", s.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", http.StatusOK, http.StatusText(http.StatusOK)),
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(s.Body)),
+		ContentLength: int64(len(s.Body)),
+		Request:       request,
+	}
+}
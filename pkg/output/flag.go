@@ -27,13 +27,18 @@ import (
 const (
 	JSON           = "json"
 	YAML           = "yaml"
+	NAME           = "name"
+	CSV            = "csv"
+	JSONPATH       = "jsonpath"
+	GO_TEMPLATE    = "go-template"
+	CUSTOM_COLUMNS = "custom-columns"
 	FLAG_NAME      = "output"
 	FLAG_SHORTHAND = "o"
 )
 
 var o string
 
-var formats = []string{JSON, YAML}
+var formats = []string{JSON, YAML, NAME, CSV, JSONPATH + "=", GO_TEMPLATE + "=", CUSTOM_COLUMNS + "="}
 
 // AddFlag adds the interactive flag to the given set of command line flags.
 func AddFlag(cmd *cobra.Command) {
@@ -42,7 +47,8 @@ func AddFlag(cmd *cobra.Command) {
 		FLAG_NAME,
 		FLAG_SHORTHAND,
 		"",
-		fmt.Sprintf("Output format. Allowed formats are %s", formats),
+		fmt.Sprintf("Output format. Allowed formats are %s. The csv format accepts an optional list of "+
+			"columns, as custom-columns does", formats),
 	)
 
 	cmd.RegisterFlagCompletionFunc(FLAG_NAME, completion)
@@ -28,13 +28,15 @@ var _ = Describe("Output flag", func() {
 		Expect(flag.Name).To(Equal(FLAG_NAME))
 		Expect(flag.Shorthand).To(Equal(FLAG_SHORTHAND))
 		Expect(flag.Value.String()).To(Equal(""))
-		Expect(flag.Usage).To(Equal("Output format. Allowed formats are [json yaml]"))
+		Expect(flag.Usage).To(Equal("Output format. Allowed formats are [json yaml name csv jsonpath= " +
+			"go-template= custom-columns=]. The csv format accepts an optional list of columns, as " +
+			"custom-columns does"))
 	})
 
 	It("Has a completion function", func() {
 		args, directive := completion(nil, nil, "")
-		Expect(len(args)).To(Equal(2))
-		Expect(args).To(ContainElements(JSON, YAML))
+		Expect(len(args)).To(Equal(7))
+		Expect(args).To(ContainElements(JSON, YAML, NAME, CSV, "jsonpath=", "go-template=", "custom-columns="))
 
 		Expect(directive).To(Equal(cobra.ShellCompDirectiveDefault))
 	})
@@ -0,0 +1,308 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the output formats that extract or reshape the fields of the JSON
+// representation of a resource: jsonpath, go-template, custom-columns, csv and name.
+
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"gitlab.com/c0b/go-ordered-json"
+)
+
+// decode returns the generic representation of a JSON document. Lists are wrapped in an object
+// with an 'items' field, as kubectl does, so that templates can use '.items' for any list.
+func decode(body []byte) (interface{}, error) {
+	var data interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err := decoder.Decode(&data)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode resource: %v", err)
+	}
+	if items, ok := data.([]interface{}); ok {
+		return map[string]interface{}{"items": items}, nil
+	}
+	return data, nil
+}
+
+// rows returns the items of a list, or the resource itself when it isn't a list.
+func rows(data interface{}) []interface{} {
+	if object, ok := data.(map[string]interface{}); ok && len(object) == 1 {
+		if items, ok := object["items"].([]interface{}); ok {
+			return items
+		}
+	}
+	return []interface{}{data}
+}
+
+func printJSONPath(body []byte, arg string) (string, error) {
+	if arg == "" {
+		return "", fmt.Errorf("Missing template, use '-o %s=<template>'", JSONPATH)
+	}
+	jsonPath, err := ParseJSONPath(arg)
+	if err != nil {
+		return "", err
+	}
+	data, err := decode(body)
+	if err != nil {
+		return "", err
+	}
+	result, err := jsonPath.Execute(data)
+	if err != nil {
+		return "", err
+	}
+	return ensureNewline(result), nil
+}
+
+func printGoTemplate(body []byte, arg string) (string, error) {
+	if arg == "" {
+		return "", fmt.Errorf("Missing template, use '-o %s=<template>'", GO_TEMPLATE)
+	}
+	tmpl, err := template.New(GO_TEMPLATE).Option("missingkey=zero").Parse(arg)
+	if err != nil {
+		return "", fmt.Errorf("Invalid template: %v", err)
+	}
+	data, err := decode(body)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	err = tmpl.Execute(&b, data)
+	if err != nil {
+		return "", fmt.Errorf("Failed to execute template: %v", err)
+	}
+	return ensureNewline(b.String()), nil
+}
+
+type customColumn struct {
+	header string
+	path   *JSONPath
+}
+
+// parseCustomColumns parses a list of columns like 'ID:.id,STATE:.state'. The braces around the
+// expressions are optional.
+func parseCustomColumns(spec string) ([]customColumn, error) {
+	columns := []customColumn{}
+	for _, column := range strings.Split(spec, ",") {
+		header, expression, found := strings.Cut(column, ":")
+		if !found || strings.TrimSpace(header) == "" || strings.TrimSpace(expression) == "" {
+			return nil, fmt.Errorf("Invalid column '%s', expected '<HEADER>:<JSONPath expression>'", column)
+		}
+		expression = strings.TrimSpace(expression)
+		if !strings.HasPrefix(expression, "{") {
+			expression = fmt.Sprintf("{%s}", expression)
+		}
+		path, err := ParseJSONPath(expression)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, customColumn{header: strings.TrimSpace(header), path: path})
+	}
+	return columns, nil
+}
+
+// value returns the values selected by the column, separated by commas.
+func (c *customColumn) value(row interface{}, missing string) (string, error) {
+	values, err := c.path.Values(row)
+	if err != nil {
+		return "", err
+	}
+	if len(values) == 0 {
+		return missing, nil
+	}
+	texts := make([]string, 0, len(values))
+	for _, value := range values {
+		texts = append(texts, formatValue(value))
+	}
+	return strings.Join(texts, ","), nil
+}
+
+func printCustomColumns(body []byte, arg string) (string, error) {
+	if arg == "" {
+		return "", fmt.Errorf("Missing columns, use '-o %s=<HEADER>:<JSONPath expression>,...'", CUSTOM_COLUMNS)
+	}
+	columns, err := parseCustomColumns(arg)
+	if err != nil {
+		return "", err
+	}
+	data, err := decode(body)
+	if err != nil {
+		return "", err
+	}
+
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	headers := make([]string, 0, len(columns))
+	for _, column := range columns {
+		headers = append(headers, column.header)
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	for _, row := range rows(data) {
+		values := make([]string, 0, len(columns))
+		for _, column := range columns {
+			value, err := column.value(row, "<none>")
+			if err != nil {
+				return "", err
+			}
+			values = append(values, value)
+		}
+		fmt.Fprintf(writer, "%s\n", strings.Join(values, "\t"))
+	}
+	err = writer.Flush()
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// printCSV prints the selected columns, or every top level field when no column is given.
+func printCSV(body []byte, arg string) (string, error) {
+	data, err := decode(body)
+	if err != nil {
+		return "", err
+	}
+	items := rows(data)
+
+	var columns []customColumn
+	if arg != "" {
+		columns, err = parseCustomColumns(arg)
+	} else {
+		columns, err = fieldColumns(body)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var b bytes.Buffer
+	writer := csv.NewWriter(&b)
+	headers := make([]string, 0, len(columns))
+	for _, column := range columns {
+		headers = append(headers, column.header)
+	}
+	err = writer.Write(headers)
+	if err != nil {
+		return "", err
+	}
+	for _, row := range items {
+		values := make([]string, 0, len(columns))
+		for _, column := range columns {
+			value, err := column.value(row, "")
+			if err != nil {
+				return "", err
+			}
+			values = append(values, value)
+		}
+		err = writer.Write(values)
+		if err != nil {
+			return "", err
+		}
+	}
+	writer.Flush()
+	return b.String(), writer.Error()
+}
+
+// fieldColumns returns a column for every top level field of the resources, in the order they
+// first appear in the document.
+func fieldColumns(body []byte) ([]customColumn, error) {
+	var objects []json.RawMessage
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+		err := json.Unmarshal(body, &objects)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to decode resource: %v", err)
+		}
+	} else {
+		objects = []json.RawMessage{body}
+	}
+
+	columns := []customColumn{}
+	seen := map[string]bool{}
+	for _, object := range objects {
+		fields := ordered.NewOrderedMap()
+		if json.Unmarshal(object, fields) != nil {
+			continue
+		}
+		iter := fields.EntriesIter()
+		for {
+			pair, ok := iter()
+			if !ok {
+				break
+			}
+			if seen[pair.Key] {
+				continue
+			}
+			seen[pair.Key] = true
+			path := &JSONPath{nodes: []*jsonPathNode{{
+				nodeType: pathNode,
+				path: &jsonPathExpression{
+					segments: []jsonPathSegment{{segmentType: fieldSegment, field: pair.Key}},
+				},
+			}}}
+			columns = append(columns, customColumn{header: pair.Key, path: path})
+		}
+	}
+	return columns, nil
+}
+
+// Fields used, in order of preference, to name a resource
+var nameFields = []string{"id", "name", "RoleName", "RoleARN", "Arn"}
+
+// printName prints the name of every resource, prefixed by its kind when it has one, for example
+// 'cluster/2a3b4c'.
+func printName(body []byte) (string, error) {
+	data, err := decode(body)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, row := range rows(data) {
+		object, ok := row.(map[string]interface{})
+		if !ok {
+			b.WriteString(formatValue(row) + "\n")
+			continue
+		}
+		name := ""
+		for _, field := range nameFields {
+			if value, ok := object[field]; ok && formatValue(value) != "" {
+				name = formatValue(value)
+				break
+			}
+		}
+		if name == "" {
+			continue
+		}
+		if kind := formatValue(object["kind"]); kind != "" {
+			name = fmt.Sprintf("%s/%s", strings.ToLower(kind), name)
+		}
+		b.WriteString(name + "\n")
+	}
+	return b.String(), nil
+}
+
+func ensureNewline(text string) string {
+	if text == "" || strings.HasSuffix(text, "\n") {
+		return text
+	}
+	return text + "\n"
+}
@@ -0,0 +1,88 @@
+package output
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Output formats", func() {
+	var body bytes.Buffer
+
+	BeforeEach(func() {
+		first, err := cmv1.NewCluster().ID("a1").Name("first").State(cmv1.ClusterStateReady).
+			Region(cmv1.NewCloudRegion().ID("us-east-1")).Build()
+		Expect(err).NotTo(HaveOccurred())
+		second, err := cmv1.NewCluster().ID("b2").Name("second").State(cmv1.ClusterStateInstalling).Build()
+		Expect(err).NotTo(HaveOccurred())
+		body.Reset()
+		Expect(cmv1.MarshalClusterList([]*cmv1.Cluster{first, second}, &body)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		SetOutput("")
+	})
+
+	format := func(output string) (string, error) {
+		SetOutput(output)
+		return parseResource(body)
+	}
+
+	It("Prints a JSONPath template", func() {
+		result, err := format(`jsonpath={.items[*].id}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("a1 b2\n"))
+
+		result, err = format(`jsonpath={range .items[*]}{.name}{"\t"}{.region.id}{"\n"}{end}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("first\tus-east-1\nsecond\t\n"))
+
+		result, err = format(`jsonpath={.items[?(@.state=="ready")].name}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("first\n"))
+	})
+
+	It("Prints a Go template", func() {
+		result, err := format(`go-template={{range .items}}{{.id}}={{.state}} {{end}}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("a1=ready b2=installing \n"))
+	})
+
+	It("Prints custom columns", func() {
+		result, err := format("custom-columns=ID:.id,REGION:{.region.id}")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("ID  REGION\na1  us-east-1\nb2  <none>\n"))
+	})
+
+	It("Prints every field as CSV", func() {
+		result, err := format(CSV)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("kind,id,name,region,state\n" +
+			"Cluster,a1,first,\"{\"\"id\"\":\"\"us-east-1\"\",\"\"kind\"\":\"\"CloudRegion\"\"}\",ready\n" +
+			"Cluster,b2,second,,installing\n"))
+	})
+
+	It("Prints selected columns as CSV", func() {
+		result, err := format("csv=id:.id,name:.name")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("id,name\na1,first\nb2,second\n"))
+	})
+
+	It("Prints names", func() {
+		result, err := format(NAME)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("cluster/a1\ncluster/b2\n"))
+	})
+
+	It("Fails without a template", func() {
+		_, err := format(JSONPATH)
+		Expect(err).To(MatchError("Missing template, use '-o jsonpath=<template>'"))
+	})
+
+	It("Fails with an argument for json", func() {
+		_, err := format("json=.id")
+		Expect(err).To(MatchError("Format 'json' doesn't accept arguments"))
+	})
+})
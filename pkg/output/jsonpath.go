@@ -0,0 +1,538 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains a JSONPath template engine, compatible with the subset of the kubectl syntax
+// that is useful for ROSA resources:
+//
+//	{.items[*].id}                               fields, indexes, slices and wildcards
+//	{.items[?(@.state=="ready")].name}           filters comparing a field with a literal
+//	{range .items[*]}{.id}{"\t"}{.name}{"\n"}{end}   iteration over results
+//
+// Missing fields produce no output instead of an error, as with 'kubectl get -o jsonpath'. Other
+// syntax, like recursive descent with '..' or comparisons other than '==' and '!=', is rejected.
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type nodeType int
+
+const (
+	textNode nodeType = iota
+	pathNode
+	rangeNode
+)
+
+type jsonPathNode struct {
+	nodeType nodeType
+	// text is the literal text of a text node
+	text string
+	// path is evaluated by path and range nodes
+	path *jsonPathExpression
+	// children are the nodes repeated for every result of a range node
+	children []*jsonPathNode
+}
+
+// JSONPath is a parsed JSONPath template.
+type JSONPath struct {
+	nodes []*jsonPathNode
+}
+
+// ParseJSONPath parses a template made of literal text and expressions between braces.
+func ParseJSONPath(template string) (*JSONPath, error) {
+	root := &jsonPathNode{}
+	stack := []*jsonPathNode{root}
+	for len(template) > 0 {
+		start := strings.Index(template, "{")
+		if start < 0 {
+			start = len(template)
+		}
+		current := stack[len(stack)-1]
+		if start > 0 {
+			current.children = append(current.children, &jsonPathNode{nodeType: textNode, text: template[:start]})
+			template = template[start:]
+			continue
+		}
+		end := closingIndex(template, '{', '}')
+		if end < 0 {
+			return nil, fmt.Errorf("Unclosed action in JSONPath template '%s'", template)
+		}
+		action := strings.TrimSpace(template[1:end])
+		template = template[end+1:]
+
+		switch {
+		case action == "end":
+			if len(stack) == 1 {
+				return nil, fmt.Errorf("Unexpected 'end' in JSONPath template")
+			}
+			stack = stack[:len(stack)-1]
+		case strings.HasPrefix(action, "range "):
+			path, err := parseJSONPathExpression(strings.TrimSpace(strings.TrimPrefix(action, "range ")))
+			if err != nil {
+				return nil, err
+			}
+			node := &jsonPathNode{nodeType: rangeNode, path: path}
+			current.children = append(current.children, node)
+			stack = append(stack, node)
+		case strings.HasPrefix(action, "\""):
+			text, err := strconv.Unquote(action)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid string %s in JSONPath template: %v", action, err)
+			}
+			current.children = append(current.children, &jsonPathNode{nodeType: textNode, text: text})
+		default:
+			path, err := parseJSONPathExpression(action)
+			if err != nil {
+				return nil, err
+			}
+			current.children = append(current.children, &jsonPathNode{nodeType: pathNode, path: path})
+		}
+	}
+	if len(stack) > 1 {
+		return nil, fmt.Errorf("Missing 'end' for 'range' in JSONPath template")
+	}
+	return &JSONPath{nodes: root.children}, nil
+}
+
+// Execute renders the template with the given data, which is the result of decoding a JSON
+// document.
+func (j *JSONPath) Execute(data interface{}) (string, error) {
+	var b strings.Builder
+	err := executeNodes(&b, j.nodes, data, data)
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// Values returns the values selected by a template made of a single expression, as used by custom
+// columns.
+func (j *JSONPath) Values(data interface{}) ([]interface{}, error) {
+	if len(j.nodes) != 1 || j.nodes[0].nodeType != pathNode {
+		return nil, fmt.Errorf("Expected a single JSONPath expression")
+	}
+	return j.nodes[0].path.evaluate(data, data)
+}
+
+func executeNodes(b *strings.Builder, nodes []*jsonPathNode, root interface{}, current interface{}) error {
+	for _, node := range nodes {
+		switch node.nodeType {
+		case textNode:
+			b.WriteString(node.text)
+		case pathNode:
+			values, err := node.path.evaluate(root, current)
+			if err != nil {
+				return err
+			}
+			texts := make([]string, 0, len(values))
+			for _, value := range values {
+				texts = append(texts, formatValue(value))
+			}
+			b.WriteString(strings.Join(texts, " "))
+		case rangeNode:
+			values, err := node.path.evaluate(root, current)
+			if err != nil {
+				return err
+			}
+			for _, value := range values {
+				err = executeNodes(b, node.children, root, value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// formatValue returns the text of a scalar, or the compact JSON representation of an object or an
+// array.
+func formatValue(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	case bool:
+		return strconv.FormatBool(typed)
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	}
+	result, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(result)
+}
+
+type segmentType int
+
+const (
+	fieldSegment segmentType = iota
+	indexSegment
+	sliceSegment
+	wildcardSegment
+	filterSegment
+)
+
+type jsonPathSegment struct {
+	segmentType segmentType
+	field       string
+	index       int
+	// start and end of a slice, nil when omitted
+	start, end *int
+	filter     *jsonPathFilter
+}
+
+type jsonPathFilter struct {
+	path *jsonPathExpression
+	// operator is empty when the filter only checks that the path exists
+	operator string
+	value    string
+}
+
+type jsonPathExpression struct {
+	// fromRoot is set for expressions starting with '$', the others are relative to the current
+	// value
+	fromRoot bool
+	segments []jsonPathSegment
+}
+
+// invalidFieldCharacters can't be part of the name of a field, they are operators or other syntax
+// that isn't supported.
+const invalidFieldCharacters = " \t=!<>()@$'\"*,"
+
+func parseJSONPathExpression(text string) (*jsonPathExpression, error) {
+	expression := &jsonPathExpression{}
+	rest := text
+	switch {
+	case strings.HasPrefix(rest, "$"):
+		expression.fromRoot = true
+		rest = rest[1:]
+	case strings.HasPrefix(rest, "@"):
+		rest = rest[1:]
+	case rest == "" || (rest[0] != '.' && rest[0] != '['):
+		return nil, fmt.Errorf("Invalid JSONPath expression '%s', it should start with '.'", text)
+	}
+
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			if strings.HasPrefix(rest, ".") {
+				return nil, fmt.Errorf("Invalid JSONPath expression '%s', recursive descent with '..' isn't supported",
+					text)
+			}
+			if strings.HasPrefix(rest, "*") {
+				expression.segments = append(expression.segments, jsonPathSegment{segmentType: wildcardSegment})
+				rest = rest[1:]
+				continue
+			}
+			length := strings.IndexAny(rest, ".[")
+			if length < 0 {
+				length = len(rest)
+			}
+			if strings.ContainsAny(rest[:length], invalidFieldCharacters) {
+				return nil, fmt.Errorf("Invalid JSONPath expression '%s', unexpected '%s'", text, rest[:length])
+			}
+			if length > 0 {
+				expression.segments = append(expression.segments,
+					jsonPathSegment{segmentType: fieldSegment, field: rest[:length]})
+			}
+			rest = rest[length:]
+		case '[':
+			end := closingIndex(rest, '[', ']')
+			if end < 0 {
+				return nil, fmt.Errorf("Invalid JSONPath expression '%s', missing ']'", text)
+			}
+			segment, err := parseBracket(strings.TrimSpace(rest[1:end]))
+			if err != nil {
+				return nil, fmt.Errorf("Invalid JSONPath expression '%s': %v", text, err)
+			}
+			expression.segments = append(expression.segments, segment)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("Invalid JSONPath expression '%s', unexpected '%s'", text, rest)
+		}
+	}
+	return expression, nil
+}
+
+func parseBracket(content string) (jsonPathSegment, error) {
+	switch {
+	case content == "*":
+		return jsonPathSegment{segmentType: wildcardSegment}, nil
+	case strings.HasPrefix(content, "'") || strings.HasPrefix(content, "\""):
+		field, err := unquote(content)
+		if err != nil {
+			return jsonPathSegment{}, err
+		}
+		return jsonPathSegment{segmentType: fieldSegment, field: field}, nil
+	case strings.HasPrefix(content, "?(") && strings.HasSuffix(content, ")"):
+		filter, err := parseFilter(strings.TrimSpace(content[2 : len(content)-1]))
+		if err != nil {
+			return jsonPathSegment{}, err
+		}
+		return jsonPathSegment{segmentType: filterSegment, filter: filter}, nil
+	case strings.Contains(content, ":"):
+		startText, endText, _ := strings.Cut(content, ":")
+		segment := jsonPathSegment{segmentType: sliceSegment}
+		for _, bound := range []struct {
+			text   string
+			target **int
+		}{{startText, &segment.start}, {endText, &segment.end}} {
+			if strings.TrimSpace(bound.text) == "" {
+				continue
+			}
+			value, err := strconv.Atoi(strings.TrimSpace(bound.text))
+			if err != nil {
+				return jsonPathSegment{}, fmt.Errorf("invalid slice '%s'", content)
+			}
+			*bound.target = &value
+		}
+		return segment, nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return jsonPathSegment{}, fmt.Errorf("invalid index '%s'", content)
+	}
+	return jsonPathSegment{segmentType: indexSegment, index: index}, nil
+}
+
+var filterOperators = []string{"==", "!="}
+
+func parseFilter(content string) (*jsonPathFilter, error) {
+	filter := &jsonPathFilter{}
+	pathText := content
+	for _, operator := range filterOperators {
+		left, right, found := strings.Cut(content, operator)
+		if !found {
+			continue
+		}
+		value, err := filterValue(strings.TrimSpace(right))
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter '%s': %v", content, err)
+		}
+		pathText = strings.TrimSpace(left)
+		filter.operator = operator
+		filter.value = value
+		break
+	}
+	if !strings.HasPrefix(pathText, "@") {
+		return nil, fmt.Errorf("filter '%s' should start with '@'", content)
+	}
+	path, err := parseJSONPathExpression(pathText)
+	if err != nil {
+		return nil, err
+	}
+	filter.path = path
+	return filter, nil
+}
+
+// filterValue returns the text of the literal compared by a filter, which is either a quoted string,
+// a number or a boolean.
+func filterValue(text string) (string, error) {
+	if text == "" {
+		return "", fmt.Errorf("missing value")
+	}
+	if strings.HasPrefix(text, "'") {
+		if len(text) < 2 || !strings.HasSuffix(text, "'") {
+			return "", fmt.Errorf("unclosed string %s", text)
+		}
+		return unquote(text)
+	}
+	if strings.HasPrefix(text, "\"") {
+		return unquote(text)
+	}
+	if _, err := strconv.ParseFloat(text, 64); err == nil {
+		return text, nil
+	}
+	if _, err := strconv.ParseBool(text); err == nil {
+		return text, nil
+	}
+	return "", fmt.Errorf("value '%s' should be a quoted string, a number or a boolean", text)
+}
+
+// unquote returns the text of a quoted string, or the text itself for numbers and booleans.
+func unquote(text string) (string, error) {
+	if len(text) >= 2 && text[0] == '\'' && text[len(text)-1] == '\'' {
+		return text[1 : len(text)-1], nil
+	}
+	if strings.HasPrefix(text, "\"") {
+		return strconv.Unquote(text)
+	}
+	return text, nil
+}
+
+// closingIndex returns the index of the character closing the one at the start of the text,
+// ignoring nested pairs and quoted strings.
+func closingIndex(text string, open byte, close byte) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == open:
+			depth++
+		case c == close:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func (e *jsonPathExpression) evaluate(root interface{}, current interface{}) ([]interface{}, error) {
+	values := []interface{}{current}
+	if e.fromRoot {
+		values = []interface{}{root}
+	}
+	for _, segment := range e.segments {
+		next := []interface{}{}
+		for _, value := range values {
+			selected, err := segment.evaluate(root, value)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, selected...)
+		}
+		values = next
+	}
+	return values, nil
+}
+
+func (s *jsonPathSegment) evaluate(root interface{}, value interface{}) ([]interface{}, error) {
+	switch s.segmentType {
+	case fieldSegment:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, nil
+		}
+		field, ok := object[s.field]
+		if !ok {
+			return nil, nil
+		}
+		return []interface{}{field}, nil
+	case indexSegment:
+		array, ok := value.([]interface{})
+		if !ok {
+			return nil, nil
+		}
+		index := s.index
+		if index < 0 {
+			index += len(array)
+		}
+		if index < 0 || index >= len(array) {
+			return nil, nil
+		}
+		return []interface{}{array[index]}, nil
+	case sliceSegment:
+		array, ok := value.([]interface{})
+		if !ok {
+			return nil, nil
+		}
+		start, end := sliceBound(s.start, 0, len(array)), sliceBound(s.end, len(array), len(array))
+		if start >= end {
+			return nil, nil
+		}
+		return array[start:end], nil
+	case wildcardSegment:
+		return children(value), nil
+	case filterSegment:
+		result := []interface{}{}
+		for _, child := range children(value) {
+			matches, err := s.filter.matches(root, child)
+			if err != nil {
+				return nil, err
+			}
+			if matches {
+				result = append(result, child)
+			}
+		}
+		return result, nil
+	}
+	return nil, nil
+}
+
+func sliceBound(bound *int, defaultValue int, length int) int {
+	if bound == nil {
+		return defaultValue
+	}
+	value := *bound
+	if value < 0 {
+		value += length
+	}
+	if value < 0 {
+		return 0
+	}
+	if value > length {
+		return length
+	}
+	return value
+}
+
+// children returns the elements of an array, or the values of an object sorted by key.
+func children(value interface{}) []interface{} {
+	switch typed := value.(type) {
+	case []interface{}:
+		return typed
+	case map[string]interface{}:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		result := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			result = append(result, typed[key])
+		}
+		return result
+	}
+	return nil
+}
+
+func (f *jsonPathFilter) matches(root interface{}, value interface{}) (bool, error) {
+	values, err := f.path.evaluate(root, value)
+	if err != nil {
+		return false, err
+	}
+	if f.operator == "" {
+		return len(values) > 0, nil
+	}
+	equal := len(values) == 1 && formatValue(values[0]) == f.value
+	if f.operator == "!=" {
+		return !equal, nil
+	}
+	return equal, nil
+}
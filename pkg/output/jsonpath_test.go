@@ -0,0 +1,59 @@
+package output
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JSONPath", func() {
+	var data interface{}
+
+	BeforeEach(func() {
+		Expect(json.Unmarshal([]byte(`{
+			"items": [
+				{"id": "a", "nodes": {"compute": 2}, "tags": ["x", "y"]},
+				{"id": "b", "nodes": {"compute": 3}, "tags": []}
+			]
+		}`), &data)).To(Succeed())
+	})
+
+	execute := func(template string) string {
+		jsonPath, err := ParseJSONPath(template)
+		Expect(err).NotTo(HaveOccurred())
+		result, err := jsonPath.Execute(data)
+		Expect(err).NotTo(HaveOccurred())
+		return result
+	}
+
+	It("Selects fields, indexes and slices", func() {
+		Expect(execute("{.items[0].id}")).To(Equal("a"))
+		Expect(execute("{.items[-1].nodes.compute}")).To(Equal("3"))
+		Expect(execute("{.items[0:1].id}")).To(Equal("a"))
+		Expect(execute("{$.items[*]['id']}")).To(Equal("a b"))
+		Expect(execute("{.items[0].tags}")).To(Equal(`["x","y"]`))
+	})
+
+	It("Ignores missing fields", func() {
+		Expect(execute("id={.items[0].missing}")).To(Equal("id="))
+	})
+
+	It("Filters values", func() {
+		Expect(execute(`{.items[?(@.nodes.compute==3)].id}`)).To(Equal("b"))
+		Expect(execute(`{.items[?(@.id!='b')].id}`)).To(Equal("a"))
+	})
+
+	It("Iterates over nested ranges", func() {
+		Expect(execute(`{range .items[*]}{.id}:{range .tags[*]}{@} {end};{end}`)).To(Equal("a:x y ;b:;"))
+	})
+
+	It("Fails with invalid templates", func() {
+		for _, template := range []string{"{.items", "{range .items[*]}", "{end}", "{items}", "{.items[x]}",
+			"{..id}", "{.items..id}", "{.items[?(@.id==)]}", "{.items[?(@.id==a)]}", "{.items[?(@.id<'b')]}",
+			"{.items[?(@.id=='b'}", "{.items[?(@.id=='b)]}"} {
+			_, err := ParseJSONPath(template)
+			Expect(err).To(HaveOccurred(), template)
+		}
+	})
+})
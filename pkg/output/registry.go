@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the registry of the functions that write the JSON representation of the
+// resources printed with the '--output' command line option.
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+)
+
+type marshaller func(resource interface{}, writer io.Writer) error
+
+var marshallers = map[reflect.Type]marshaller{}
+
+// Register registers the function that writes the JSON representation of the resources of type T,
+// usually one of the marshal functions of the OCM SDK, called from an init function next to the code
+// that reads the resources, for example:
+//
+//	output.Register(cmv1.MarshalCluster)
+//	output.Register(cmv1.MarshalClusterList)
+//
+// Lists of a registered type are printed even when no list function is registered. Resources of
+// other types are encoded with the 'encoding/json' package, so their fields need to be exported.
+func Register[T any](marshal func(T, io.Writer) error) {
+	marshallers[reflectType[T]()] = func(resource interface{}, writer io.Writer) error {
+		return marshal(resource.(T), writer)
+	}
+}
+
+func reflectType[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// IsRegistered returns true when a marshal function is registered for the type of the resource or
+// for the type of its elements.
+func IsRegistered(resource interface{}) bool {
+	resourceType := reflect.TypeOf(resource)
+	if resourceType == nil {
+		return false
+	}
+	if _, ok := marshallers[resourceType]; ok {
+		return true
+	}
+	if resourceType.Kind() == reflect.Slice {
+		_, ok := marshallers[resourceType.Elem()]
+		return ok
+	}
+	return false
+}
+
+func marshal(resource interface{}, b *bytes.Buffer) error {
+	value := reflect.ValueOf(resource)
+	// Empty lists are always printed the same way, whatever the marshaller would write for them
+	if value.Kind() == reflect.Slice && value.Len() == 0 {
+		b.WriteString("[]")
+		return nil
+	}
+	if !value.IsValid() {
+		return defaultEncode(resource, b)
+	}
+	if marshal, ok := marshallers[value.Type()]; ok {
+		return marshal(resource, b)
+	}
+	if value.Kind() == reflect.Slice {
+		if marshal, ok := marshallers[value.Type().Elem()]; ok {
+			return marshalList(value, marshal, b)
+		}
+	}
+	return defaultEncode(resource, b)
+}
+
+// marshalList writes the elements one by one and then indents the whole list the same way that the
+// list functions of the OCM SDK do.
+func marshalList(value reflect.Value, marshal marshaller, b *bytes.Buffer) error {
+	var list bytes.Buffer
+	list.WriteString("[")
+	for i := 0; i < value.Len(); i++ {
+		if i > 0 {
+			list.WriteString(",")
+		}
+		err := marshal(value.Index(i).Interface(), &list)
+		if err != nil {
+			return err
+		}
+	}
+	list.WriteString("]")
+	var compact bytes.Buffer
+	err := json.Compact(&compact, list.Bytes())
+	if err != nil {
+		return err
+	}
+	return json.Indent(b, compact.Bytes(), "", "  ")
+}
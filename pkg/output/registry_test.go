@@ -0,0 +1,68 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type registeredResource struct {
+	id string
+}
+
+type plainResource struct {
+	ID string `json:"id"`
+}
+
+var _ = Describe("Marshaller registry", func() {
+	BeforeEach(func() {
+		Register(func(resource *registeredResource, writer io.Writer) error {
+			_, err := fmt.Fprintf(writer, `{"kind":"Registered","id":"%s"}`, resource.id)
+			return err
+		})
+		DeferCleanup(func() {
+			delete(marshallers, reflectType[*registeredResource]())
+		})
+	})
+
+	It("Uses the registered marshaller", func() {
+		var b bytes.Buffer
+		Expect(marshal(&registeredResource{id: "a"}, &b)).To(Succeed())
+		Expect(b.String()).To(Equal(`{"kind":"Registered","id":"a"}`))
+		Expect(IsRegistered(&registeredResource{})).To(BeTrue())
+	})
+
+	It("Marshals lists of a registered type", func() {
+		var b bytes.Buffer
+		Expect(marshal([]*registeredResource{{id: "a"}, {id: "b"}}, &b)).To(Succeed())
+		Expect(b.String()).To(Equal(`[
+  {
+    "kind": "Registered",
+    "id": "a"
+  },
+  {
+    "kind": "Registered",
+    "id": "b"
+  }
+]`))
+		Expect(IsRegistered([]*registeredResource{})).To(BeTrue())
+	})
+
+	It("Prints empty lists the same way for every type", func() {
+		for _, resource := range []interface{}{[]*registeredResource{}, []plainResource{}, []string(nil)} {
+			var b bytes.Buffer
+			Expect(marshal(resource, &b)).To(Succeed())
+			Expect(b.String()).To(Equal("[]"))
+		}
+	})
+
+	It("Encodes other types with encoding/json", func() {
+		var b bytes.Buffer
+		Expect(marshal([]plainResource{{ID: "a"}}, &b)).To(Succeed())
+		Expect(b.String()).To(MatchJSON(`[{"id": "a"}]`))
+		Expect(IsRegistered(plainResource{})).To(BeFalse())
+	})
+})
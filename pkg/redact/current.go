@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redact
+
+import (
+	"errors"
+	"os"
+	"sync"
+)
+
+var (
+	lock    sync.Mutex
+	loaded  bool
+	current *Redactor
+)
+
+// Load creates the redactor used by the whole process, with the built-in rules and the rules of
+// the file returned by RulesLocation, if it exists. It returns an error if that file can't be
+// read or contains invalid rules; in that case only the built-in rules are applied.
+func Load() error {
+	lock.Lock()
+	defer lock.Unlock()
+	return load()
+}
+
+// Current returns the redactor used by the whole process. It is created the first time it is
+// needed if Load hasn't been called.
+func Current() *Redactor {
+	lock.Lock()
+	defer lock.Unlock()
+	if !loaded {
+		_ = load()
+	}
+	return current
+}
+
+func load() error {
+	loaded = true
+	var err error
+	current, err = loadRedactor()
+	if err != nil {
+		current, _ = New(DefaultRules())
+	}
+	return err
+}
+
+func loadRedactor() (*Redactor, error) {
+	rules := []*Rules{DefaultRules()}
+	path, err := RulesLocation()
+	if err != nil {
+		return nil, err
+	}
+	_, err = os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) && os.Getenv(RulesEnvVar) == "" {
+		// The default file is optional, but the one explicitly selected isn't:
+		return New(rules...)
+	}
+	user, err := LoadRules(path)
+	if err != nil {
+		return nil, err
+	}
+	return New(append(rules, user)...)
+}
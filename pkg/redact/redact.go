@@ -0,0 +1,380 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redact replaces credentials and other sensitive values in the data that the tool writes
+// for others to read: the dumps of the OCM and AWS requests in debug mode, the cassettes written
+// with '--record' and the support bundles. The built-in rules can be extended with a file, so that
+// the output complies with the security policies of the user.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gitlab.com/c0b/go-ordered-json"
+	"sigs.k8s.io/yaml"
+)
+
+// Replacement is the text that replaces the redacted values.
+const Replacement = "***"
+
+// RulesEnvVar is the environment variable that contains the path of the file with the redaction
+// rules of the user.
+const RulesEnvVar = "ROSA_REDACTION_RULES"
+
+// Rules are the fields, headers and patterns that are redacted.
+type Rules struct {
+	// Fields are names of fields of JSON documents, forms and XML documents, and of 'name=value'
+	// or 'name: value' pairs in text. Names are compared ignoring case.
+	Fields []string `json:"fields,omitempty"`
+
+	// Headers are names of HTTP headers.
+	Headers []string `json:"headers,omitempty"`
+
+	// Patterns are regular expressions. All the text that they match is replaced.
+	Patterns []string `json:"patterns,omitempty"`
+}
+
+// DefaultRules returns the rules that are always applied.
+func DefaultRules() *Rules {
+	return &Rules{
+		Fields: []string{
+			"access_key_id",
+			"access_token",
+			"admin",
+			"client_secret",
+			"id_token",
+			"kubeconfig",
+			"password",
+			"refresh_token",
+			"secret_access_key",
+			"session_token",
+			"ssh",
+			"token",
+			"AccessKeyId",
+			"SecretAccessKey",
+			"SessionToken",
+		},
+		Headers: []string{
+			"Authorization",
+			"Cookie",
+			"Proxy-Authorization",
+			"Set-Cookie",
+			"X-Amz-Security-Token",
+		},
+		Patterns: []string{
+			// Bearer tokens and JSON web tokens:
+			`(?i)\bBearer\s+[A-Za-z0-9\-_.~+/]{16,}=*`,
+			`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`,
+			// AWS access key identifiers:
+			`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`,
+		},
+	}
+}
+
+// RulesLocation returns the path of the file that contains the redaction rules of the user. It is
+// the value of the ROSA_REDACTION_RULES environment variable or, if that isn't set, the
+// 'rosa/redaction.yaml' file inside the configuration directory of the user.
+func RulesLocation() (string, error) {
+	if path := os.Getenv(RulesEnvVar); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "rosa", "redaction.yaml"), nil
+}
+
+// LoadRules reads redaction rules from a YAML or JSON file.
+func LoadRules(path string) (*Rules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read redaction rules file '%s': %v", path, err)
+	}
+	rules := &Rules{}
+	err = yaml.UnmarshalStrict(data, rules)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse redaction rules file '%s': %v", path, err)
+	}
+	return rules, nil
+}
+
+// Redactor applies a set of redaction rules. Don't create instances of this type directly; use the
+// New function instead.
+type Redactor struct {
+	rules    []*Rules
+	fields   map[string]bool
+	headers  map[string]bool
+	patterns []*regexp.Regexp
+
+	// pairs matches the 'name=value', 'name: value' and '"name": "value"' pairs of the fields in
+	// text, and headers the header lines, both in the HTTP format and in the format used by the
+	// dumps of the OCM SDK.
+	pairs       *regexp.Regexp
+	headerLines *regexp.Regexp
+}
+
+// New creates a redactor that applies the given rules.
+func New(rules ...*Rules) (*Redactor, error) {
+	r := &Redactor{
+		fields:  map[string]bool{},
+		headers: map[string]bool{},
+	}
+	var fields, headers []string
+	for _, set := range rules {
+		if set == nil {
+			continue
+		}
+		r.rules = append(r.rules, set)
+		for _, field := range set.Fields {
+			if field != "" && !r.fields[strings.ToLower(field)] {
+				r.fields[strings.ToLower(field)] = true
+				fields = append(fields, regexp.QuoteMeta(field))
+			}
+		}
+		for _, header := range set.Headers {
+			if header != "" && !r.headers[strings.ToLower(header)] {
+				r.headers[strings.ToLower(header)] = true
+				headers = append(headers, regexp.QuoteMeta(header))
+			}
+		}
+		for _, pattern := range set.Patterns {
+			compiled, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid redaction pattern '%s': %v", pattern, err)
+			}
+			r.patterns = append(r.patterns, compiled)
+		}
+	}
+	if len(fields) > 0 {
+		r.pairs = regexp.MustCompile(`(?i)((?:^|[^A-Za-z0-9_-])["']?(?:` + strings.Join(fields, "|") +
+			`)["']?\s*[:=]\s*)("(?:[^"\\]|\\.)*"|'[^']*'|[^\s,&;{}\[\]"']+)`)
+	}
+	if len(headers) > 0 {
+		names := strings.Join(headers, "|")
+		r.headerLines = regexp.MustCompile(`(?im)(^\s*(?:` + names + `):[ \t]*)[^\r\n]*|` +
+			`(header '(?:` + names + `)' is ')[^']*`)
+	}
+	return r, nil
+}
+
+// With returns a redactor that applies the rules of this one and the given additional fields.
+func (r *Redactor) With(fields ...string) *Redactor {
+	result, err := New(append(r.rules, &Rules{Fields: fields})...)
+	if err != nil {
+		// The patterns were already compiled successfully for this redactor:
+		panic(err)
+	}
+	return result
+}
+
+// Field returns true if the values of the given field are redacted.
+func (r *Redactor) Field(name string) bool {
+	return r.fields[strings.ToLower(name)]
+}
+
+// Header returns true if the values of the given header are redacted.
+func (r *Redactor) Header(name string) bool {
+	return r.headers[strings.ToLower(name)]
+}
+
+// Headers returns a copy of the given headers with the values of the redacted ones replaced.
+func (r *Redactor) Headers(header http.Header) http.Header {
+	if header == nil {
+		return nil
+	}
+	result := header.Clone()
+	for name, values := range result {
+		if r.Header(name) {
+			result[name] = []string{Replacement}
+			continue
+		}
+		for i, value := range values {
+			values[i] = r.applyPatterns(value)
+		}
+	}
+	return result
+}
+
+// Values replaces the values of the redacted fields of a form.
+func (r *Redactor) Values(values url.Values) {
+	for name, items := range values {
+		for i := range items {
+			if r.Field(name) {
+				items[i] = Replacement
+			} else {
+				items[i] = r.applyPatterns(items[i])
+			}
+		}
+	}
+}
+
+// Value replaces the values of the redacted fields of a decoded JSON document, and of the objects
+// that it contains.
+func (r *Redactor) Value(value interface{}) {
+	switch typed := value.(type) {
+	case *ordered.OrderedMap:
+		iterator := typed.EntriesIter()
+		for {
+			pair, ok := iterator()
+			if !ok {
+				break
+			}
+			if r.Field(pair.Key) {
+				typed.Set(pair.Key, Replacement)
+				continue
+			}
+			if text, ok := pair.Value.(string); ok {
+				typed.Set(pair.Key, r.applyPatterns(text))
+				continue
+			}
+			r.Value(pair.Value)
+		}
+	case map[string]interface{}:
+		for key, item := range typed {
+			if r.Field(key) {
+				typed[key] = Replacement
+				continue
+			}
+			if text, ok := item.(string); ok {
+				typed[key] = r.applyPatterns(text)
+				continue
+			}
+			r.Value(item)
+		}
+	case []interface{}:
+		for i, item := range typed {
+			if text, ok := item.(string); ok {
+				typed[i] = r.applyPatterns(text)
+				continue
+			}
+			r.Value(item)
+		}
+	}
+}
+
+// JSON redacts a JSON document, preserving the order of the fields. It returns false if the data
+// isn't a JSON object or array.
+func (r *Redactor) JSON(data []byte) ([]byte, bool) {
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		parsed := ordered.NewOrderedMap()
+		if json.Unmarshal(trimmed, parsed) != nil {
+			return nil, false
+		}
+		r.Value(parsed)
+		result, err := json.Marshal(parsed)
+		if err != nil {
+			return nil, false
+		}
+		return result, true
+	case bytes.HasPrefix(trimmed, []byte("[")):
+		// Arrays are redacted item by item, so that the order of the fields of the objects that
+		// they contain is preserved:
+		var items []json.RawMessage
+		if json.Unmarshal(trimmed, &items) != nil {
+			return nil, false
+		}
+		for i, item := range items {
+			if redacted, ok := r.JSON(item); ok {
+				items[i] = redacted
+				continue
+			}
+			var text string
+			if json.Unmarshal(item, &text) == nil {
+				items[i], _ = json.Marshal(r.applyPatterns(text))
+			}
+		}
+		result, err := json.Marshal(items)
+		if err != nil {
+			return nil, false
+		}
+		return result, true
+	}
+	return nil, false
+}
+
+// Body redacts the body of an HTTP request or response according to its content type. JSON
+// documents are returned without white space.
+func (r *Redactor) Body(contentType string, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			break
+		}
+		r.Values(form)
+		return []byte(form.Encode())
+	case strings.HasSuffix(mediaType, "json"):
+		if redacted, ok := r.JSON(body); ok {
+			return redacted
+		}
+	case strings.HasSuffix(mediaType, "xml"):
+		text := string(body)
+		for field := range r.fields {
+			element := regexp.MustCompile(`(?i)(<` + regexp.QuoteMeta(field) + `>)[^<]*(</` +
+				regexp.QuoteMeta(field) + `>)`)
+			text = element.ReplaceAllString(text, "${1}"+Replacement+"${2}")
+		}
+		return []byte(r.Text(text))
+	}
+	return []byte(r.Text(string(body)))
+}
+
+// Text redacts free text, like log messages: the values of the redacted fields that appear as
+// 'name=value', 'name: value' or '"name": "value"' pairs, the values of the redacted headers, and
+// everything that matches the patterns.
+func (r *Redactor) Text(text string) string {
+	if r.headerLines != nil {
+		text = r.headerLines.ReplaceAllString(text, "${1}${2}"+Replacement)
+	}
+	if r.pairs != nil {
+		text = r.pairs.ReplaceAllStringFunc(text, func(match string) string {
+			groups := r.pairs.FindStringSubmatch(match)
+			value := groups[2]
+			switch {
+			case strings.HasPrefix(value, `"`):
+				return groups[1] + `"` + Replacement + `"`
+			case strings.HasPrefix(value, `'`):
+				return groups[1] + `'` + Replacement + `'`
+			default:
+				return groups[1] + Replacement
+			}
+		})
+	}
+	return r.applyPatterns(text)
+}
+
+func (r *Redactor) applyPatterns(text string) string {
+	for _, pattern := range r.patterns {
+		text = pattern.ReplaceAllString(text, Replacement)
+	}
+	return text
+}
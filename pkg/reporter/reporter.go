@@ -23,6 +23,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/color"
 	"github.com/openshift/rosa/pkg/debug"
+	"github.com/openshift/rosa/pkg/logformat"
 )
 
 // Object is the reported object used by the tool. It prints the messages to the standard output or
@@ -35,13 +36,19 @@ func (r *Object) Debugf(format string, args ...interface{}) {
 	if !debug.Enabled() {
 		return
 	}
+	if logformat.UseJSON() {
+		_ = logformat.NewRecord(debugLevel, fmt.Sprintf(format, args...)).Write(os.Stdout)
+		return
+	}
 	r.Infof(format, args...)
 }
 
 // Infof prints an informative message with the given format and arguments.
 func (r *Object) Infof(format string, args ...interface{}) {
 	message := fmt.Sprintf(format, args...)
-	if color.UseColor() {
+	if logformat.UseJSON() {
+		_ = logformat.NewRecord(infoLevel, message).Write(os.Stdout)
+	} else if color.UseColor() {
 		_, _ = fmt.Fprintf(os.Stdout, "%s%s\n", infoColorPrefix, message)
 	} else {
 		_, _ = fmt.Fprintf(os.Stdout, "%s%s\n", infoPrefix, message)
@@ -51,7 +58,9 @@ func (r *Object) Infof(format string, args ...interface{}) {
 // Warnf prints an warning message with the given format and arguments.
 func (r *Object) Warnf(format string, args ...interface{}) {
 	message := fmt.Sprintf(format, args...)
-	if color.UseColor() {
+	if logformat.UseJSON() {
+		_ = logformat.NewRecord(warnLevel, message).Write(os.Stderr)
+	} else if color.UseColor() {
 		_, _ = fmt.Fprintf(os.Stderr, "%s%s\n", warnColorPrefix, message)
 	} else {
 		_, _ = fmt.Fprintf(os.Stderr, "%s%s\n", warnPrefix, message)
@@ -63,7 +72,9 @@ func (r *Object) Warnf(format string, args ...interface{}) {
 // report the error and also return it.
 func (r *Object) Errorf(format string, args ...interface{}) error {
 	message := fmt.Sprintf(format, args...)
-	if color.UseColor() {
+	if logformat.UseJSON() {
+		_ = logformat.NewRecord(errorLevel, message).Write(os.Stderr)
+	} else if color.UseColor() {
 		_, _ = fmt.Fprintf(os.Stderr, "%s%s\n", errorColorPrefix, message)
 	} else {
 		_, _ = fmt.Fprintf(os.Stderr, "%s%s\n", errorPrefix, message)
@@ -71,6 +82,14 @@ func (r *Object) Errorf(format string, args ...interface{}) error {
 	return errors.New(message)
 }
 
+// Levels of the messages when the '--log-format=json' flag is used, the same used by logrus:
+const (
+	debugLevel = "debug"
+	infoLevel  = "info"
+	warnLevel  = "warning"
+	errorLevel = "error"
+)
+
 // Message prefix using ANSI scape sequences to set colors:
 const (
 	infoColorPrefix  = "\033[0;36mI:\033[m "
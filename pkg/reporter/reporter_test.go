@@ -1,8 +1,10 @@
 package reporter
 
 import (
+	"encoding/json"
 	"io"
 	"os"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/color"
 	"github.com/openshift/rosa/pkg/debug"
+	"github.com/openshift/rosa/pkg/logformat"
 )
 
 func TestReporter(t *testing.T) {
@@ -28,6 +31,9 @@ var _ = Describe("Reporter Tests", func() {
 	AfterEach(func() {
 		color.SetColor("auto")
 		debug.SetEnabled(false)
+		logformat.SetFormat(logformat.Text)
+		logformat.SetCommand("")
+		logformat.SetClusterID("")
 	})
 
 	It("Returns a reporter", func() {
@@ -149,6 +155,60 @@ var _ = Describe("Reporter Tests", func() {
 			Expect(stdErr).To(BeEmpty())
 		})
 	})
+
+	Context("JSON", func() {
+		BeforeEach(func() {
+			logformat.SetFormat(logformat.JSON)
+			logformat.SetCommand("rosa describe cluster")
+			logformat.SetClusterID("123")
+		})
+
+		decode := func(line string) map[string]interface{} {
+			Expect(line).To(HaveSuffix("\n"))
+			Expect(strings.Count(line, "\n")).To(Equal(1))
+			entry := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(line), &entry)).To(Succeed())
+			Expect(entry).To(HaveKey("timestamp"))
+			delete(entry, "timestamp")
+			return entry
+		}
+
+		It("Prints an info message as a JSON line", func() {
+			color.SetColor("always")
+			stdOut, stdErr := captureStdOutAndStdError(func() {
+				reporter.Infof("Hello %s", "World")
+			})
+			Expect(decode(stdOut)).To(Equal(map[string]interface{}{
+				"level":      "info",
+				"command":    "rosa describe cluster",
+				"cluster_id": "123",
+				"message":    "Hello World",
+			}))
+			Expect(stdErr).To(BeEmpty())
+		})
+
+		It("Prints warn and error messages as JSON lines", func() {
+			stdOut, stdErr := captureStdOutAndStdError(func() {
+				reporter.Warnf("Careful")
+			})
+			Expect(decode(stdErr)).To(HaveKeyWithValue("level", "warning"))
+			Expect(stdOut).To(BeEmpty())
+
+			stdOut, stdErr = captureStdOutAndStdError(func() {
+				reporter.Errorf("Failed")
+			})
+			Expect(decode(stdErr)).To(HaveKeyWithValue("level", "error"))
+			Expect(stdOut).To(BeEmpty())
+		})
+
+		It("Prints a debug message as a JSON line", func() {
+			debug.SetEnabled(true)
+			stdOut, _ := captureStdOutAndStdError(func() {
+				reporter.Debugf("Hello World")
+			})
+			Expect(decode(stdOut)).To(HaveKeyWithValue("level", "debug"))
+		})
+	})
 })
 
 func captureStdOutAndStdError(function func()) (string, string) {
@@ -0,0 +1,28 @@
+package rosa
+
+import (
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// Injection contains the clients that the runtimes use instead of connecting to OCM and AWS. It
+// allows tests to run commands in-process, see the 'pkg/test/harness' package. Tests replace the
+// function used to terminate the process with 'cmdcontext.SetExit'.
+type Injection struct {
+	OCMClient *ocm.Client
+	AWSClient aws.Client
+	Creator   *aws.Creator
+}
+
+var injection Injection
+
+// Inject makes the runtimes created with NewRuntime use the given clients, including the runtimes
+// of the commands that create them directly instead of using DefaultRunner. It returns a function
+// that restores the previous injection.
+func Inject(value Injection) (restore func()) {
+	previous := injection
+	injection = value
+	return func() {
+		injection = previous
+	}
+}
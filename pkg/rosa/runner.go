@@ -2,9 +2,11 @@ package rosa
 
 import (
 	"context"
-	"os"
 
 	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
 )
 
 // RuntimeVisitor are functions that configure the Runtime for a command.
@@ -18,18 +20,19 @@ type CommandRunner func(ctx context.Context, runtime *Runtime, command *cobra.Co
 // of instantiating several key resources on behalf of a command
 func DefaultRunner(visitor RuntimeVisitor, runner CommandRunner) func(command *cobra.Command, args []string) {
 	return func(command *cobra.Command, args []string) {
-		ctx := context.Background()
 		r := NewRuntime()
-		defer r.Cleanup()
-
-		if visitor != nil {
-			visitor(ctx, r, command, args)
-		}
+		ctx := r.Context
 
-		err := runner(ctx, r, command, args)
+		// The runtime is cleaned up before exiting, as the deferred calls don't run after that
+		err := func() error {
+			defer r.Cleanup()
+			if visitor != nil {
+				visitor(ctx, r, command, args)
+			}
+			return runner(ctx, r, command, args)
+		}()
 		if err != nil {
-			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, cmdcontext.Explain(err)))
 		}
 	}
 }
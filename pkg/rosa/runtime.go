@@ -1,7 +1,7 @@
 package rosa
 
 import (
-	"os"
+	"context"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -9,12 +9,19 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+	"github.com/openshift/rosa/pkg/logformat"
 	"github.com/openshift/rosa/pkg/logging"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/tracing"
 )
 
 type Runtime struct {
+	// Context is cancelled when the command is interrupted or the time given with '--timeout'
+	// expires. The OCM and AWS clients send their requests with it.
+	Context    context.Context
 	Reporter   *reporter.Object
 	Logger     *logrus.Logger
 	OCMClient  *ocm.Client
@@ -29,13 +36,26 @@ func NewRuntime() *Runtime {
 	reporter := reporter.CreateReporter()
 	logger := logging.NewLogger()
 	spinner := spinner.New(spinner.CharSets[9], 100*time.Millisecond)
-	return &Runtime{Reporter: reporter, Logger: logger, Spinner: spinner}
+	return &Runtime{
+		Context:   cmdcontext.Current(),
+		Reporter:  reporter,
+		Logger:    logger,
+		Spinner:   spinner,
+		OCMClient: injection.OCMClient,
+		AWSClient: injection.AWSClient,
+		Creator:   injection.Creator,
+	}
 }
 
 // Adds an OCM client to the runtime. Requires a deferred call to `.Cleanup()` to close connections.
+// Commands that exit with 'cmdcontext.Exit' skip their deferred calls, so the runtime is also
+// cleaned up when the command exits.
 func (r *Runtime) WithOCM() *Runtime {
 	if r.OCMClient == nil {
 		r.OCMClient = ocm.CreateNewClientOrExit(r.Logger, r.Reporter)
+		cmdcontext.OnExit(func(int) {
+			r.Cleanup()
+		})
 	}
 	return r
 }
@@ -44,27 +64,28 @@ func (r *Runtime) WithOCM() *Runtime {
 func (r *Runtime) WithAWS() *Runtime {
 	// dependency to ocm client to validate the region
 	r.WithOCM()
-	err := r.OCMClient.ValidateAwsClientRegion()
-	if err != nil {
-		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
-	}
 	if r.AWSClient == nil {
+		err := r.OCMClient.ValidateAwsClientRegion()
+		if err != nil {
+			r.Reporter.Errorf("%v", err)
+			cmdcontext.Exit(1)
+		}
 		r.AWSClient = aws.CreateNewClientOrExit(r.Logger, r.Reporter)
 	}
 	if r.Creator == nil {
 		var err error
 		r.Creator, err = r.AWSClient.GetCreator()
 		if err != nil {
-			r.Reporter.Errorf("Failed to get AWS creator: %v", err)
-			os.Exit(1)
+			cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get AWS creator")))
 		}
 	}
 	return r
 }
 
+// Cleanup closes the connections of the runtime. It can be called more than once.
 func (r *Runtime) Cleanup() {
-	if r.OCMClient != nil {
+	// The injected client is shared by all the runtimes, it is closed by whoever injected it
+	if r.OCMClient != nil && r.OCMClient != injection.OCMClient {
 		if err := r.OCMClient.Close(); err != nil {
 			r.Reporter.Errorf("Failed to close OCM connection: %v", err)
 		}
@@ -75,8 +96,7 @@ func (r *Runtime) Cleanup() {
 func (r *Runtime) GetClusterKey() string {
 	clusterKey, err := ocm.GetClusterKey()
 	if err != nil {
-		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrap(rosaerrors.CategoryUsage, err)))
 	}
 	r.ClusterKey = clusterKey
 	return clusterKey
@@ -90,7 +110,7 @@ func (r *Runtime) FetchCluster() *cmv1.Cluster {
 	// We don't want to lazy init the OCM client since it requires cleanup
 	if r.OCMClient == nil {
 		r.Reporter.Errorf("Tried to fetch a cluster without initializing the OCM client, exiting.")
-		os.Exit(1)
+		cmdcontext.Exit(1)
 	}
 	if r.ClusterKey == "" {
 		r.GetClusterKey()
@@ -102,9 +122,10 @@ func (r *Runtime) FetchCluster() *cmv1.Cluster {
 	r.Reporter.Debugf("Loading cluster '%s'", r.ClusterKey)
 	cluster, err := r.OCMClient.GetCluster(r.ClusterKey, r.Creator)
 	if err != nil {
-		r.Reporter.Errorf("Failed to get cluster '%s': %v", r.ClusterKey, err)
-		os.Exit(1)
+		cmdcontext.Exit(rosaerrors.Print(r.Reporter, rosaerrors.Wrapf(err, "Failed to get cluster '%s'", r.ClusterKey)))
 	}
 	r.Cluster = cluster
+	logformat.SetClusterID(cluster.ID())
+	tracing.SetClusterID(cluster.ID())
 	return cluster
 }
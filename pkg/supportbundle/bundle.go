@@ -0,0 +1,298 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package supportbundle collects the diagnostics of a cluster from OCM and AWS into a compressed
+// archive that can be attached to a support case.
+package supportbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/sirupsen/logrus"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/info"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/redact"
+)
+
+// ManifestFileName is the name of the file of the archive that describes its contents.
+const ManifestFileName = "manifest.json"
+
+// Status of the collection of a piece of diagnostics.
+const (
+	StatusCollected = "collected"
+	StatusFailed    = "failed"
+	StatusSkipped   = "skipped"
+)
+
+// Manifest describes the contents of a support bundle, including the diagnostics that couldn't be
+// collected and why.
+type Manifest struct {
+	Kind              string       `json:"kind"`
+	ClusterID         string       `json:"cluster_id"`
+	ClusterName       string       `json:"cluster_name"`
+	ClusterState      string       `json:"cluster_state"`
+	RosaVersion       string       `json:"rosa_version"`
+	CreationTimestamp time.Time    `json:"creation_timestamp"`
+	Collections       []Collection `json:"collections"`
+}
+
+// Collection is the result of collecting one piece of diagnostics.
+type Collection struct {
+	Name   string `json:"name"`
+	File   string `json:"file,omitempty"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Failed returns the collections that failed.
+func (m *Manifest) Failed() []Collection {
+	var result []Collection
+	for _, collection := range m.Collections {
+		if collection.Status == StatusFailed {
+			result = append(result, collection)
+		}
+	}
+	return result
+}
+
+// CollectorBuilder contains the information and logic needed to build a collector. Don't create
+// instances of this type directly; use the NewCollector function instead.
+type CollectorBuilder struct {
+	logger    *logrus.Logger
+	redactor  *redact.Redactor
+	ocmClient *ocm.Client
+	awsClient aws.Client
+	awsError  error
+	cluster   *cmv1.Cluster
+	now       func() time.Time
+}
+
+// Collector gathers the diagnostics of a cluster. Don't create instances of this type directly;
+// use the NewCollector function instead.
+type Collector struct {
+	logger    *logrus.Logger
+	redactor  *redact.Redactor
+	ocmClient *ocm.Client
+	awsClient aws.Client
+	awsError  error
+	cluster   *cmv1.Cluster
+	now       func() time.Time
+}
+
+// NewCollector creates a builder that can then be used to create a collector.
+func NewCollector() *CollectorBuilder {
+	return &CollectorBuilder{}
+}
+
+// Logger sets the logger that the collector uses to report progress. This is mandatory.
+func (b *CollectorBuilder) Logger(value *logrus.Logger) *CollectorBuilder {
+	b.logger = value
+	return b
+}
+
+// Redactor sets the redactor applied to the files of the bundle. The default is the redactor of
+// the process.
+func (b *CollectorBuilder) Redactor(value *redact.Redactor) *CollectorBuilder {
+	b.redactor = value
+	return b
+}
+
+// OCMClient sets the client used to collect the diagnostics stored in OCM. This is mandatory.
+func (b *CollectorBuilder) OCMClient(value *ocm.Client) *CollectorBuilder {
+	b.ocmClient = value
+	return b
+}
+
+// AWSClient sets the client used to collect the state of the roles and the OIDC provider of the
+// cluster. When it isn't set those collections are skipped.
+func (b *CollectorBuilder) AWSClient(value aws.Client) *CollectorBuilder {
+	b.awsClient = value
+	return b
+}
+
+// AWSError sets the reason why the AWS client couldn't be created. When it is set, and the AWS
+// client isn't, the collections that need AWS are recorded as failed with this reason.
+func (b *CollectorBuilder) AWSError(value error) *CollectorBuilder {
+	b.awsError = value
+	return b
+}
+
+// Cluster sets the cluster whose diagnostics are collected. This is mandatory.
+func (b *CollectorBuilder) Cluster(value *cmv1.Cluster) *CollectorBuilder {
+	b.cluster = value
+	return b
+}
+
+// Clock sets the function that returns the current time, used for the timestamps of the archive.
+// The default is time.Now.
+func (b *CollectorBuilder) Clock(value func() time.Time) *CollectorBuilder {
+	b.now = value
+	return b
+}
+
+// Build uses the information stored in the builder to create a new collector.
+func (b *CollectorBuilder) Build() (*Collector, error) {
+	if b.logger == nil {
+		return nil, fmt.Errorf("Logger is mandatory")
+	}
+	if b.ocmClient == nil {
+		return nil, fmt.Errorf("OCM client is mandatory")
+	}
+	if b.cluster == nil {
+		return nil, fmt.Errorf("Cluster is mandatory")
+	}
+	redactor := b.redactor
+	if redactor == nil {
+		redactor = redact.Current()
+	}
+	now := b.now
+	if now == nil {
+		now = time.Now
+	}
+	return &Collector{
+		logger:    b.logger,
+		redactor:  redactor,
+		ocmClient: b.ocmClient,
+		awsClient: b.awsClient,
+		awsError:  b.awsError,
+		cluster:   b.cluster,
+		now:       now,
+	}, nil
+}
+
+// Write collects the diagnostics of the cluster and writes them, redacted, to a gzip compressed tar
+// archive. Diagnostics that can't be collected are recorded in the manifest, which is the last
+// file of the archive, and don't make the whole collection fail. The returned error is only for
+// failures to write the archive.
+func (c *Collector) Write(writer io.Writer) (*Manifest, error) {
+	timestamp := c.now().UTC()
+	manifest := &Manifest{
+		Kind:              "SupportBundle",
+		ClusterID:         c.cluster.ID(),
+		ClusterName:       c.cluster.Name(),
+		ClusterState:      string(c.cluster.State()),
+		RosaVersion:       info.Version,
+		CreationTimestamp: timestamp,
+	}
+	dir := fmt.Sprintf("rosa-support-bundle-%s-%s", c.cluster.ID(), timestamp.Format("20060102150405"))
+
+	zipper := gzip.NewWriter(writer)
+	archive := tar.NewWriter(zipper)
+	add := func(name string, data []byte) error {
+		err := archive.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     path.Join(dir, name),
+			Size:     int64(len(data)),
+			Mode:     0600,
+			ModTime:  timestamp,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = archive.Write(data)
+		return err
+	}
+
+	for _, item := range c.items() {
+		collection := Collection{
+			Name: item.name,
+		}
+		if item.skip != "" {
+			collection.Status = StatusSkipped
+			collection.Reason = item.skip
+			manifest.Collections = append(manifest.Collections, collection)
+			continue
+		}
+		c.logger.Debugf("Collecting %s", item.name)
+		data, err := item.collect()
+		if err != nil {
+			c.logger.Debugf("Failed to collect %s: %v", item.name, err)
+			collection.Status = StatusFailed
+			collection.Reason = err.Error()
+			manifest.Collections = append(manifest.Collections, collection)
+			continue
+		}
+		err = add(item.file, c.redact(item.file, data))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to write '%s' to support bundle: %v", item.file, err)
+		}
+		collection.Status = StatusCollected
+		collection.File = item.file
+		manifest.Collections = append(manifest.Collections, collection)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to write manifest to support bundle: %v", err)
+	}
+	err = add(ManifestFileName, append(data, '\n'))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to write manifest to support bundle: %v", err)
+	}
+	err = archive.Close()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to write support bundle: %v", err)
+	}
+	err = zipper.Close()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to write support bundle: %v", err)
+	}
+	return manifest, nil
+}
+
+// redact replaces the credentials contained in a file of the bundle.
+func (c *Collector) redact(file string, data []byte) []byte {
+	if strings.HasSuffix(file, ".json") {
+		if redacted, ok := c.redactor.JSON(data); ok {
+			if indented, err := indent(redacted); err == nil {
+				return indented
+			}
+		}
+	}
+	return []byte(c.redactor.Text(string(data)))
+}
+
+// marshal writes an object to a buffer with one of the marshal functions of the OCM SDK, and
+// indents the result so that it is easy to read.
+func marshal[T any](object T, marshaller func(T, io.Writer) error) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	err := marshaller(object, buffer)
+	if err != nil {
+		return nil, err
+	}
+	return indent(buffer.Bytes())
+}
+
+func indent(data []byte) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	err := json.Indent(buffer, data, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	buffer.WriteByte('\n')
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/test"
+	"github.com/openshift/rosa/pkg/test/harness"
+)
+
+var clustersPath = "/api/clusters_mgmt/v1/clusters/" + test.MockClusterID
+
+var _ = Describe("Collector", func() {
+	var (
+		h         *harness.Harness
+		awsClient *aws.MockClient
+		awsError  error
+		logger    *logrus.Logger
+		now       time.Time
+	)
+
+	BeforeEach(func() {
+		h = harness.New(GinkgoT())
+		DeferCleanup(h.Close)
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		awsError = nil
+		logger = logrus.New()
+		logger.SetOutput(io.Discard)
+		now = time.Date(2024, 6, 5, 10, 15, 0, 0, time.UTC)
+	})
+
+	// write collects the diagnostics of the cluster and returns the files of the archive,
+	// without the name of the directory that contains them.
+	write := func(cluster *cmv1.Cluster, client aws.Client) (*Manifest, map[string]string) {
+		collector, err := NewCollector().
+			Logger(logger).
+			OCMClient(h.OCMClient).
+			AWSClient(client).
+			AWSError(awsError).
+			Cluster(cluster).
+			Clock(func() time.Time { return now }).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		buffer := &bytes.Buffer{}
+		manifest, err := collector.Write(buffer)
+		Expect(err).ToNot(HaveOccurred())
+
+		zipped, err := gzip.NewReader(buffer)
+		Expect(err).ToNot(HaveOccurred())
+		archive := tar.NewReader(zipped)
+		files := map[string]string{}
+		for {
+			header, err := archive.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).ToNot(HaveOccurred())
+			dir, file, _ := strings.Cut(header.Name, "/")
+			Expect(dir).To(Equal(fmt.Sprintf("rosa-support-bundle-%s-20240605101500", test.MockClusterID)))
+			data, err := io.ReadAll(archive)
+			Expect(err).ToNot(HaveOccurred())
+			files[file] = string(data)
+		}
+		return manifest, files
+	}
+
+	status := func(manifest *Manifest, name string) string {
+		for _, collection := range manifest.Collections {
+			if collection.Name == name {
+				return collection.Status
+			}
+		}
+		return ""
+	}
+
+	It("Collects the diagnostics and records the ones that fail", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateError)
+			c.AWS(cmv1.NewAWS().
+				SubnetIDs("subnet-1", "subnet-2").
+				STS(cmv1.NewSTS().
+					RoleARN("arn:aws:iam::123456789012:role/prefix-Installer-Role").
+					OperatorIAMRoles(cmv1.NewOperatorIAMRole().
+						Namespace("openshift-ingress-operator").
+						Name("cloud-credentials").
+						RoleARN("arn:aws:iam::123456789012:role/prefix-openshift-ingress-operator")).
+					OidcConfig(cmv1.NewOidcConfig().IssuerUrl("https://oidc.example.com/abc"))))
+		})
+		h.OCM.RouteToHandler(http.MethodGet, clustersPath+"/logs/install",
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "Log",
+				"content": "level=info msg=Creating\nlevel=debug password=hunter2\n"
+			}`))
+		h.OCM.RouteToHandler(http.MethodGet, clustersPath+"/limited_support_reasons",
+			RespondWithJSON(http.StatusOK, `{
+				"kind": "LimitedSupportReasonList",
+				"items": [{"kind": "LimitedSupportReason", "id": "r1", "summary": "Missing role"}]
+			}`))
+		h.OCM.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/network_verifications/subnet-1",
+			RespondWithJSON(http.StatusOK, `{"kind": "NetworkVerification", "id": "subnet-1", "state": "passed"}`))
+		notFound := RespondWithJSON(http.StatusNotFound, `{"kind": "Error", "reason": "Not found"}`)
+		h.OCM.RouteToHandler(http.MethodGet, clustersPath+"/inflight_checks", notFound)
+		h.OCM.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/network_verifications/subnet-2", notFound)
+
+		awsClient.EXPECT().GetRoleByARN("arn:aws:iam::123456789012:role/prefix-Installer-Role").Return(
+			iamtypes.Role{RoleName: awssdk.String("prefix-Installer-Role")}, nil)
+		awsClient.EXPECT().ListAttachedRolePolicies("prefix-Installer-Role").Return(
+			[]string{"arn:aws:iam::123456789012:policy/prefix-Installer-Role-Policy"}, nil)
+		awsClient.EXPECT().GetRoleByARN("arn:aws:iam::123456789012:role/prefix-openshift-ingress-operator").Return(
+			iamtypes.Role{}, fmt.Errorf("NoSuchEntity"))
+		awsClient.EXPECT().GetOpenIDConnectProviderByOidcEndpointUrl("https://oidc.example.com/abc").Return(
+			"arn:aws:iam::123456789012:oidc-provider/oidc.example.com/abc", nil)
+
+		manifest, files := write(cluster, awsClient)
+
+		Expect(manifest.ClusterID).To(Equal(test.MockClusterID))
+		Expect(manifest.CreationTimestamp).To(Equal(now))
+		Expect(status(manifest, "cluster")).To(Equal(StatusCollected))
+		Expect(status(manifest, "install_logs")).To(Equal(StatusCollected))
+		Expect(status(manifest, "uninstall_logs")).To(Equal(StatusSkipped))
+		Expect(status(manifest, "inflight_checks")).To(Equal(StatusFailed))
+		Expect(status(manifest, "limited_support_reasons")).To(Equal(StatusCollected))
+		Expect(status(manifest, "subnet_verification/subnet-1")).To(Equal(StatusCollected))
+		Expect(status(manifest, "subnet_verification/subnet-2")).To(Equal(StatusFailed))
+		Expect(status(manifest, "account_role/installer")).To(Equal(StatusCollected))
+		Expect(status(manifest, "operator_role/openshift-ingress-operator-cloud-credentials")).To(
+			Equal(StatusFailed))
+		Expect(status(manifest, "oidc_provider")).To(Equal(StatusCollected))
+		Expect(manifest.Failed()).To(HaveLen(3))
+
+		Expect(files).To(HaveKey("cluster.json"))
+		Expect(files).To(HaveKey("limited_support_reasons.json"))
+		Expect(files).To(HaveKey("network_verifications/subnet-1.json"))
+		Expect(files).ToNot(HaveKey("network_verifications/subnet-2.json"))
+		Expect(files).ToNot(HaveKey("inflight_checks.json"))
+		Expect(files["logs/install.log"]).To(ContainSubstring("level=info msg=Creating"))
+		Expect(files["logs/install.log"]).To(ContainSubstring("password=***"))
+		Expect(files["logs/install.log"]).ToNot(ContainSubstring("hunter2"))
+		Expect(files["aws/account_roles/installer.json"]).To(ContainSubstring("prefix-Installer-Role-Policy"))
+		Expect(files["aws/oidc_provider.json"]).To(ContainSubstring(`"exists": true`))
+
+		written := &Manifest{}
+		Expect(json.Unmarshal([]byte(files[ManifestFileName]), written)).To(Succeed())
+		Expect(written.Collections).To(Equal(manifest.Collections))
+	})
+
+	It("Skips the AWS diagnostics of clusters that don't use STS", func() {
+		h.OCM.RouteToHandler(http.MethodGet, clustersPath+"/logs/install",
+			RespondWithJSON(http.StatusOK, `{"kind": "Log", "content": ""}`))
+		h.OCM.RouteToHandler(http.MethodGet, clustersPath+"/inflight_checks",
+			RespondWithJSON(http.StatusOK, `{"kind": "InflightCheckList", "items": []}`))
+		h.OCM.RouteToHandler(http.MethodGet, clustersPath+"/limited_support_reasons",
+			RespondWithJSON(http.StatusOK, `{"kind": "LimitedSupportReasonList", "items": []}`))
+
+		manifest, files := write(test.MockCluster(nil), awsClient)
+
+		Expect(status(manifest, "subnet_verifications")).To(Equal(StatusSkipped))
+		Expect(status(manifest, "account_roles")).To(Equal(StatusSkipped))
+		Expect(status(manifest, "operator_roles")).To(Equal(StatusSkipped))
+		Expect(status(manifest, "oidc_provider")).To(Equal(StatusSkipped))
+		Expect(manifest.Failed()).To(BeEmpty())
+		Expect(files).To(HaveKey(ManifestFileName))
+	})
+
+	It("Records why the AWS diagnostics can't be collected when there is no AWS client", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.AWS(cmv1.NewAWS().
+				STS(cmv1.NewSTS().
+					RoleARN("arn:aws:iam::123456789012:role/prefix-Installer-Role")))
+		})
+		h.OCM.RouteToHandler(http.MethodGet, clustersPath+"/logs/install",
+			RespondWithJSON(http.StatusOK, `{"kind": "Log", "content": ""}`))
+		h.OCM.RouteToHandler(http.MethodGet, clustersPath+"/inflight_checks",
+			RespondWithJSON(http.StatusOK, `{"kind": "InflightCheckList", "items": []}`))
+		h.OCM.RouteToHandler(http.MethodGet, clustersPath+"/limited_support_reasons",
+			RespondWithJSON(http.StatusOK, `{"kind": "LimitedSupportReasonList", "items": []}`))
+		awsError = fmt.Errorf("Failed to create AWS client: no credentials")
+
+		manifest, files := write(cluster, nil)
+
+		Expect(status(manifest, "cluster")).To(Equal(StatusCollected))
+		Expect(manifest.Failed()).To(ConsistOf(
+			Collection{Name: "account_roles", Status: StatusFailed, Reason: awsError.Error()},
+			Collection{Name: "operator_roles", Status: StatusFailed, Reason: awsError.Error()},
+			Collection{Name: "oidc_provider", Status: StatusFailed, Reason: awsError.Error()},
+		))
+		Expect(files).To(HaveKey(ManifestFileName))
+	})
+})
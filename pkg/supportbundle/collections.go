@@ -0,0 +1,243 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportbundle
+
+import (
+	"encoding/json"
+	"fmt"
+
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// item is a piece of diagnostics that is written to one file of the bundle.
+type item struct {
+	name    string
+	file    string
+	skip    string
+	collect func() ([]byte, error)
+}
+
+// roleState is the state of an IAM role of the cluster as written to the bundle.
+type roleState struct {
+	Role             iamtypes.Role `json:"role"`
+	AttachedPolicies []string      `json:"attached_policies"`
+}
+
+// oidcProviderState is the state of the OIDC provider of the cluster as written to the bundle.
+type oidcProviderState struct {
+	IssuerURL   string `json:"issuer_url"`
+	ProviderARN string `json:"provider_arn,omitempty"`
+	Exists      bool   `json:"exists"`
+}
+
+// items returns the diagnostics that are collected for the cluster, in the order they are written
+// to the bundle.
+func (c *Collector) items() []item {
+	cluster := c.cluster
+	items := []item{
+		{
+			name: "cluster",
+			file: "cluster.json",
+			collect: func() ([]byte, error) {
+				return marshal(cluster, cmv1.MarshalCluster)
+			},
+		},
+		{
+			name: "install_logs",
+			file: "logs/install.log",
+			collect: func() ([]byte, error) {
+				logs, err := c.ocmClient.GetInstallLogs(cluster.ID(), 0)
+				if err != nil {
+					return nil, err
+				}
+				return []byte(logs.Content()), nil
+			},
+		},
+		{
+			name: "uninstall_logs",
+			file: "logs/uninstall.log",
+			skip: skipUnless(cluster.State() == cmv1.ClusterStateUninstalling,
+				"The cluster isn't uninstalling"),
+			collect: func() ([]byte, error) {
+				logs, err := c.ocmClient.GetUninstallLogs(cluster.ID(), 0)
+				if err != nil {
+					return nil, err
+				}
+				return []byte(logs.Content()), nil
+			},
+		},
+		{
+			name: "inflight_checks",
+			file: "inflight_checks.json",
+			collect: func() ([]byte, error) {
+				checks, err := c.ocmClient.GetInflightChecks(cluster.ID())
+				if err != nil {
+					return nil, err
+				}
+				return marshal(checks, cmv1.MarshalInflightCheckList)
+			},
+		},
+		{
+			name: "limited_support_reasons",
+			file: "limited_support_reasons.json",
+			collect: func() ([]byte, error) {
+				reasons, err := c.ocmClient.GetLimitedSupportReasons(cluster.ID())
+				if err != nil {
+					return nil, err
+				}
+				return marshal(reasons, cmv1.MarshalLimitedSupportReasonList)
+			},
+		},
+	}
+
+	subnets := cluster.AWS().SubnetIDs()
+	if len(subnets) == 0 {
+		items = append(items, item{
+			name: "subnet_verifications",
+			skip: "The cluster doesn't use existing subnets",
+		})
+	}
+	for _, subnet := range subnets {
+		subnet := subnet
+		items = append(items, item{
+			name: "subnet_verification/" + subnet,
+			file: "network_verifications/" + subnet + ".json",
+			collect: func() ([]byte, error) {
+				verification, err := c.ocmClient.GetVerifyNetworkSubnet(subnet)
+				if err != nil {
+					return nil, err
+				}
+				return marshal(verification, cmv1.MarshalSubnetNetworkVerification)
+			},
+		})
+	}
+
+	return append(items, c.awsItems()...)
+}
+
+// awsItems returns the diagnostics collected from AWS: the state of the account roles, of the
+// operator roles and of the OIDC provider of the cluster.
+func (c *Collector) awsItems() []item {
+	sts := c.cluster.AWS().STS()
+	skip := ""
+	switch {
+	case c.awsClient == nil && c.awsError == nil:
+		skip = "There is no AWS client"
+	case sts.RoleARN() == "":
+		skip = "The cluster doesn't use STS"
+	}
+	if skip != "" {
+		return []item{
+			{name: "account_roles", skip: skip},
+			{name: "operator_roles", skip: skip},
+			{name: "oidc_provider", skip: skip},
+		}
+	}
+	if c.awsClient == nil {
+		fail := func() ([]byte, error) {
+			return nil, c.awsError
+		}
+		return []item{
+			{name: "account_roles", collect: fail},
+			{name: "operator_roles", collect: fail},
+			{name: "oidc_provider", collect: fail},
+		}
+	}
+
+	var items []item
+	accountRoles := []struct {
+		name string
+		arn  string
+	}{
+		{"installer", sts.RoleARN()},
+		{"support", sts.SupportRoleARN()},
+		{"control_plane", sts.InstanceIAMRoles().MasterRoleARN()},
+		{"worker", sts.InstanceIAMRoles().WorkerRoleARN()},
+	}
+	for _, role := range accountRoles {
+		if role.arn == "" {
+			continue
+		}
+		items = append(items, c.roleItem("account_role/"+role.name, "aws/account_roles/"+role.name+".json",
+			role.arn))
+	}
+	for _, role := range sts.OperatorIAMRoles() {
+		name := role.Namespace() + "-" + role.Name()
+		items = append(items, c.roleItem("operator_role/"+name, "aws/operator_roles/"+name+".json",
+			role.RoleARN()))
+	}
+
+	issuerURL := sts.OIDCEndpointURL()
+	if sts.OidcConfig() != nil && sts.OidcConfig().IssuerUrl() != "" {
+		issuerURL = sts.OidcConfig().IssuerUrl()
+	}
+	return append(items, item{
+		name: "oidc_provider",
+		file: "aws/oidc_provider.json",
+		skip: skipUnless(issuerURL != "", "The cluster has no OIDC issuer"),
+		collect: func() ([]byte, error) {
+			arn, err := c.awsClient.GetOpenIDConnectProviderByOidcEndpointUrl(issuerURL)
+			if err != nil {
+				return nil, err
+			}
+			return indentJSON(oidcProviderState{
+				IssuerURL:   issuerURL,
+				ProviderARN: arn,
+				Exists:      arn != "",
+			})
+		},
+	})
+}
+
+func (c *Collector) roleItem(name string, file string, arn string) item {
+	return item{
+		name: name,
+		file: file,
+		collect: func() ([]byte, error) {
+			role, err := c.awsClient.GetRoleByARN(arn)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to get role '%s': %v", arn, err)
+			}
+			state := roleState{
+				Role: role,
+			}
+			if role.RoleName != nil {
+				state.AttachedPolicies, err = c.awsClient.ListAttachedRolePolicies(*role.RoleName)
+				if err != nil {
+					return nil, fmt.Errorf("Failed to list policies of role '%s': %v", arn, err)
+				}
+			}
+			return indentJSON(state)
+		},
+	}
+}
+
+func skipUnless(condition bool, reason string) string {
+	if condition {
+		return ""
+	}
+	return reason
+}
+
+func indentJSON(value interface{}) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return indent(data)
+}
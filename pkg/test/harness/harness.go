@@ -0,0 +1,223 @@
+// Package harness runs the commands of the ROSA CLI in-process, against a fake OCM server and an
+// AWS client backed by the mocks of the AWS API clients, capturing the standard output and error
+// streams. It can be used to test tools that wrap the commands, for example:
+//
+//	h := harness.New(GinkgoT())
+//	DeferCleanup(h.Close)
+//	h.OCM.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/clusters",
+//		RespondWithJSON(http.StatusOK, test.FormatClusterList(clusters)))
+//	result := h.Run(cluster.Cmd, "--output", "json")
+//	Expect(result.ExitCode).To(Equal(0))
+//
+// The handlers of the OCM server and the expectations of the mocks use Gomega, so a fail handler
+// needs to be registered, as Ginkgo does. Commands that exit with 'cmdcontext.Exit' are stopped and
+// their exit code is returned in the result, the process keeps running.
+package harness
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/onsi/gomega/ghttp"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/mocks"
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosalogging "github.com/openshift/rosa/pkg/logging"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	// AccountID is the AWS account of the default creator
+	AccountID = "123456789012"
+	// Region is the region of the AWS client
+	Region = "us-east-1"
+)
+
+// Harness contains the fake servers and clients used by the commands it runs. Don't create
+// instances of this type directly; use the New function instead.
+type Harness struct {
+	// OCM is the fake OCM API server. Requests without a handler get a 500 response.
+	OCM *ghttp.Server
+
+	// Mocks of the AWS API clients used by AWSClient
+	IAM            *mocks.MockIamApiClient
+	EC2            *mocks.MockEc2ApiClient
+	Organizations  *mocks.MockOrganizationsApiClient
+	S3             *mocks.MockS3ApiClient
+	SecretsManager *mocks.MockSecretsManagerApiClient
+	STS            *mocks.MockStsApiClient
+	CloudFormation *mocks.MockCloudFormationApiClient
+	ServiceQuotas  *mocks.MockServiceQuotasApiClient
+	IAMQuotas      *mocks.MockServiceQuotasApiClient
+
+	// Clients injected in the runtimes of the commands
+	OCMClient *ocm.Client
+	AWSClient aws.Client
+	Creator   *aws.Creator
+
+	lock        sync.Mutex
+	restore     func()
+	restoreExit func()
+}
+
+// Result is the outcome of running a command.
+type Result struct {
+	Stdout string
+	Stderr string
+	// ExitCode is the code the command exited with, zero when it finished without exiting
+	ExitCode int
+	// Err is the error returned by cobra, for example when a flag is invalid
+	Err error
+}
+
+// exitCode is the value of the panic used to stop a command that exits.
+type exitCode int
+
+// New creates the fake OCM server and the AWS mocks and injects the clients that use them in the
+// runtimes of the commands. Call Close once the harness is no longer needed.
+func New(t gomock.TestReporter) *Harness {
+	h := &Harness{}
+	h.OCM = ghttp.NewServer()
+	h.OCM.SetAllowUnhandledRequests(true)
+	h.OCM.SetUnhandledRequestStatusCode(http.StatusInternalServerError)
+
+	logger, err := logging.NewGoLoggerBuilder().Build()
+	if err != nil {
+		panic(err)
+	}
+	connection, err := sdk.NewConnectionBuilder().
+		Logger(logger).
+		Tokens(MakeTokenString("Bearer", 15*time.Minute)).
+		URL(h.OCM.URL()).
+		Build()
+	if err != nil {
+		panic(err)
+	}
+	h.OCMClient = ocm.NewClientWithConnection(connection)
+
+	ctrl := gomock.NewController(t)
+	h.IAM = mocks.NewMockIamApiClient(ctrl)
+	h.EC2 = mocks.NewMockEc2ApiClient(ctrl)
+	h.Organizations = mocks.NewMockOrganizationsApiClient(ctrl)
+	h.S3 = mocks.NewMockS3ApiClient(ctrl)
+	h.SecretsManager = mocks.NewMockSecretsManagerApiClient(ctrl)
+	h.STS = mocks.NewMockStsApiClient(ctrl)
+	h.CloudFormation = mocks.NewMockCloudFormationApiClient(ctrl)
+	h.ServiceQuotas = mocks.NewMockServiceQuotasApiClient(ctrl)
+	h.IAMQuotas = mocks.NewMockServiceQuotasApiClient(ctrl)
+	h.AWSClient = aws.New(awssdk.Config{Region: Region}, rosalogging.NewLogger(),
+		h.IAM, h.EC2, h.Organizations, h.S3, h.SecretsManager, h.STS, h.CloudFormation,
+		h.ServiceQuotas, h.IAMQuotas, nil, false)
+	h.Creator = &aws.Creator{
+		ARN:       fmt.Sprintf("arn:aws:iam::%s:user/test", AccountID),
+		AccountID: AccountID,
+	}
+
+	h.restore = rosa.Inject(rosa.Injection{
+		OCMClient: h.OCMClient,
+		AWSClient: h.AWSClient,
+		Creator:   h.Creator,
+	})
+	h.restoreExit = cmdcontext.SetExit(func(code int) {
+		panic(exitCode(code))
+	})
+	return h
+}
+
+// Run runs the command with the given arguments, which don't include the names of the command and
+// its parents. The flags of the command are reset to their defaults first, so that the values of
+// a previous run don't leak into the next one.
+func (h *Harness) Run(cmd *cobra.Command, args ...string) (result Result) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for c := cmd; c != nil; c = c.Parent() {
+		resetFlags(c.Flags())
+		resetFlags(c.PersistentFlags())
+	}
+	path := []string{}
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		path = append([]string{c.Name()}, path...)
+	}
+	root := cmd.Root()
+	root.SetArgs(append(path, args...))
+	defer ocm.SetClusterKey("")
+
+	stdout, stderr := os.Stdout, os.Stderr
+	stdoutReader, stdoutWriter, err := os.Pipe()
+	if err != nil {
+		return Result{Err: err}
+	}
+	stderrReader, stderrWriter, err := os.Pipe()
+	if err != nil {
+		return Result{Err: err}
+	}
+	os.Stdout, os.Stderr = stdoutWriter, stderrWriter
+
+	var wait sync.WaitGroup
+	wait.Add(2)
+	go readAll(&wait, stdoutReader, &result.Stdout)
+	go readAll(&wait, stderrReader, &result.Stderr)
+	defer func() {
+		os.Stdout, os.Stderr = stdout, stderr
+		stdoutWriter.Close()
+		stderrWriter.Close()
+		wait.Wait()
+	}()
+
+	defer func() {
+		if value := recover(); value != nil {
+			code, ok := value.(exitCode)
+			if !ok {
+				panic(value)
+			}
+			result.ExitCode = int(code)
+		}
+	}()
+	_, result.Err = root.ExecuteC()
+	return
+}
+
+// Close removes the injected clients and stops the fake OCM server.
+func (h *Harness) Close() {
+	h.restoreExit()
+	h.restore()
+	h.OCMClient.Close()
+	h.OCM.Close()
+}
+
+func readAll(wait *sync.WaitGroup, reader io.ReadCloser, result *string) {
+	defer wait.Done()
+	defer reader.Close()
+	data, _ := io.ReadAll(reader)
+	*result = string(data)
+}
+
+func resetFlags(flags *pflag.FlagSet) {
+	flags.VisitAll(func(flag *pflag.Flag) {
+		if value, ok := flag.Value.(pflag.SliceValue); ok {
+			defaults := []string{}
+			if trimmed := strings.Trim(flag.DefValue, "[]"); trimmed != "" {
+				defaults = strings.Split(trimmed, ",")
+			}
+			_ = value.Replace(defaults)
+		} else {
+			_ = flag.Value.Set(flag.DefValue)
+		}
+		flag.Changed = false
+	})
+}
@@ -0,0 +1,94 @@
+package harness_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	describecluster "github.com/openshift/rosa/cmd/describe/cluster"
+	"github.com/openshift/rosa/cmd/list"
+	"github.com/openshift/rosa/cmd/list/machinepool"
+	"github.com/openshift/rosa/cmd/list/version"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+	"github.com/openshift/rosa/pkg/test"
+	"github.com/openshift/rosa/pkg/test/harness"
+)
+
+var _ = Describe("Harness", func() {
+	var h *harness.Harness
+
+	BeforeEach(func() {
+		h = harness.New(GinkgoT())
+		DeferCleanup(h.Close)
+	})
+
+	It("Runs a command that creates its own runtime", func() {
+		v, err := cmv1.NewVersion().ID("openshift-v4.15.1").RawID("4.15.1").Enabled(true).Default(true).Build()
+		Expect(err).NotTo(HaveOccurred())
+		h.OCM.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/versions",
+			RespondWithJSON(http.StatusOK, test.FormatVersionList([]*cmv1.Version{v})))
+
+		result := h.Run(version.Cmd)
+		Expect(result.Err).NotTo(HaveOccurred())
+		Expect(result.ExitCode).To(Equal(0))
+		Expect(result.Stdout).To(ContainSubstring("4.15.1"))
+		Expect(result.Stdout).To(ContainSubstring("yes"))
+		Expect(version.Cmd.Parent()).To(Equal(list.Cmd))
+	})
+
+	It("Captures the exit code and the error of a failing command", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateInstalling)
+		})
+		h.OCM.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/clusters",
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{cluster})))
+
+		result := h.Run(machinepool.NewListMachinePoolCommand(), "--cluster", "my-cluster")
+		Expect(result.ExitCode).To(Equal(1))
+		Expect(result.Stdout).To(BeEmpty())
+		Expect(result.Stderr).To(ContainSubstring("Cluster 'my-cluster' is not yet ready"))
+	})
+
+	It("Captures the exit code of a command that exits without returning", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateReady)
+		})
+		h.OCM.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/clusters",
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{cluster})))
+
+		result := h.Run(describecluster.Cmd, "--cluster", "my-cluster")
+		Expect(result.ExitCode).To(Equal(1))
+		Expect(result.Stderr).To(ContainSubstring("Failed to get scheduled upgrades for cluster 'my-cluster'"))
+	})
+
+	It("Exits with the code of the category of the error in commands that report it themselves", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateReady)
+		})
+		h.OCM.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/clusters",
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{cluster})))
+		h.OCM.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/clusters/"+cluster.ID()+"/upgrade_policies",
+			RespondWithJSON(http.StatusServiceUnavailable, `{
+				"kind": "Error",
+				"status": 503,
+				"code": "CLUSTERS-MGMT-503",
+				"reason": "Service unavailable"
+			}`))
+
+		result := h.Run(describecluster.Cmd, "--cluster", "my-cluster")
+		Expect(result.ExitCode).To(Equal(rosaerrors.CategoryOCMServer.ExitCode()))
+		Expect(result.Stderr).To(ContainSubstring(
+			"Failed to get scheduled upgrades for cluster 'my-cluster': Service unavailable"))
+	})
+
+	It("Resets the flags between runs", func() {
+		cmd := machinepool.NewListMachinePoolCommand()
+		Expect(cmd.Flags().Set("cluster", "my-cluster")).To(Succeed())
+		result := h.Run(cmd)
+		Expect(result.Err).To(MatchError(ContainSubstring(`required flag(s) "cluster" not set`)))
+		Expect(result.ExitCode).To(Equal(0))
+	})
+})
@@ -260,6 +260,36 @@ func FormatVersionList(versions []*v1.Version) string {
 	}`, len(versions), len(versions), versionJson.String())
 }
 
+func FormatSTSPolicyList(policies []*v1.AWSSTSPolicy) string {
+	var policyJson bytes.Buffer
+
+	v1.MarshalAWSSTSPolicyList(policies, &policyJson)
+
+	return fmt.Sprintf(`
+	{
+		"kind": "AWSSTSPolicyList",
+		"page": 1,
+		"size": %d,
+		"total": %d,
+		"items": %s
+	}`, len(policies), len(policies), policyJson.String())
+}
+
+func FormatSTSCredentialRequestList(credRequests []*v1.STSCredentialRequest) string {
+	var credRequestJson bytes.Buffer
+
+	v1.MarshalSTSCredentialRequestList(credRequests, &credRequestJson)
+
+	return fmt.Sprintf(`
+	{
+		"kind": "STSCredentialRequestList",
+		"page": 1,
+		"size": %d,
+		"total": %d,
+		"items": %s
+	}`, len(credRequests), len(credRequests), credRequestJson.String())
+}
+
 func FormatIDPList(idps []*v1.IdentityProvider) string {
 	var idpJson bytes.Buffer
 
@@ -357,6 +387,10 @@ func FormatResource(resource interface{}) string {
 		if res, ok := resource.(*v1.BreakGlassCredential); ok {
 			err = v1.MarshalBreakGlassCredential(res, &outputJson)
 		}
+	case "*v1.IdentityProvider":
+		if res, ok := resource.(*v1.IdentityProvider); ok {
+			err = v1.MarshalIdentityProvider(res, &outputJson)
+		}
 	case "*v1.Account":
 		if res, ok := resource.(*amsv1.Account); ok {
 			err = amsv1.MarshalAccount(res, &outputJson)
@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package timings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const middlewareID = "RosaTimings"
+
+// throttles detects the throttling errors, with the same error codes that the retryer of the AWS
+// clients retries.
+var throttles = retry.IsErrorThrottles(retry.DefaultThrottles)
+
+// AddMiddleware adds to the given stack of an AWS client the middleware that measures each
+// operation, including its retries. It is intended for the API options of the AWS configuration.
+func AddMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(middlewareID, handleInitialize),
+		middleware.After)
+}
+
+func handleInitialize(ctx context.Context, in middleware.InitializeInput,
+	next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	r := current()
+	if r == nil {
+		return next.HandleInitialize(ctx, in)
+	}
+	start := r.now()
+	out, metadata, err := next.HandleInitialize(ctx, in)
+	duration := r.now().Sub(start)
+
+	// The retry middleware of the client saves the result of each attempt:
+	retries, throttled := 0, 0
+	if results, ok := retry.GetAttemptResults(metadata); ok {
+		for _, result := range results.Results {
+			if result.Retried {
+				retries++
+			}
+			if result.Err != nil && throttles.IsErrorThrottle(result.Err) == aws.TrueTernary {
+				throttled++
+			}
+		}
+	}
+	name := fmt.Sprintf("AWS %s.%s", awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx))
+	r.record(name, duration, retries, throttled, true)
+	return out, metadata, err
+}
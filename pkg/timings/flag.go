@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to implement the '--timings' command line option.
+
+package timings
+
+import (
+	"github.com/spf13/pflag"
+)
+
+var enabled bool
+
+// AddFlag adds the timings flag to the given set of command line flags.
+func AddFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&enabled,
+		"timings",
+		false,
+		"Print to the standard error stream, when the command finishes, a table with the number of "+
+			"calls, latency, retries and throttling events of each OCM endpoint and AWS operation "+
+			"called. Identifiers in OCM paths are replaced with '{id}'.",
+	)
+}
+
+// Start starts measuring the calls if the timings flag is set.
+func Start() {
+	lock.Lock()
+	defer lock.Unlock()
+	if enabled && active == nil {
+		active = newRecorder()
+	}
+}
+
+// Enabled returns true if the calls are being measured.
+func Enabled() bool {
+	return current() != nil
+}
+
+// SetEnabled sets the value of the timings flag.
+func SetEnabled(value bool) {
+	enabled = value
+}
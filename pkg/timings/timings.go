@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package timings measures the OCM and AWS calls sent by the command and prints a summary table
+// when it finishes, to help spotting commands that send more calls than needed.
+package timings
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// stats contains the measurements of the calls to one endpoint or operation.
+type stats struct {
+	name      string
+	calls     int
+	total     time.Duration
+	max       time.Duration
+	retries   int
+	throttles int
+}
+
+// recorder collects the measurements of the process.
+type recorder struct {
+	now   func() time.Time
+	lock  sync.Mutex
+	stats map[string]*stats
+
+	// pending contains the OCM requests whose last attempt failed in a way that makes the SDK
+	// send them again.
+	pending map[*http.Request]struct{}
+}
+
+var (
+	lock   sync.Mutex
+	active *recorder
+)
+
+func current() *recorder {
+	lock.Lock()
+	defer lock.Unlock()
+	return active
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		now:     time.Now,
+		stats:   map[string]*stats{},
+		pending: map[*http.Request]struct{}{},
+	}
+}
+
+// record adds an attempt to the measurements of the given endpoint or operation. Attempts that
+// are retries of a previous one aren't counted as calls, but their time is added to the total.
+func (r *recorder) record(name string, duration time.Duration, retries int, throttles int, call bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	item, ok := r.stats[name]
+	if !ok {
+		item = &stats{name: name}
+		r.stats[name] = item
+	}
+	if call {
+		item.calls++
+	}
+	item.total += duration
+	if duration > item.max {
+		item.max = duration
+	}
+	item.retries += retries
+	item.throttles += throttles
+}
+
+// Finish writes the summary table to the standard error stream, so that it doesn't mix with the
+// output of the command, and stops measuring. It must be called before the process exits.
+func Finish() {
+	lock.Lock()
+	r := active
+	active = nil
+	lock.Unlock()
+	if r == nil {
+		return
+	}
+	r.print(os.Stderr)
+}
+
+// print writes the summary table, with the endpoints that took longer first.
+func (r *recorder) print(writer io.Writer) {
+	r.lock.Lock()
+	items := make([]*stats, 0, len(r.stats))
+	for _, item := range r.stats {
+		items = append(items, item)
+	}
+	r.lock.Unlock()
+	if len(items) == 0 {
+		fmt.Fprintf(writer, "No OCM or AWS calls were sent\n")
+		return
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].total != items[j].total {
+			return items[i].total > items[j].total
+		}
+		return items[i].name < items[j].name
+	})
+
+	var calls, retries, throttles int
+	var total time.Duration
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(table, "CALL\tCOUNT\tTOTAL\tAVG\tMAX\tRETRIES\tTHROTTLED\n")
+	for _, item := range items {
+		fmt.Fprintf(table, "%s\t%d\t%s\t%s\t%s\t%d\t%d\n",
+			item.name,
+			item.calls,
+			format(item.total),
+			format(average(item.total, item.calls)),
+			format(item.max),
+			item.retries,
+			item.throttles,
+		)
+		calls += item.calls
+		retries += item.retries
+		throttles += item.throttles
+		total += item.total
+	}
+	fmt.Fprintf(table, "TOTAL\t%d\t%s\t\t\t%d\t%d\n", calls, format(total), retries, throttles)
+	table.Flush()
+}
+
+func average(total time.Duration, calls int) time.Duration {
+	if calls == 0 {
+		return total
+	}
+	return total / time.Duration(calls)
+}
+
+func format(value time.Duration) string {
+	return value.Round(time.Millisecond).String()
+}
@@ -0,0 +1,162 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package timings
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/smithy-go/middleware"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeTransport is a round tripper that returns the responses with the given codes in order, and
+// advances the clock of the recorder by a second for each one.
+type fakeTransport struct {
+	clock *time.Time
+	codes []int
+}
+
+func (t *fakeTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	*t.clock = t.clock.Add(time.Second)
+	code := t.codes[0]
+	t.codes = t.codes[1:]
+	return &http.Response{
+		StatusCode: code,
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    request,
+	}, nil
+}
+
+func summary() string {
+	buffer := &bytes.Buffer{}
+	current().print(buffer)
+	return buffer.String()
+}
+
+var _ = Describe("Timings", func() {
+	var clock time.Time
+
+	BeforeEach(func() {
+		SetEnabled(true)
+		Start()
+		clock = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		current().now = func() time.Time {
+			return clock
+		}
+		DeferCleanup(func() {
+			lock.Lock()
+			active = nil
+			lock.Unlock()
+			SetEnabled(false)
+		})
+	})
+
+	It("Doesn't measure when the flag isn't set", func() {
+		lock.Lock()
+		active = nil
+		lock.Unlock()
+		SetEnabled(false)
+		Start()
+		Expect(Enabled()).To(BeFalse())
+	})
+
+	It("Groups OCM calls by endpoint and counts the retries of the SDK", func() {
+		transport := Wrap(&fakeTransport{
+			clock: &clock,
+			codes: []int{200, 200, http.StatusTooManyRequests, 200, 404},
+		})
+		send := func(method, url string) *http.Request {
+			request, err := http.NewRequest(method, url, nil)
+			Expect(err).ToNot(HaveOccurred())
+			response, err := transport.RoundTrip(request)
+			Expect(err).ToNot(HaveOccurred())
+			response.Body.Close()
+			return request
+		}
+		send(http.MethodGet, "https://api.example.com/api/clusters_mgmt/v1/clusters/123")
+		send(http.MethodGet, "https://api.example.com/api/clusters_mgmt/v1/clusters/456")
+		throttled := send(http.MethodGet, "https://api.example.com/api/clusters_mgmt/v1/clusters/789/addons")
+
+		// The SDK sends the same request again:
+		response, err := transport.RoundTrip(throttled)
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+		send(http.MethodGet, "https://api.example.com/api/clusters_mgmt/v1/clusters/789/addons")
+
+		Expect(summary()).To(Equal(
+			"CALL                                                COUNT  TOTAL  AVG   MAX  RETRIES  THROTTLED\n" +
+				"OCM GET /api/clusters_mgmt/v1/clusters/{id}/addons  2      3s     1.5s  1s   1        1\n" +
+				"OCM GET /api/clusters_mgmt/v1/clusters/{id}         2      2s     1s    1s   0        0\n" +
+				"TOTAL                                               4      5s                1        1\n",
+		))
+	})
+
+	It("Counts the retries and throttling errors of AWS operations", func() {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.Header().Set("Content-Type", "text/xml")
+			if attempts == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code>` +
+					`<Message>Rate exceeded</Message></Error><RequestId>1</RequestId></ErrorResponse>`))
+				return
+			}
+			_, _ = w.Write([]byte(`<GetRoleResponse><GetRoleResult><Role><RoleName>r</RoleName></Role>` +
+				`</GetRoleResult></GetRoleResponse>`))
+		}))
+		defer server.Close()
+		client := iam.New(iam.Options{
+			Region:       "us-east-1",
+			BaseEndpoint: aws.String(server.URL),
+			Credentials:  credentials.NewStaticCredentialsProvider("AKIATEST", "secret", ""),
+			APIOptions:   []func(*middleware.Stack) error{AddMiddleware},
+			Retryer: retry.NewStandard(func(options *retry.StandardOptions) {
+				options.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+					return 0, nil
+				})
+			}),
+		})
+
+		_, err := client.GetRole(context.Background(), &iam.GetRoleInput{
+			RoleName: aws.String("r"),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(attempts).To(Equal(2))
+
+		item := current().stats["AWS IAM.GetRole"]
+		Expect(item).ToNot(BeNil())
+		Expect(item.calls).To(Equal(1))
+		Expect(item.retries).To(Equal(1))
+		Expect(item.throttles).To(Equal(1))
+	})
+
+	It("Says when there were no calls", func() {
+		Expect(summary()).To(Equal("No OCM or AWS calls were sent\n"))
+	})
+})
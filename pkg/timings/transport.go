@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package timings
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/openshift/rosa/pkg/ocm/endpoint"
+)
+
+// Wrap returns a round tripper that measures the OCM requests sent with the given one. It is
+// intended for the transport wrapper of the OCM connection, and it must be the first wrapper, so
+// that it receives the retries of the SDK as the same request.
+func Wrap(next http.RoundTripper) http.RoundTripper {
+	return &roundTripper{next: next}
+}
+
+type roundTripper struct {
+	next http.RoundTripper
+}
+
+func (t *roundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	r := current()
+	if r == nil {
+		return t.next.RoundTrip(request)
+	}
+	name := fmt.Sprintf("OCM %s %s", request.Method, endpoint.Parse(request.URL.Path).Template)
+	retry := r.retried(request)
+	start := r.now()
+	response, err := t.next.RoundTrip(request)
+	duration := r.now().Sub(start)
+
+	retries, throttles := 0, 0
+	if retry {
+		retries = 1
+	}
+	if err == nil && response.StatusCode == http.StatusTooManyRequests {
+		throttles = 1
+	}
+	r.record(name, duration, retries, throttles, !retry)
+	if willRetry(request, response, err) {
+		r.lock.Lock()
+		r.pending[request] = struct{}{}
+		r.lock.Unlock()
+	}
+	return response, err
+}
+
+// retried checks if the given request was sent before and failed in a way that makes the SDK
+// send it again.
+func (r *recorder) retried(request *http.Request) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, ok := r.pending[request]
+	delete(r.pending, request)
+	return ok
+}
+
+// willRetry checks if the retry wrapper of the OCM SDK will send the request again, using the
+// same rules. It doesn't know the retry limit, so the last attempt may be wrongly considered
+// retryable, which is harmless.
+func willRetry(request *http.Request, response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch code := response.StatusCode; {
+	case code == http.StatusServiceUnavailable || code == http.StatusTooManyRequests:
+		return true
+	case code >= http.StatusInternalServerError && request.Method == http.MethodGet:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+
+	"github.com/openshift/rosa/pkg/redact"
+)
+
+const middlewareID = "RosaTracing"
+
+// AddMiddleware adds to the given stack of an AWS client the middleware that records a span for
+// each operation. It is intended for the API options of the AWS configuration.
+func AddMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(middlewareID, handleInitialize),
+		middleware.After)
+}
+
+func handleInitialize(ctx context.Context, in middleware.InitializeInput,
+	next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	service := awsmiddleware.GetServiceID(ctx)
+	operation := awsmiddleware.GetOperationName(ctx)
+	ctx, span := Start(ctx, fmt.Sprintf("AWS %s.%s", service, operation), KindClient)
+	if span == nil {
+		return next.HandleInitialize(ctx, in)
+	}
+	defer span.End()
+	span.SetAttribute("aws.service", service)
+	span.SetAttribute("aws.operation", operation)
+	if region := awsmiddleware.GetRegion(ctx); region != "" {
+		span.SetAttribute("aws.region", region)
+	}
+	for _, field := range resourceFields(in.Parameters) {
+		span.SetAttribute("aws.input."+field.name, field.value)
+	}
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+	if response, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && response.StatusCode != 0 {
+		span.SetAttribute("http.status_code", response.StatusCode)
+	}
+	span.SetError(err)
+	return out, metadata, err
+}
+
+type resourceField struct {
+	name  string
+	value string
+}
+
+// resourceFields returns the string fields of the input of an operation that name the resources
+// it works with, like 'RoleName', 'PolicyArn' or 'SubnetId'.
+func resourceFields(input interface{}) []resourceField {
+	value := reflect.ValueOf(input)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	redactor := redact.Current()
+	var result []resourceField
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() || !isResourceName(field.Name) {
+			continue
+		}
+		text := value.Field(i)
+		if text.Kind() == reflect.Ptr {
+			if text.IsNil() {
+				continue
+			}
+			text = text.Elem()
+		}
+		if text.Kind() != reflect.String || text.String() == "" {
+			continue
+		}
+		// Some names match the suffixes, like 'AccessKeyId', and are secrets:
+		if redactor.Field(field.Name) {
+			continue
+		}
+		result = append(result, resourceField{
+			name:  field.Name,
+			value: redactor.Text(text.String()),
+		})
+	}
+	return result
+}
+
+func isResourceName(name string) bool {
+	for _, suffix := range []string{"Name", "Arn", "ARN", "Id"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
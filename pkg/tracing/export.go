@@ -0,0 +1,303 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/rosa/pkg/info"
+)
+
+// Environment variables of the OpenTelemetry specification that configure the OTLP exporter.
+const (
+	endpointEnvVar       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	tracesEndpointEnvVar = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	headersEnvVar        = "OTEL_EXPORTER_OTLP_HEADERS"
+	protocolEnvVar       = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	serviceNameEnvVar    = "OTEL_SERVICE_NAME"
+)
+
+// jsonProtocol is the only OTLP protocol supported, as the protobuf encoding isn't available.
+const jsonProtocol = "http/json"
+
+const exportTimeout = 10 * time.Second
+
+// exporter sends finished spans somewhere.
+type exporter interface {
+	export(span *Span) error
+	close() error
+}
+
+// configuredExporters returns the exporters selected by the '--trace-file' flag and the OTLP
+// environment variables.
+func configuredExporters() ([]exporter, error) {
+	err := Validate()
+	if err != nil {
+		return nil, err
+	}
+	var exporters []exporter
+	if traceFile != "" {
+		file, err := os.OpenFile(traceFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to create trace file: %v", err)
+		}
+		exporters = append(exporters, &fileExporter{writer: file})
+	}
+	if url := otlpEndpoint(); url != "" {
+		exporters = append(exporters, &otlpExporter{
+			url:     url,
+			headers: headers(),
+			client:  &http.Client{Timeout: exportTimeout},
+		})
+	}
+	return exporters, nil
+}
+
+func otlpEndpoint() string {
+	if url := os.Getenv(tracesEndpointEnvVar); url != "" {
+		return url
+	}
+	if url := os.Getenv(endpointEnvVar); url != "" {
+		return strings.TrimSuffix(url, "/") + "/v1/traces"
+	}
+	return ""
+}
+
+// headers parses the headers of the OTLP requests, in the 'name1=value1,name2=value2' format.
+func headers() http.Header {
+	result := http.Header{}
+	for _, pair := range strings.Split(os.Getenv(headersEnvVar), ",") {
+		name, value, ok := strings.Cut(pair, "=")
+		if ok && strings.TrimSpace(name) != "" {
+			result.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+		}
+	}
+	return result
+}
+
+// fileExporter writes each span to a file as soon as it ends, as a line containing an OTLP export
+// request, the format read by the file receiver of the OpenTelemetry collector. Writing the spans
+// one by one keeps them when the process exits without ending all of them.
+type fileExporter struct {
+	lock   sync.Mutex
+	writer io.WriteCloser
+}
+
+func (e *fileExporter) export(span *Span) error {
+	data, err := json.Marshal(encode([]*Span{span}))
+	if err != nil {
+		return err
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	_, err = e.writer.Write(append(data, '\n'))
+	return err
+}
+
+func (e *fileExporter) close() error {
+	return e.writer.Close()
+}
+
+// otlpExporter sends the spans to an OpenTelemetry collector using the OTLP protocol over HTTP
+// with the JSON encoding. Spans are sent together when the command finishes.
+type otlpExporter struct {
+	url     string
+	headers http.Header
+	client  *http.Client
+
+	lock  sync.Mutex
+	spans []*Span
+}
+
+func (e *otlpExporter) export(span *Span) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.spans = append(e.spans, span)
+	return nil
+}
+
+func (e *otlpExporter) close() error {
+	e.lock.Lock()
+	spans := e.spans
+	e.spans = nil
+	e.lock.Unlock()
+	if len(spans) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(encode(spans))
+	if err != nil {
+		return err
+	}
+	// The command context may already be cancelled, and the spans are still worth sending:
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	for name, values := range e.headers {
+		request.Header[name] = values
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := e.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+	if response.StatusCode/100 != 2 {
+		return fmt.Errorf("Collector '%s' responded with status %d", e.url, response.StatusCode)
+	}
+	return nil
+}
+
+// The following types are the JSON encoding of the OTLP export request of traces.
+
+type exportRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scopeSpans struct {
+	Scope scope      `json:"scope"`
+	Spans []spanData `json:"spans"`
+}
+
+type scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type spanData struct {
+	TraceID           string     `json:"traceId"`
+	SpanID            string     `json:"spanId"`
+	ParentSpanID      string     `json:"parentSpanId,omitempty"`
+	Name              string     `json:"name"`
+	Kind              int        `json:"kind"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string     `json:"endTimeUnixNano"`
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	Status            *status    `json:"status,omitempty"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+type status struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+const statusError = 2
+
+func encode(spans []*Span) exportRequest {
+	serviceName := os.Getenv(serviceNameEnvVar)
+	if serviceName == "" {
+		serviceName = "rosa"
+	}
+	data := make([]spanData, 0, len(spans))
+	for _, span := range spans {
+		data = append(data, encodeSpan(span))
+	}
+	return exportRequest{
+		ResourceSpans: []resourceSpans{{
+			Resource: resource{
+				Attributes: []keyValue{
+					attribute("service.name", serviceName),
+					attribute("service.version", info.Version),
+				},
+			},
+			ScopeSpans: []scopeSpans{{
+				Scope: scope{
+					Name:    "github.com/openshift/rosa",
+					Version: info.Version,
+				},
+				Spans: data,
+			}},
+		}},
+	}
+}
+
+func encodeSpan(span *Span) spanData {
+	span.lock.Lock()
+	defer span.lock.Unlock()
+	result := spanData{
+		TraceID:           span.traceID,
+		SpanID:            span.spanID,
+		ParentSpanID:      span.parentID,
+		Name:              span.name,
+		Kind:              span.kind,
+		StartTimeUnixNano: strconv.FormatInt(span.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.end.UnixNano(), 10),
+	}
+	for _, key := range span.keys {
+		result.Attributes = append(result.Attributes, attribute(key, span.attributes[key]))
+	}
+	if span.err != "" {
+		result.Status = &status{
+			Code:    statusError,
+			Message: span.err,
+		}
+	}
+	return result
+}
+
+func attribute(key string, value interface{}) keyValue {
+	result := keyValue{Key: key}
+	switch typed := value.(type) {
+	case bool:
+		result.Value.BoolValue = &typed
+	case int:
+		text := strconv.Itoa(typed)
+		result.Value.IntValue = &text
+	case int64:
+		text := strconv.FormatInt(typed, 10)
+		result.Value.IntValue = &text
+	case string:
+		result.Value.StringValue = &typed
+	default:
+		text := fmt.Sprint(typed)
+		result.Value.StringValue = &text
+	}
+	return result
+}
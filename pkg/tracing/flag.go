@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to implement the '--trace-file' command line option.
+
+package tracing
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+)
+
+var traceFile string
+
+// AddFlag adds the trace file flag to the given set of command line flags.
+func AddFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&traceFile,
+		"trace-file",
+		"",
+		"Write the OpenTelemetry spans of the command and of the OCM and AWS requests that it "+
+			"sends to this file, in the OTLP JSON format. Spans are also sent to the collector "+
+			"given in the 'OTEL_EXPORTER_OTLP_ENDPOINT' or 'OTEL_EXPORTER_OTLP_TRACES_ENDPOINT' "+
+			"environment variable, with the headers given in 'OTEL_EXPORTER_OTLP_HEADERS'.",
+	)
+}
+
+// SetTraceFile sets the file where the spans are written.
+func SetTraceFile(value string) {
+	traceFile = value
+}
+
+// Validate checks that the OTLP protocol selected in the environment is supported.
+func Validate() error {
+	protocol := os.Getenv(protocolEnvVar)
+	if protocol != "" && protocol != jsonProtocol && otlpEndpoint() != "" {
+		return fmt.Errorf("OTLP protocol '%s' isn't supported, set '%s' to '%s'", protocol,
+			protocolEnvVar, jsonProtocol)
+	}
+	return nil
+}
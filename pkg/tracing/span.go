@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing records how long the command, and each OCM and AWS request it sends, take as
+// OpenTelemetry spans, and exports them in the OTLP JSON encoding to a file or to a collector.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Kinds of spans, with the values of the OTLP protocol.
+const (
+	KindInternal = 1
+	KindClient   = 3
+)
+
+// Attribute names shared by the spans.
+const (
+	ClusterIDAttribute = "rosa.cluster_id"
+	ExitCodeAttribute  = "rosa.exit_code"
+)
+
+// Span is an operation whose duration is recorded. Don't create instances of this type directly;
+// use the Start function instead.
+type Span struct {
+	tracer     *tracer
+	traceID    string
+	spanID     string
+	parentID   string
+	name       string
+	kind       int
+	start      time.Time
+	end        time.Time
+	lock       sync.Mutex
+	attributes map[string]interface{}
+	keys       []string
+	err        string
+	ended      bool
+}
+
+type spanKey struct{}
+
+// Start starts a span that is a child of the span of the given context or, if there is none, of
+// the span of the command. It returns a context that contains the new span. When tracing isn't
+// enabled it returns nil and the given context; all the methods of a nil span do nothing.
+func Start(ctx context.Context, name string, kind int) (context.Context, *Span) {
+	t := current()
+	if t == nil {
+		return ctx, nil
+	}
+	parent := FromContext(ctx)
+	if parent == nil {
+		parent = t.command
+	}
+	span := t.newSpan(parent, name, kind)
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// FromContext returns the span of the given context, or nil if there is none.
+func FromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// SetAttribute adds an attribute to the span. Values can be strings, booleans and integers.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.attributes[key]; !ok {
+		s.keys = append(s.keys, key)
+	}
+	s.attributes[key] = value
+}
+
+// SetError marks the operation of the span as failed.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.err = err.Error()
+}
+
+// End records the end of the operation and exports the span. Calling it more than once has no
+// effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	if s.ended {
+		s.lock.Unlock()
+		return
+	}
+	s.ended = true
+	s.end = s.tracer.now()
+	s.lock.Unlock()
+	s.tracer.export(s)
+}
+
+// TraceParent returns the value of the W3C 'traceparent' header that propagates the span to the
+// servers that receive the requests.
+func (s *Span) TraceParent() string {
+	if s == nil {
+		return ""
+	}
+	return fmt.Sprintf("00-%s-%s-01", s.traceID, s.spanID)
+}
+
+func randomID(bytes int) string {
+	data := make([]byte, bytes)
+	_, err := rand.Read(data)
+	if err != nil {
+		// The identifiers only need to be unique within the trace:
+		copy(data, fmt.Sprintf("%0*x", bytes, time.Now().UnixNano()))
+	}
+	return hex.EncodeToString(data)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/rosa/pkg/info"
+)
+
+// tracer creates the spans of the process and sends them to the exporters.
+type tracer struct {
+	now       func() time.Time
+	exporters []exporter
+	traceID   string
+	command   *Span
+
+	lock      sync.Mutex
+	clusterID string
+}
+
+var (
+	lock   sync.Mutex
+	active *tracer
+)
+
+func current() *tracer {
+	lock.Lock()
+	defer lock.Unlock()
+	return active
+}
+
+// StartCommand starts tracing the command with the given name, if a trace file or an OTLP endpoint
+// is configured. The spans of the OCM and AWS requests are children of the span of the command.
+func StartCommand(name string) error {
+	exporters, err := configuredExporters()
+	if err != nil || len(exporters) == 0 {
+		return err
+	}
+	t := &tracer{
+		now:       time.Now,
+		exporters: exporters,
+		traceID:   randomID(16),
+	}
+	t.command = t.newSpan(nil, name, KindInternal)
+	t.command.SetAttribute("rosa.version", info.Version)
+	if len(os.Args) > 1 {
+		t.command.SetAttribute("rosa.args", strings.Join(os.Args[1:], " "))
+	}
+	lock.Lock()
+	active = t
+	lock.Unlock()
+	return nil
+}
+
+// FinishCommand ends the span of the command, recording the exit code, and sends the spans that
+// haven't been sent yet. It must be called before the process exits.
+func FinishCommand(exitCode int) {
+	lock.Lock()
+	t := active
+	active = nil
+	lock.Unlock()
+	if t == nil {
+		return
+	}
+	t.command.SetAttribute(ExitCodeAttribute, exitCode)
+	if exitCode != 0 {
+		t.command.SetError(fmt.Errorf("Exited with code %d", exitCode))
+	}
+	t.command.End()
+	for _, exporter := range t.exporters {
+		err := exporter.close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to export trace: %v\n", err)
+		}
+	}
+}
+
+// Enabled returns true if the command is being traced.
+func Enabled() bool {
+	return current() != nil
+}
+
+// SetClusterID sets the identifier of the cluster that the command works with. It is added to the
+// span of the command and to the spans started after this call.
+func SetClusterID(value string) {
+	t := current()
+	if t == nil || value == "" {
+		return
+	}
+	t.lock.Lock()
+	t.clusterID = value
+	t.lock.Unlock()
+	t.command.SetAttribute(ClusterIDAttribute, value)
+}
+
+func (t *tracer) newSpan(parent *Span, name string, kind int) *Span {
+	span := &Span{
+		tracer:     t,
+		traceID:    t.traceID,
+		spanID:     randomID(8),
+		name:       name,
+		kind:       kind,
+		start:      t.now(),
+		attributes: map[string]interface{}{},
+	}
+	if parent != nil {
+		span.parentID = parent.spanID
+	}
+	t.lock.Lock()
+	clusterID := t.clusterID
+	t.lock.Unlock()
+	if clusterID != "" {
+		span.SetAttribute(ClusterIDAttribute, clusterID)
+	}
+	return span
+}
+
+func (t *tracer) export(span *Span) {
+	for _, exporter := range t.exporters {
+		err := exporter.export(span)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to export trace: %v\n", err)
+		}
+	}
+}
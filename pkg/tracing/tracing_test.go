@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/smithy-go/middleware"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// ocmServer returns a server that answers OCM requests with an empty object, and saves the
+// 'traceparent' header of the last request.
+func ocmServer(traceParent *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*traceParent = r.Header.Get("traceparent")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+}
+
+// iamServer returns a server that answers IAM 'GetRole' requests.
+func iamServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = w.Write([]byte(`<GetRoleResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">` +
+			`<GetRoleResult><Role><RoleName>ManagedOpenShift-Installer-Role</RoleName></Role>` +
+			`</GetRoleResult></GetRoleResponse>`))
+	}))
+}
+
+func iamClient(url string) *iam.Client {
+	return iam.New(iam.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(url),
+		Credentials:  credentials.NewStaticCredentialsProvider("AKIATEST", "secret", ""),
+		APIOptions:   append([]func(*middleware.Stack) error{}, AddMiddleware),
+	})
+}
+
+func readTraceFile(path string) []spanData {
+	file, err := os.Open(path)
+	Expect(err).ToNot(HaveOccurred())
+	defer file.Close()
+	var result []spanData
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var request exportRequest
+		Expect(json.Unmarshal(scanner.Bytes(), &request)).To(Succeed())
+		result = append(result, request.ResourceSpans[0].ScopeSpans[0].Spans...)
+	}
+	return result
+}
+
+func attributes(span spanData) map[string]string {
+	result := map[string]string{}
+	for _, attribute := range span.Attributes {
+		switch {
+		case attribute.Value.StringValue != nil:
+			result[attribute.Key] = *attribute.Value.StringValue
+		case attribute.Value.IntValue != nil:
+			result[attribute.Key] = *attribute.Value.IntValue
+		}
+	}
+	return result
+}
+
+var _ = Describe("Tracing", func() {
+	var traceParent string
+	var ocm *httptest.Server
+
+	BeforeEach(func() {
+		traceParent = ""
+		ocm = ocmServer(&traceParent)
+		DeferCleanup(ocm.Close)
+		DeferCleanup(SetTraceFile, "")
+		DeferCleanup(FinishCommand, 0)
+	})
+
+	It("Doesn't trace when there is no trace file nor endpoint", func() {
+		Expect(StartCommand("rosa describe cluster")).To(Succeed())
+		Expect(Enabled()).To(BeFalse())
+
+		response, err := (&http.Client{Transport: Wrap(http.DefaultTransport)}).Get(
+			ocm.URL + "/api/clusters_mgmt/v1/clusters/123")
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+		Expect(traceParent).To(BeEmpty())
+	})
+
+	It("Writes the spans of the command and its requests to the trace file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "trace.json")
+		SetTraceFile(path)
+		Expect(StartCommand("rosa describe cluster")).To(Succeed())
+		Expect(Enabled()).To(BeTrue())
+
+		response, err := (&http.Client{Transport: Wrap(http.DefaultTransport)}).Get(
+			ocm.URL + "/api/clusters_mgmt/v1/clusters/123/addons/abc?token=eyJhbGciOiJIUzI1NiJ9.e30.sig")
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+		SetClusterID("123")
+		server := iamServer()
+		defer server.Close()
+		_, err = iamClient(server.URL).GetRole(context.Background(), &iam.GetRoleInput{
+			RoleName: aws.String("ManagedOpenShift-Installer-Role"),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		FinishCommand(3)
+
+		spans := readTraceFile(path)
+		Expect(spans).To(HaveLen(3))
+		request, role, command := spans[0], spans[1], spans[2]
+
+		Expect(command.Name).To(Equal("rosa describe cluster"))
+		Expect(command.ParentSpanID).To(BeEmpty())
+		Expect(command.Status).ToNot(BeNil())
+		Expect(command.Status.Code).To(Equal(statusError))
+		Expect(attributes(command)).To(HaveKeyWithValue(ExitCodeAttribute, "3"))
+		Expect(attributes(command)).To(HaveKeyWithValue(ClusterIDAttribute, "123"))
+
+		Expect(request.Name).To(Equal("OCM GET /api/clusters_mgmt/v1/clusters/{id}/addons/{id}"))
+		Expect(request.Kind).To(Equal(KindClient))
+		Expect(request.TraceID).To(Equal(command.TraceID))
+		Expect(request.ParentSpanID).To(Equal(command.SpanID))
+		Expect(request.Status).To(BeNil())
+		Expect(traceParent).To(Equal("00-" + request.TraceID + "-" + request.SpanID + "-01"))
+		Expect(attributes(request)).To(HaveKeyWithValue("http.status_code", "200"))
+		Expect(attributes(request)).To(HaveKeyWithValue("ocm.service", "clusters_mgmt"))
+		Expect(attributes(request)).To(HaveKeyWithValue("ocm.resource", "addons"))
+		Expect(attributes(request)).To(HaveKeyWithValue("ocm.resource_id", "abc"))
+		Expect(attributes(request)).To(HaveKeyWithValue(ClusterIDAttribute, "123"))
+		Expect(attributes(request)["http.url"]).ToNot(ContainSubstring("eyJ"))
+
+		Expect(role.Name).To(Equal("AWS IAM.GetRole"))
+		Expect(role.ParentSpanID).To(Equal(command.SpanID))
+		Expect(attributes(role)).To(HaveKeyWithValue("aws.region", "us-east-1"))
+		Expect(attributes(role)).To(HaveKeyWithValue("aws.input.RoleName", "ManagedOpenShift-Installer-Role"))
+		Expect(attributes(role)).To(HaveKeyWithValue("http.status_code", "200"))
+		Expect(attributes(role)).To(HaveKeyWithValue(ClusterIDAttribute, "123"))
+	})
+
+	It("Sends the spans to the OTLP endpoint when the command finishes", func() {
+		var requests []exportRequest
+		var authorization string
+		collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Path).To(Equal("/v1/traces"))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			authorization = r.Header.Get("Authorization")
+			body, err := io.ReadAll(r.Body)
+			Expect(err).ToNot(HaveOccurred())
+			var request exportRequest
+			Expect(json.Unmarshal(body, &request)).To(Succeed())
+			requests = append(requests, request)
+		}))
+		defer collector.Close()
+		GinkgoT().Setenv(endpointEnvVar, collector.URL+"/")
+		GinkgoT().Setenv(headersEnvVar, "Authorization=Bearer abc")
+		GinkgoT().Setenv(serviceNameEnvVar, "rosa-test")
+		Expect(StartCommand("rosa list clusters")).To(Succeed())
+
+		response, err := (&http.Client{Transport: Wrap(http.DefaultTransport)}).Get(
+			ocm.URL + "/api/clusters_mgmt/v1/clusters")
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+		Expect(requests).To(BeEmpty())
+		FinishCommand(0)
+
+		Expect(requests).To(HaveLen(1))
+		Expect(authorization).To(Equal("Bearer abc"))
+		resource := requests[0].ResourceSpans[0]
+		Expect(*resource.Resource.Attributes[0].Value.StringValue).To(Equal("rosa-test"))
+		spans := resource.ScopeSpans[0].Spans
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Name).To(Equal("OCM GET /api/clusters_mgmt/v1/clusters"))
+		Expect(attributes(spans[0])).To(HaveKeyWithValue("ocm.resource", "clusters"))
+		Expect(attributes(spans[0])).ToNot(HaveKey("ocm.resource_id"))
+		Expect(spans[1].Name).To(Equal("rosa list clusters"))
+		Expect(spans[1].Status).To(BeNil())
+	})
+
+	It("Rejects OTLP protocols other than JSON", func() {
+		GinkgoT().Setenv(endpointEnvVar, "http://localhost:4318")
+		GinkgoT().Setenv(protocolEnvVar, "grpc")
+		Expect(StartCommand("rosa list clusters")).To(MatchError(ContainSubstring("'grpc' isn't supported")))
+		Expect(Enabled()).To(BeFalse())
+	})
+
+	It("Marks failed requests as errors", func() {
+		GinkgoT().Setenv(tracesEndpointEnvVar, ocm.URL+"/v1/traces")
+		Expect(StartCommand("rosa list clusters")).To(Succeed())
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer failing.Close()
+		ctx, parent := Start(context.Background(), "parent", KindInternal)
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, failing.URL+"/api/x/v1/clusters/1", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := Wrap(http.DefaultTransport).RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+		parent.End()
+
+		exporter := current().exporters[0].(*otlpExporter)
+		Expect(exporter.spans).To(HaveLen(2))
+		span := encodeSpan(exporter.spans[0])
+		Expect(span.ParentSpanID).To(Equal(parent.spanID))
+		Expect(span.Status).ToNot(BeNil())
+		Expect(span.Status.Message).To(Equal("Status 404"))
+	})
+})
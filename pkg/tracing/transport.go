@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/openshift/rosa/pkg/ocm/endpoint"
+	"github.com/openshift/rosa/pkg/redact"
+)
+
+// Wrap returns a round tripper that records a span for each OCM request sent with the given one.
+// It is intended for the transport wrapper of the OCM connection.
+func Wrap(next http.RoundTripper) http.RoundTripper {
+	return &roundTripper{next: next}
+}
+
+type roundTripper struct {
+	next http.RoundTripper
+}
+
+func (r *roundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	path := endpoint.Parse(request.URL.Path)
+	ctx, span := Start(request.Context(), fmt.Sprintf("OCM %s %s", request.Method, path.Template), KindClient)
+	if span == nil {
+		return r.next.RoundTrip(request)
+	}
+	defer span.End()
+	span.SetAttribute("http.method", request.Method)
+	span.SetAttribute("http.url", redact.Current().Text(request.URL.String()))
+	if path.Service != "" {
+		span.SetAttribute("ocm.service", path.Service)
+	}
+	if path.Resource != "" {
+		span.SetAttribute("ocm.resource", path.Resource)
+	}
+	if path.ResourceID != "" {
+		span.SetAttribute("ocm.resource_id", path.ResourceID)
+	}
+	if path.ClusterID != "" {
+		span.SetAttribute(ClusterIDAttribute, path.ClusterID)
+	}
+
+	// Requests must not be modified by round trippers, so the header goes in a copy:
+	request = request.Clone(ctx)
+	request.Header.Set("traceparent", span.TraceParent())
+	response, err := r.next.RoundTrip(request)
+	if err != nil {
+		span.SetError(err)
+		return nil, err
+	}
+	span.SetAttribute("http.status_code", response.StatusCode)
+	if response.StatusCode >= http.StatusBadRequest {
+		span.SetError(fmt.Errorf("Status %d", response.StatusCode))
+	}
+	return response, nil
+}
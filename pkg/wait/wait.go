@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package wait polls OCM until a resource reaches a condition, as used by the 'rosa wait' commands.
+package wait
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8swait "k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+const DefaultTimeout = 60 * time.Minute
+
+// Backoff between two checks of a resource. It is a variable so that tests can shorten it.
+var Backoff = k8swait.Backoff{
+	Duration: 5 * time.Second,
+	Factor:   1.5,
+	Jitter:   0.1,
+	Steps:    math.MaxInt32,
+	Cap:      time.Minute,
+}
+
+// Options are the flags shared by the 'rosa wait' commands. The timeout is the one given with the
+// global '--timeout' flag, or DefaultTimeout when that flag isn't set.
+type Options struct {
+	For     string
+	Timeout time.Duration
+}
+
+// AddFlags adds the '--for' flag to the given command. The condition defaults to the first of the
+// supported conditions.
+func AddFlags(cmd *cobra.Command, options *Options, conditions []string) {
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.For,
+		"for",
+		conditions[0],
+		fmt.Sprintf("Condition to wait for. Supported conditions are: %s.", strings.Join(conditions, ", ")),
+	)
+	cmd.RegisterFlagCompletionFunc("for", func(*cobra.Command, []string, string) ([]string,
+		cobra.ShellCompDirective) {
+		return conditions, cobra.ShellCompDirectiveDefault
+	})
+}
+
+// Validate checks that the requested condition is supported, and sets the timeout if it isn't set.
+func (o *Options) Validate(conditions []string) error {
+	if !helper.Contains(conditions, o.For) {
+		return fmt.Errorf("Invalid condition '%s', supported conditions are: %s",
+			o.For, strings.Join(conditions, ", "))
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = cmdcontext.Timeout()
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = DefaultTimeout
+	}
+	return nil
+}
+
+// Check returns the current state of a resource and whether the awaited condition is met. It
+// returns an error when the resource can't be fetched or is in a state it will never leave.
+type Check func() (state string, done bool, err error)
+
+// Until calls the check with an increasing delay until the condition is met, the check fails or
+// the timeout expires. Every change of state is reported. The description names the resource, for
+// example "cluster 'mycluster'".
+func Until(parent context.Context, r *reporter.Object, options *Options, description string, check Check) error {
+	ctx, cancel := context.WithTimeout(parent, options.Timeout)
+	defer cancel()
+
+	lastState := ""
+	err := k8swait.ExponentialBackoffWithContext(ctx, Backoff, func(context.Context) (bool, error) {
+		state, done, err := check()
+		if err != nil {
+			return false, err
+		}
+		if state != lastState {
+			r.Infof("State of %s is '%s'", description, state)
+			lastState = state
+		} else {
+			r.Debugf("State of %s is still '%s'", description, state)
+		}
+		return done, nil
+	})
+	// The command was interrupted or its own timeout expired, the check may have failed because of
+	// that, so the reason is reported instead of its error
+	if parent.Err() != nil {
+		cause := context.Cause(parent)
+		return &rosaerrors.Error{
+			Category: rosaerrors.Classify(cause).Category,
+			Message:  fmt.Sprintf("Stopped waiting for %s to be %s: %v", description, options.For, cause),
+			Err:      cause,
+		}
+	}
+	if k8swait.Interrupted(err) {
+		return rosaerrors.New(rosaerrors.CategoryTimeout, "Timed out after %s waiting for %s to be %s",
+			options.Timeout, description, options.For)
+	}
+	return err
+}
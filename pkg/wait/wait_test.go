@@ -0,0 +1,107 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/cmdcontext"
+	rosaerrors "github.com/openshift/rosa/pkg/errors"
+	"github.com/openshift/rosa/pkg/reporter"
+)
+
+var _ = Describe("Wait", func() {
+	var conditions = []string{"ready", "deleted"}
+
+	Context("Flags", func() {
+		It("Defaults to the first condition", func() {
+			options := &Options{}
+			cmd := &cobra.Command{}
+			AddFlags(cmd, options, conditions)
+			Expect(options.For).To(Equal("ready"))
+			Expect(cmd.Flags().Lookup("timeout")).To(BeNil())
+			Expect(options.Validate(conditions)).To(Succeed())
+			Expect(options.Timeout).To(Equal(DefaultTimeout))
+		})
+
+		It("Uses the timeout of the global flag", func() {
+			cmdcontext.SetTimeout(2 * time.Hour)
+			DeferCleanup(cmdcontext.SetTimeout, time.Duration(0))
+			options := &Options{For: "ready"}
+			Expect(options.Validate(conditions)).To(Succeed())
+			Expect(options.Timeout).To(Equal(2 * time.Hour))
+		})
+
+		It("Fails to validate an unknown condition", func() {
+			options := &Options{For: "gone", Timeout: time.Minute}
+			err := options.Validate(conditions)
+			Expect(err).To(MatchError("Invalid condition 'gone', supported conditions are: ready, deleted"))
+		})
+
+	})
+
+	Context("Until", func() {
+		var backoff = Backoff
+
+		BeforeEach(func() {
+			Backoff.Duration = time.Millisecond
+			Backoff.Cap = 10 * time.Millisecond
+			DeferCleanup(func() {
+				Backoff = backoff
+			})
+		})
+
+		It("Returns once the condition is met", func() {
+			options := &Options{For: "ready", Timeout: time.Minute}
+			calls := 0
+			err := Until(context.Background(), reporter.CreateReporter(), options, "cluster 'test'",
+				func() (string, bool, error) {
+					calls++
+					if calls < 3 {
+						return "installing", false, nil
+					}
+					return "ready", true, nil
+				})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal(3))
+		})
+
+		It("Stops on a terminal error", func() {
+			options := &Options{For: "ready", Timeout: time.Minute}
+			calls := 0
+			err := Until(context.Background(), reporter.CreateReporter(), options, "cluster 'test'",
+				func() (string, bool, error) {
+					calls++
+					return "", false, fmt.Errorf("Cluster 'test' is in 'error' state")
+				})
+			Expect(err).To(MatchError("Cluster 'test' is in 'error' state"))
+			Expect(calls).To(Equal(1))
+		})
+
+		It("Fails when the timeout expires", func() {
+			options := &Options{For: "ready", Timeout: 50 * time.Millisecond}
+			err := Until(context.Background(), reporter.CreateReporter(), options, "cluster 'test'",
+				func() (string, bool, error) {
+					return "installing", false, nil
+				})
+			Expect(err).To(MatchError("Timed out after 50ms waiting for cluster 'test' to be ready"))
+			Expect(rosaerrors.ExitCode(err)).To(Equal(rosaerrors.CategoryTimeout.ExitCode()))
+		})
+
+		It("Stops when the command is interrupted", func() {
+			options := &Options{For: "ready", Timeout: time.Minute}
+			ctx, cancel := context.WithCancelCause(context.Background())
+			err := Until(ctx, reporter.CreateReporter(), options, "cluster 'test'",
+				func() (string, bool, error) {
+					cancel(rosaerrors.New(rosaerrors.CategoryInterrupted, "Interrupted"))
+					return "installing", false, nil
+				})
+			Expect(err).To(MatchError("Stopped waiting for cluster 'test' to be ready: Interrupted"))
+			Expect(rosaerrors.ExitCode(err)).To(Equal(rosaerrors.CategoryInterrupted.ExitCode()))
+		})
+	})
+})